@@ -0,0 +1,14 @@
+data "stackit_dns_zone_transfer" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  zone_id    = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# Configure the secondary DNS provider to pull the zone from the STACKIT primary name server
+output "secondary_dns_config" {
+  value = {
+    zone         = data.stackit_dns_zone_transfer.example.dns_name
+    masters      = [data.stackit_dns_zone_transfer.example.primary_name_server]
+    name_servers = data.stackit_dns_zone_transfer.example.name_servers
+    allowed_from = data.stackit_dns_zone_transfer.example.transfer_acl
+  }
+}
@@ -1,4 +1,10 @@
 data "stackit_git" "git" {
   project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
   instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
-}
\ No newline at end of file
+}
+
+# Only one of instance_id or name can be passed
+data "stackit_git" "git_by_name" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "git-example-instance"
+}
@@ -0,0 +1,28 @@
+data "stackit_iaas_quotas" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# example usage: check at plan time that all volumes of the configuration fit into the remaining quotas
+locals {
+  volume_count = 3
+  volume_size  = 64
+}
+
+resource "stackit_volume" "example" {
+  count             = local.volume_count
+  project_id        = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name              = "my_volume_${count.index}"
+  availability_zone = "eu01-1"
+  size              = local.volume_size
+
+  lifecycle {
+    precondition {
+      condition     = local.volume_count <= data.stackit_iaas_quotas.example.volumes.available
+      error_message = "Creating ${local.volume_count} volumes exceeds the volume quota, only ${data.stackit_iaas_quotas.example.volumes.available} of ${data.stackit_iaas_quotas.example.volumes.limit} volumes are left. Delete unused volumes or request a quota increase from the STACKIT support."
+    }
+    precondition {
+      condition     = local.volume_count * local.volume_size <= data.stackit_iaas_quotas.example.gigabytes.available
+      error_message = "Creating ${local.volume_count * local.volume_size} GB of volumes exceeds the volume size quota, only ${data.stackit_iaas_quotas.example.gigabytes.available} GB are left. Delete unused volumes or request a quota increase from the STACKIT support."
+    }
+  }
+}
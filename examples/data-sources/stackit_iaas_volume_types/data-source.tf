@@ -0,0 +1,24 @@
+data "stackit_iaas_volume_types" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# example usage: validate the requested performance class at plan time
+variable "performance_class" {
+  type    = string
+  default = "storage_premium_perf1"
+}
+
+resource "stackit_volume" "example" {
+  project_id        = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name              = "my_volume"
+  availability_zone = "eu01-1"
+  size              = 64
+  performance_class = var.performance_class
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.stackit_iaas_volume_types.example.names, var.performance_class)
+      error_message = "The performance class ${var.performance_class} is not available."
+    }
+  }
+}
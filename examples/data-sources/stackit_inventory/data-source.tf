@@ -0,0 +1,35 @@
+data "stackit_inventory" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# only include resources labeled with env = "prod"
+data "stackit_inventory" "prod" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  filter = {
+    labels = {
+      env = "prod"
+    }
+  }
+}
+
+# example usage: generate an Ansible inventory grouped by the "role" label of the servers
+locals {
+  servers = data.stackit_inventory.example.servers
+  roles   = distinct([for server in local.servers : lookup(coalesce(server.labels, {}), "role", "ungrouped")])
+}
+
+output "ansible_inventory" {
+  value = yamlencode({
+    all = {
+      children = {
+        for role in local.roles : role => {
+          hosts = {
+            for server in local.servers : server.name => {
+              ansible_host = coalesce(server.nics[0].public_ip, server.nics[0].ipv4)
+            } if lookup(coalesce(server.labels, {}), "role", "ungrouped") == role && length(server.nics) > 0
+          }
+        }
+      }
+    }
+  })
+}
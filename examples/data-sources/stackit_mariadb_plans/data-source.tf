@@ -0,0 +1,18 @@
+data "stackit_mariadb_plans" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# example usage: select the smallest single node plan with at least 2 CPUs and 8 GB RAM
+locals {
+  mariadb_plan = [
+    for plan in data.stackit_mariadb_plans.example.plans : plan
+    if plan.version == "10.11" && plan.replicas == 1 && plan.cpu >= 2 && plan.ram >= 8
+  ][0]
+}
+
+resource "stackit_mariadb_instance" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "example-instance"
+  version    = local.mariadb_plan.version
+  plan_name  = local.mariadb_plan.name
+}
@@ -2,4 +2,10 @@ data "stackit_network_interface" "example" {
   project_id           = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
   network_id           = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
   network_interface_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
-}
\ No newline at end of file
+}
+
+data "stackit_network_interface" "by_ipv4" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  network_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  ipv4       = "10.0.0.10"
+}
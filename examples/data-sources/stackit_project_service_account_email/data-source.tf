@@ -0,0 +1,3 @@
+data "stackit_project_service_account_email" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
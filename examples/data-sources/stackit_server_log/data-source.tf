@@ -0,0 +1,13 @@
+data "stackit_server_log" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  server_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  length     = 500
+}
+
+# Fail the run, if the server didn't finish its boot
+check "server_booted" {
+  assert {
+    condition     = strcontains(data.stackit_server_log.example.output, "Cloud-init finished")
+    error_message = "The server didn't finish booting."
+  }
+}
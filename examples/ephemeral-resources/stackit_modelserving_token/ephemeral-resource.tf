@@ -0,0 +1,11 @@
+ephemeral "stackit_modelserving_token" "example" {
+  project_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name         = "Example token"
+  ttl_duration = "1h"
+}
+
+# The token is only available during the Terraform operation, e.g. to pass it to another provider
+provider "openai" {
+  base_url = "https://api.openai-compat.model-serving.eu01.onstackit.cloud/v1"
+  api_key  = ephemeral.stackit_modelserving_token.example.content
+}
@@ -0,0 +1,7 @@
+resource "stackit_dns_record_set" "ptr" {
+  project_id = stackit_dns_zone.reverse.project_id
+  zone_id    = stackit_dns_zone.reverse.zone_id
+  name       = provider::stackit::reverse_record_name("192.0.2.10")
+  type       = "PTR"
+  records    = ["www.example.com."]
+}
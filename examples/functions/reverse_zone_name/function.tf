@@ -0,0 +1,8 @@
+resource "stackit_dns_zone" "reverse" {
+  project_id      = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name            = "Reverse zone"
+  dns_name        = provider::stackit::reverse_zone_name("192.0.2.0/24")
+  contact_email   = "aa@bb.ccc"
+  type            = "primary"
+  is_reverse_zone = true
+}
@@ -24,3 +24,15 @@ provider "stackit" {
   private_key_path         = var.private_key_path
 }
 
+
+# Auth profiles, used by resources setting the auth_profile attribute
+provider "stackit" {
+  default_region           = "eu01"
+  service_account_key_path = var.service_account_key_path
+
+  auth_profiles = {
+    "team-a" = {
+      service_account_key_path = var.team_a_service_account_key_path
+    }
+  }
+}
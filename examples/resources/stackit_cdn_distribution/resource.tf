@@ -10,10 +10,49 @@ resource "stackit_cdn_distribution" "example_distribution" {
     }
     regions           = ["EU", "US", "ASIA", "AF", "SA"]
     blocked_countries = ["DE", "AT", "CH"]
+    blocked_ips       = ["192.0.2.1", "198.51.100.0/24"]
 
     optimizer = {
       enabled = true
     }
+
+    cache = {
+      default_duration = "P1D"
+    }
+
+    waf = {
+      mode = "ENABLED"
+      type = "FREE"
+    }
+  }
+}
+
+# Use an object storage bucket as origin, the origin URL is derived from the bucket
+resource "stackit_cdn_distribution" "example_bucket_distribution" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  config = {
+    backend = {
+      type      = "http"
+      bucket_id = stackit_objectstorage_bucket.example.id
+    }
+    regions = ["EU"]
+  }
+}
+
+# Push the access logs of the distribution to an observability instance
+resource "stackit_cdn_distribution" "logging_example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  config = {
+    backend = {
+      type       = "http"
+      origin_url = "https://mybackend.onstackit.cloud"
+    }
+    regions = ["EU"]
+    logging = {
+      push_url = stackit_observability_instance.example.logs_push_url
+      username = stackit_observability_credential.example.username
+      password = stackit_observability_credential.example.password
+    }
   }
 }
 
@@ -7,6 +7,15 @@ resource "stackit_dns_record_set" "example" {
   records    = ["1.2.3.4"]
 }
 
+# Point the apex of the zone to a load balancer or CDN distribution
+resource "stackit_dns_record_set" "alias-example" {
+  project_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  zone_id      = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name         = "example.com."
+  type         = "ALIAS"
+  alias_target = "example-distribution.cdn.stackit.cloud."
+}
+
 # Only use the import statement, if you want to import an existing dns record set
 import {
   to = stackit_dns_record_set.import-example
@@ -13,4 +13,10 @@ resource "stackit_dns_zone" "example" {
 import {
   to = stackit_dns_zone.import-example
   id = "${var.project_id},${var.zone_id}"
+}
+
+# The zone can also be imported by its DNS name
+import {
+  to = stackit_dns_zone.import-example-by-dns-name
+  id = "${var.project_id},${var.dns_name}"
 }
\ No newline at end of file
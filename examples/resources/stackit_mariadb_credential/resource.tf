@@ -3,6 +3,20 @@ resource "stackit_mariadb_credential" "example" {
   instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
 }
 
+# Rotate the credential every 30 days
+resource "time_rotating" "rotate" {
+  rotation_days = 30
+}
+
+resource "stackit_mariadb_credential" "rotating_example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+  rotate_when_changed = {
+    rotation = time_rotating.rotate.id
+  }
+}
+
 # Only use the import statement, if you want to import an existing mariadb credential
 import {
   to = stackit_mariadb_credential.import-example
@@ -24,6 +24,15 @@ resource "stackit_network" "example_non_routed_network" {
   routed = false
 }
 
+resource "stackit_network" "example_dual_stack_network" {
+  project_id         = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name               = "example-dual-stack-network"
+  ipv4_prefix_length = 24
+  ipv6_prefix_length = 64
+  ipv6_nameservers   = ["2001:4860:4860::8888"]
+  routed             = true
+}
+
 # Only use the import statement, if you want to import an existing network
 # Note: There will be a conflict which needs to be resolved manually.
 # These attributes cannot be configured together: [ipv4_prefix,ipv4_prefix_length,ipv4_gateway]
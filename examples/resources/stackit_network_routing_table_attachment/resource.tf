@@ -0,0 +1,11 @@
+resource "stackit_network_routing_table_attachment" "example" {
+  project_id       = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  network_id       = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  routing_table_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# Only use the import statement, if you want to import an existing network routing table attachment
+import {
+  to = stackit_network_routing_table_attachment.import-example
+  id = "${var.project_id},${var.region},${var.network_id}"
+}
@@ -1,7 +1,8 @@
 resource "stackit_objectstorage_credential" "example" {
-  project_id           = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
-  credentials_group_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
-  expiration_timestamp = "2027-01-02T03:04:05Z"
+  project_id                   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  credentials_group_id         = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  expiration_timestamp         = "2027-01-02T03:04:05Z"
+  expiration_warning_threshold = "30d"
 }
 
 # Only use the import statement, if you want to import an existing objectstorage credential
@@ -0,0 +1,22 @@
+resource "stackit_objectstorage_project" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  region     = "eu01"
+}
+
+# Referencing the project ID and region of the object storage project makes sure object storage is enabled before the bucket is created
+resource "stackit_objectstorage_bucket" "example" {
+  project_id = stackit_objectstorage_project.example.project_id
+  region     = stackit_objectstorage_project.example.region
+  name       = "example-bucket"
+}
+
+# The S3 endpoint can be used e.g. for the AWS provider, instead of hardcoding it
+output "s3_endpoint" {
+  value = stackit_objectstorage_project.example.s3_endpoint
+}
+
+# Only use the import statement, if you want to import an existing objectstorage project
+import {
+  to = stackit_objectstorage_project.import-example
+  id = "${var.project_id},${var.region}"
+}
@@ -4,7 +4,7 @@ resource "stackit_opensearch_instance" "example" {
   version    = "2"
   plan_name  = "stackit-opensearch-1.2.10-replica"
   parameters = {
-    sgw_acl = "193.148.160.0/19,45.129.40.0/21,45.135.244.0/22"
+    acl = ["193.148.160.0/19", "45.129.40.0/21", "45.135.244.0/22"]
   }
 }
 
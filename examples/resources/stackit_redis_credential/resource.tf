@@ -3,6 +3,21 @@ resource "stackit_redis_credential" "example" {
   instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
 }
 
+# Hand the credential to the kubernetes provider as a Secret
+resource "stackit_redis_credential" "kubernetes_example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+  render_kubernetes_secret = {
+    name      = "redis-credential"
+    namespace = "apps"
+  }
+}
+
+resource "kubernetes_manifest" "redis_credential" {
+  manifest = yamldecode(stackit_redis_credential.kubernetes_example.render_kubernetes_secret.manifest)
+}
+
 # Only use the import statement, if you want to import an existing redis credential
 import {
   to = stackit_redis_credential.import-example
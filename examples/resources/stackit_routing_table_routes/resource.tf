@@ -0,0 +1,35 @@
+resource "stackit_routing_table_routes" "example" {
+  organization_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  network_area_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  routing_table_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  routes = [
+    {
+      destination = {
+        type  = "cidrv4"
+        value = "192.168.178.0/24"
+      }
+      next_hop = {
+        type  = "ipv4"
+        value = "192.168.178.1"
+      }
+      labels = {
+        "key" = "value"
+      }
+    },
+    {
+      destination = {
+        type  = "cidrv4"
+        value = "0.0.0.0/0"
+      }
+      next_hop = {
+        type = "internet"
+      }
+    },
+  ]
+}
+
+# Only use the import statement, if you want to import the routes of an existing routing table
+import {
+  to = stackit_routing_table_routes.import-example
+  id = "${var.organization_id},${var.region},${var.network_area_id},${var.routing_table_id}"
+}
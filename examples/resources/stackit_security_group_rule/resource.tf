@@ -11,6 +11,22 @@ resource "stackit_security_group_rule" "example" {
   }
 }
 
+resource "stackit_security_group_rule" "example_https" {
+  project_id        = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  security_group_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  description       = "Allow HTTPS via IPv6"
+  direction         = "ingress"
+  ether_type        = "IPv6"
+  ip_range          = "::/0"
+  port_range = {
+    min = 443
+    max = 443
+  }
+  protocol = {
+    name = "tcp"
+  }
+}
+
 # Only use the import statement, if you want to import an existing security group rule
 # Note: There will be a conflict which needs to be resolved manually.
 # Attribute "protocol.number" cannot be specified when "protocol.name" is specified.
@@ -0,0 +1,27 @@
+resource "stackit_service_enablement" "ske" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  service_id = "cloud.stackit.ske"
+}
+
+# Referencing the project ID of the service enablement makes sure the service is enabled before the cluster is created
+resource "stackit_ske_cluster" "example" {
+  project_id             = stackit_service_enablement.ske.project_id
+  name                   = "example"
+  kubernetes_version_min = "x.x"
+  node_pools = [
+    {
+      name               = "np-example"
+      machine_type       = "x.x"
+      os_version         = "x.x.x"
+      minimum            = "2"
+      maximum            = "3"
+      availability_zones = ["eu01-3"]
+    }
+  ]
+}
+
+# Only use the import statement, if you want to import an existing service enablement
+import {
+  to = stackit_service_enablement.import-example
+  id = "${var.project_id},${var.region},${var.service_id}"
+}
@@ -3,6 +3,7 @@ package conversion
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 
@@ -87,6 +88,19 @@ func StringValueToPointer(s basetypes.StringValue) *string {
 	return &value
 }
 
+// NormalizedStringPointerValue converts an optional string returned by the API to a basetypes.StringValue.
+// APIs use an empty string and null interchangeably for unset optional fields (e.g. descriptions). If the API
+// value is empty or missing, the current value is kept as long as it is empty or null as well, to avoid diffs.
+func NormalizedStringPointerValue(current basetypes.StringValue, value *string) basetypes.StringValue {
+	if value != nil && *value != "" {
+		return types.StringValue(*value)
+	}
+	if !current.IsUnknown() && current.ValueString() == "" {
+		return current
+	}
+	return types.StringPointerValue(value)
+}
+
 // Int64ValueToPointer converts basetypes.Int64Value to a pointer to int64.
 // It returns nil if the value is null or unknown.
 func Int64ValueToPointer(s basetypes.Int64Value) *int64 {
@@ -136,6 +150,35 @@ func StringListToPointer(list basetypes.ListValue) (*[]string, error) {
 	return &listStr, nil
 }
 
+// StringListToCommaSeparatedPointer converts basetypes.ListValue to a pointer to a comma separated string of its elements.
+// It returns nil if the value is null or unknown.
+func StringListToCommaSeparatedPointer(list basetypes.ListValue) (*string, error) {
+	listStr, err := StringListToPointer(list)
+	if err != nil || listStr == nil {
+		return nil, err
+	}
+	value := strings.Join(*listStr, ",")
+	return &value, nil
+}
+
+// CommaSeparatedToStringList converts a comma separated string to a list of its elements, without surrounding whitespace.
+// It returns a null list if the value is null or unknown.
+func CommaSeparatedToStringList(s basetypes.StringValue) basetypes.ListValue {
+	if s.IsNull() || s.IsUnknown() {
+		return types.ListNull(types.StringType)
+	}
+
+	elements := []attr.Value{}
+	for _, el := range strings.Split(s.ValueString(), ",") {
+		el = strings.TrimSpace(el)
+		if el == "" {
+			continue
+		}
+		elements = append(elements, types.StringValue(el))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
 // ToJSONMApPartialUpdatePayload returns a map[string]interface{} to be used in a PATCH request payload.
 // It takes a current map as it is in the terraform state and a desired map as it is in the user configuratiom
 // and builds a map which sets to null keys that should be removed, updates the values of existing keys and adds new keys
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 )
 
 func TestFromTerraformStringMapToInterfaceMap(t *testing.T) {
@@ -392,3 +393,155 @@ func TestParseEphemeralProviderData(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizedStringPointerValue(t *testing.T) {
+	emptyString := ""
+	description := "description"
+	tests := []struct {
+		name     string
+		current  basetypes.StringValue
+		value    *string
+		expected basetypes.StringValue
+	}{
+		{
+			name:     "null config, empty api value",
+			current:  types.StringNull(),
+			value:    &emptyString,
+			expected: types.StringNull(),
+		},
+		{
+			name:     "empty config, missing api value",
+			current:  types.StringValue(""),
+			value:    nil,
+			expected: types.StringValue(""),
+		},
+		{
+			name:     "null config, missing api value",
+			current:  types.StringNull(),
+			value:    nil,
+			expected: types.StringNull(),
+		},
+		{
+			name:     "empty config, empty api value",
+			current:  types.StringValue(""),
+			value:    &emptyString,
+			expected: types.StringValue(""),
+		},
+		{
+			name:     "null config, api value set",
+			current:  types.StringNull(),
+			value:    &description,
+			expected: types.StringValue("description"),
+		},
+		{
+			name:     "config set, api value removed",
+			current:  types.StringValue("description"),
+			value:    &emptyString,
+			expected: types.StringValue(""),
+		},
+		{
+			name:     "config set, api value missing",
+			current:  types.StringValue("description"),
+			value:    nil,
+			expected: types.StringNull(),
+		},
+		{
+			name:     "unknown config, missing api value",
+			current:  types.StringUnknown(),
+			value:    nil,
+			expected: types.StringNull(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := NormalizedStringPointerValue(tt.current, tt.value)
+			if !actual.Equal(tt.expected) {
+				t.Errorf("NormalizedStringPointerValue() got = %v, want %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStringListToCommaSeparatedPointer(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     basetypes.ListValue
+		expected *string
+	}{
+		{
+			name:     "null",
+			list:     types.ListNull(types.StringType),
+			expected: nil,
+		},
+		{
+			name:     "unknown",
+			list:     types.ListUnknown(types.StringType),
+			expected: nil,
+		},
+		{
+			name:     "empty",
+			list:     types.ListValueMust(types.StringType, []attr.Value{}),
+			expected: utils.Ptr(""),
+		},
+		{
+			name: "values",
+			list: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("192.168.0.0/24"),
+				types.StringValue("10.0.0.0/8"),
+			}),
+			expected: utils.Ptr("192.168.0.0/24,10.0.0.0/8"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := StringListToCommaSeparatedPointer(tt.list)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(actual, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCommaSeparatedToStringList(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    basetypes.StringValue
+		expected basetypes.ListValue
+	}{
+		{
+			name:     "null",
+			value:    types.StringNull(),
+			expected: types.ListNull(types.StringType),
+		},
+		{
+			name:     "unknown",
+			value:    types.StringUnknown(),
+			expected: types.ListNull(types.StringType),
+		},
+		{
+			name:     "empty",
+			value:    types.StringValue(""),
+			expected: types.ListValueMust(types.StringType, []attr.Value{}),
+		},
+		{
+			name:  "values",
+			value: types.StringValue("192.168.0.0/24, 10.0.0.0/8,"),
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("192.168.0.0/24"),
+				types.StringValue("10.0.0.0/8"),
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := CommaSeparatedToStringList(tt.value)
+			if !actual.Equal(tt.expected) {
+				t.Errorf("CommaSeparatedToStringList() got = %v, want %v", actual, tt.expected)
+			}
+		})
+	}
+}
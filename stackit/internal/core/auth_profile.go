@@ -0,0 +1,50 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type authProfileContextKey struct{}
+
+// ContextWithAuthProfile returns a context whose API requests are authenticated with the given auth profile of the provider.
+// If the profile is null or unknown, the context is returned unchanged, so the default credentials of the provider are used.
+func ContextWithAuthProfile(ctx context.Context, profile types.String) context.Context {
+	if profile.IsNull() || profile.IsUnknown() {
+		return ctx
+	}
+	ctx = tflog.SetField(ctx, "auth_profile", profile.ValueString())
+	return context.WithValue(ctx, authProfileContextKey{}, profile.ValueString())
+}
+
+// AuthProfileRoundTripper sends requests with the credentials of the auth profile set in the request context.
+// Requests without an auth profile are sent with the default credentials.
+type AuthProfileRoundTripper struct {
+	defaultRoundTripper http.RoundTripper
+	profiles            map[string]http.RoundTripper
+}
+
+// NewAuthProfileRoundTripper returns a round tripper which chooses between the default round tripper and the ones of the auth profiles.
+func NewAuthProfileRoundTripper(defaultRoundTripper http.RoundTripper, profiles map[string]http.RoundTripper) *AuthProfileRoundTripper {
+	return &AuthProfileRoundTripper{
+		defaultRoundTripper: defaultRoundTripper,
+		profiles:            profiles,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rt *AuthProfileRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	profile, ok := req.Context().Value(authProfileContextKey{}).(string)
+	if !ok {
+		return rt.defaultRoundTripper.RoundTrip(req)
+	}
+	roundTripper, ok := rt.profiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("auth profile %q is not configured in the provider", profile)
+	}
+	return roundTripper.RoundTrip(req)
+}
@@ -0,0 +1,87 @@
+package core
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// namedRoundTripper returns a round tripper, which answers every request with its name in the body.
+func namedRoundTripper(name string) http.RoundTripper {
+	return roundTripperFunc(func(_ *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(name)),
+		}, nil
+	})
+}
+
+func TestAuthProfileRoundTripper(t *testing.T) {
+	tests := []struct {
+		description string
+		profile     types.String
+		expected    string
+		isValid     bool
+	}{
+		{
+			description: "no profile",
+			profile:     types.StringNull(),
+			expected:    "default",
+			isValid:     true,
+		},
+		{
+			description: "unknown profile value",
+			profile:     types.StringUnknown(),
+			expected:    "default",
+			isValid:     true,
+		},
+		{
+			description: "configured profile",
+			profile:     types.StringValue("team-a"),
+			expected:    "team-a",
+			isValid:     true,
+		},
+		{
+			description: "profile not configured",
+			profile:     types.StringValue("team-c"),
+			isValid:     false,
+		},
+	}
+	rt := NewAuthProfileRoundTripper(namedRoundTripper("default"), map[string]http.RoundTripper{
+		"team-a": namedRoundTripper("team-a"),
+		"team-b": namedRoundTripper("team-b"),
+	})
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctx := ContextWithAuthProfile(context.Background(), tt.profile)
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com", http.NoBody)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+
+			resp, err := rt.RoundTrip(req)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("Should have failed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("reading body: %v", err)
+			}
+			if string(body) != tt.expected {
+				t.Fatalf("expected request to be sent by %q, got %q", tt.expected, string(body))
+			}
+		})
+	}
+}
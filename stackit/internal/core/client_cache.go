@@ -0,0 +1,55 @@
+package core
+
+import (
+	"sync"
+)
+
+// ClientCache shares the API clients of a provider instance between its resources and data sources.
+// Each resource and data source configures its client, so large plans with hundreds of them reuse the cached clients,
+// including the authentication round tripper, instead of building a new one each time.
+// It is safe for concurrent use.
+type ClientCache struct {
+	mu      sync.Mutex
+	clients map[clientCacheKey]any
+}
+
+// clientCacheKey identifies an API client by the service, the region and the custom endpoint it was configured with.
+type clientCacheKey struct {
+	service  string
+	region   string
+	endpoint string
+}
+
+// NewClientCache returns an empty client cache.
+func NewClientCache() *ClientCache {
+	return &ClientCache{
+		clients: map[clientCacheKey]any{},
+	}
+}
+
+// CachedClient returns the client of the service for the region and endpoint from the cache. If it isn't cached yet,
+// it is created with newClient and cached, unless newClient fails. Without a cache, e.g. in unit tests,
+// newClient is called every time.
+func CachedClient[T any](cache *ClientCache, service, region, endpoint string, newClient func() (T, error)) (T, error) {
+	if cache == nil {
+		return newClient()
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	key := clientCacheKey{
+		service:  service,
+		region:   region,
+		endpoint: endpoint,
+	}
+	if client, ok := cache.clients[key].(T); ok {
+		return client, nil
+	}
+	client, err := newClient()
+	if err != nil {
+		return client, err
+	}
+	cache.clients[key] = client
+	return client, nil
+}
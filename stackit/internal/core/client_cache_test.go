@@ -0,0 +1,135 @@
+package core
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+type testClient struct {
+	endpoint string
+}
+
+func TestCachedClient(t *testing.T) {
+	tests := []struct {
+		description     string
+		cache           *ClientCache
+		calls           []clientCacheKey
+		expectedClients int
+	}{
+		{
+			description: "same client",
+			cache:       NewClientCache(),
+			calls: []clientCacheKey{
+				{service: "iaas"},
+				{service: "iaas"},
+			},
+			expectedClients: 1,
+		},
+		{
+			description: "different services",
+			cache:       NewClientCache(),
+			calls: []clientCacheKey{
+				{service: "iaas"},
+				{service: "dns"},
+			},
+			expectedClients: 2,
+		},
+		{
+			description: "different regions and endpoints",
+			cache:       NewClientCache(),
+			calls: []clientCacheKey{
+				{service: "redis", region: "eu01"},
+				{service: "redis", region: "eu02"},
+				{service: "redis", region: "eu01", endpoint: "https://redis.example.com"},
+				{service: "redis", region: "eu01"},
+			},
+			expectedClients: 3,
+		},
+		{
+			description: "no cache",
+			cache:       nil,
+			calls: []clientCacheKey{
+				{service: "iaas"},
+				{service: "iaas"},
+			},
+			expectedClients: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			created := 0
+			for _, call := range tt.calls {
+				client, err := CachedClient(tt.cache, call.service, call.region, call.endpoint, func() (*testClient, error) {
+					created++
+					return &testClient{endpoint: call.endpoint}, nil
+				})
+				if err != nil {
+					t.Fatalf("Should not have failed: %v", err)
+				}
+				if client.endpoint != call.endpoint {
+					t.Fatalf("Expected client for endpoint %q, got %q", call.endpoint, client.endpoint)
+				}
+			}
+			if created != tt.expectedClients {
+				t.Fatalf("Expected %d clients to be created, got %d", tt.expectedClients, created)
+			}
+		})
+	}
+}
+
+func TestCachedClientError(t *testing.T) {
+	cache := NewClientCache()
+	_, err := CachedClient(cache, "iaas", "", "", func() (*testClient, error) {
+		return nil, fmt.Errorf("invalid configuration")
+	})
+	if err == nil {
+		t.Fatalf("Should have failed")
+	}
+
+	// failed clients aren't cached
+	client, err := CachedClient(cache, "iaas", "", "", func() (*testClient, error) {
+		return &testClient{}, nil
+	})
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	if client == nil {
+		t.Fatalf("Expected a client")
+	}
+}
+
+func TestCachedClientConcurrent(t *testing.T) {
+	cache := NewClientCache()
+	var mu sync.Mutex
+	created := 0
+
+	var wg sync.WaitGroup
+	clients := make([]*testClient, 100)
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			client, err := CachedClient(cache, "iaas", "", "", func() (*testClient, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				created++
+				return &testClient{}, nil
+			})
+			if err != nil {
+				t.Errorf("Should not have failed: %v", err)
+			}
+			clients[i] = client
+		}(i)
+	}
+	wg.Wait()
+
+	if created != 1 {
+		t.Fatalf("Expected 1 client to be created, got %d", created)
+	}
+	for _, client := range clients {
+		if client != clients[0] {
+			t.Fatalf("Expected all resources to share the same client")
+		}
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stackitcloud/stackit-sdk-go/core/runtime"
 
@@ -43,6 +45,8 @@ type ProviderData struct {
 	// Deprecated: Use DefaultRegion instead
 	Region                          string
 	DefaultRegion                   string
+	NamePrefix                      string
+	DefaultLabels                   map[string]string
 	AuthorizationCustomEndpoint     string
 	CdnCustomEndpoint               string
 	DnsCustomEndpoint               string
@@ -72,21 +76,30 @@ type ProviderData struct {
 	ServiceAccountCustomEndpoint    string
 	EnableBetaResources             bool
 	Experiments                     []string
+	Wait                            WaitSettings
+	Clients                         *ClientCache // shared by copies of the provider data, nil if clients aren't cached
 
 	Version string // version of the STACKIT Terraform provider
 }
 
 // GetRegion returns the effective region for the provider, falling back to the deprecated _region_ attribute
 func (pd *ProviderData) GetRegion() string {
-	if pd.DefaultRegion != "" {
-		return pd.DefaultRegion
-	} else if pd.Region != "" {
-		return pd.Region
+	if region := pd.GetConfiguredRegion(); region != "" {
+		return region
 	}
 	// final fallback
 	return "eu01"
 }
 
+// GetConfiguredRegion returns the region configured in the provider, falling back to the deprecated _region_ attribute.
+// Unlike GetRegion, it doesn't fall back to a default region, so it is empty if no region is configured at all.
+func (pd *ProviderData) GetConfiguredRegion() string {
+	if pd.DefaultRegion != "" {
+		return pd.DefaultRegion
+	}
+	return pd.Region
+}
+
 func (pd *ProviderData) GetRegionWithOverride(overrideRegion types.String) string {
 	if overrideRegion.IsUnknown() || overrideRegion.IsNull() {
 		return pd.GetRegion()
@@ -94,6 +107,91 @@ func (pd *ProviderData) GetRegionWithOverride(overrideRegion types.String) strin
 	return overrideRegion.ValueString()
 }
 
+// AddNamePrefix prepends the provider-level name prefix to the given resource name. A nil name is returned unchanged.
+func (pd *ProviderData) AddNamePrefix(name *string) *string {
+	if name == nil || pd.NamePrefix == "" {
+		return name
+	}
+	prefixedName := pd.NamePrefix + *name
+	return &prefixedName
+}
+
+// RemoveNamePrefix strips the provider-level name prefix from a resource name returned by the API,
+// so that it matches the name in the Terraform configuration.
+func (pd *ProviderData) RemoveNamePrefix(name types.String) types.String {
+	if name.IsNull() || name.IsUnknown() || pd.NamePrefix == "" {
+		return name
+	}
+	return types.StringValue(strings.TrimPrefix(name.ValueString(), pd.NamePrefix))
+}
+
+// MergeDefaultLabels adds the provider-level default labels to the labels of an API payload.
+// Labels set on resource level take precedence. A nil value marks a label for removal in partial
+// update payloads; if a default label with the same key exists, the default value is used instead.
+func (pd *ProviderData) MergeDefaultLabels(labels *map[string]interface{}) *map[string]interface{} { //nolint:gocritic // pointer type matches the API payloads
+	if len(pd.DefaultLabels) == 0 {
+		return labels
+	}
+
+	merged := make(map[string]interface{}, len(pd.DefaultLabels))
+	for k, v := range pd.DefaultLabels {
+		merged[k] = v
+	}
+	if labels != nil {
+		for k, v := range *labels {
+			if _, isDefault := pd.DefaultLabels[k]; isDefault && v == nil {
+				continue
+			}
+			merged[k] = v
+		}
+	}
+	return &merged
+}
+
+// MergeDefaultStringLabels adds the provider-level default labels to the labels of an API payload
+// with string labels, e.g. of the resource manager. Labels set on resource level take precedence.
+func (pd *ProviderData) MergeDefaultStringLabels(labels *map[string]string) *map[string]string { //nolint:gocritic // pointer type matches the API payloads
+	if len(pd.DefaultLabels) == 0 {
+		return labels
+	}
+
+	merged := make(map[string]string, len(pd.DefaultLabels))
+	for k, v := range pd.DefaultLabels {
+		merged[k] = v
+	}
+	if labels != nil {
+		for k, v := range *labels {
+			merged[k] = v
+		}
+	}
+	return &merged
+}
+
+// RemoveDefaultLabels removes the provider-level default labels from the labels returned by the API,
+// so that they don't show up as a diff to the labels in the Terraform configuration. Default labels
+// which are also contained in priorLabels (from plan or state) are kept.
+func (pd *ProviderData) RemoveDefaultLabels(labels, priorLabels types.Map) types.Map {
+	if len(pd.DefaultLabels) == 0 || labels.IsNull() || labels.IsUnknown() {
+		return labels
+	}
+
+	priorElements := priorLabels.Elements()
+	elements := map[string]attr.Value{}
+	for k, v := range labels.Elements() {
+		if defaultValue, isDefault := pd.DefaultLabels[k]; isDefault && v.Equal(types.StringValue(defaultValue)) {
+			if _, isPrior := priorElements[k]; !isPrior {
+				continue
+			}
+		}
+		elements[k] = v
+	}
+
+	if len(elements) == 0 && priorLabels.IsNull() {
+		return types.MapNull(types.StringType)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
 // DiagsToError Converts TF diagnostics' errors into an error with a human-readable description.
 // If there are no errors, the output is nil
 func DiagsToError(diags diag.Diagnostics) error {
@@ -121,6 +219,7 @@ func LogAndAddError(ctx context.Context, diags *diag.Diagnostics, summary, detai
 
 	tflog.Error(ctx, fmt.Sprintf("%s | %s", summary, detail))
 	diags.AddError(summary, detail)
+	recordDiagnostic(ctx, diag.SeverityError, summary, detail)
 }
 
 // LogAndAddWarning Logs the warning and adds it to the diags
@@ -131,6 +230,15 @@ func LogAndAddWarning(ctx context.Context, diags *diag.Diagnostics, summary, det
 
 	tflog.Warn(ctx, fmt.Sprintf("%s | %s", summary, detail))
 	diags.AddWarning(summary, detail)
+	recordDiagnostic(ctx, diag.SeverityWarning, summary, detail)
+}
+
+// RemoveFromStateWithWarning removes a resource which no longer exists from the state and warns about it,
+// so it's visible to the user that the resource was removed outside of Terraform and is recreated on the next apply.
+func RemoveFromStateWithWarning(ctx context.Context, resp *resource.ReadResponse, resourceType, id string) {
+	resp.State.RemoveResource(ctx)
+	LogAndAddWarning(ctx, &resp.Diagnostics, fmt.Sprintf("The %s %q was removed outside of Terraform", resourceType, id),
+		fmt.Sprintf("The %s %q no longer exists and is removed from the state. It's created again on the next apply.", resourceType, id))
 }
 
 func LogAndAddWarningBeta(ctx context.Context, diags *diag.Diagnostics, name string, resourceType ResourceType) {
@@ -138,6 +246,7 @@ func LogAndAddWarningBeta(ctx context.Context, diags *diag.Diagnostics, name str
 	warnContent := fmt.Sprintf("The %s %q is in beta and may be subject to breaking changes in the future. Use with caution.", resourceType, name)
 	tflog.Warn(ctx, fmt.Sprintf("%s | %s", warnTitle, warnContent))
 	diags.AddWarning(warnTitle, warnContent)
+	recordDiagnostic(ctx, diag.SeverityWarning, warnTitle, warnContent)
 }
 
 func LogAndAddErrorBeta(ctx context.Context, diags *diag.Diagnostics, name string, resourceType ResourceType) {
@@ -145,6 +254,7 @@ func LogAndAddErrorBeta(ctx context.Context, diags *diag.Diagnostics, name strin
 	errContent := fmt.Sprintf(`The %s %q is in beta and the beta functionality is currently not enabled. To enable it, set the environment variable STACKIT_TF_ENABLE_BETA_RESOURCES to "true" or set the "enable_beta_resources" provider field to true.`, resourceType, name)
 	tflog.Error(ctx, fmt.Sprintf("%s | %s", errTitle, errContent))
 	diags.AddError(errTitle, errContent)
+	recordDiagnostic(ctx, diag.SeverityError, errTitle, errContent)
 }
 
 // InitProviderContext extends the context to capture the http response
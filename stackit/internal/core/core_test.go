@@ -1,9 +1,17 @@
 package core
 
 import (
+	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 )
 
 func TestProviderData_GetRegionWithOverride(t *testing.T) {
@@ -98,3 +106,335 @@ func TestProviderData_GetRegion(t *testing.T) {
 		})
 	}
 }
+
+func TestProviderData_GetConfiguredRegion(t *testing.T) {
+	tests := []struct {
+		name         string
+		providerData *ProviderData
+		want         string
+	}{
+		{
+			name: "default region is set",
+			providerData: &ProviderData{
+				DefaultRegion: "eu02",
+			},
+			want: "eu02",
+		},
+		{
+			name: "(legacy) region is set",
+			providerData: &ProviderData{
+				Region: "eu02",
+			},
+			want: "eu02",
+		},
+		{
+			name: "default region wins over (legacy) region",
+			providerData: &ProviderData{
+				DefaultRegion: "eu02",
+				Region:        "eu01",
+			},
+			want: "eu02",
+		},
+		{
+			name:         "no fallback - neither region (legacy) nor default region is set",
+			providerData: &ProviderData{},
+			want:         "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.providerData.GetConfiguredRegion(); got != tt.want {
+				t.Errorf("GetConfiguredRegion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProviderData_AddNamePrefix(t *testing.T) {
+	name := "my-network"
+	tests := []struct {
+		name         string
+		providerData *ProviderData
+		input        *string
+		want         *string
+	}{
+		{
+			name: "prefix is set",
+			providerData: &ProviderData{
+				NamePrefix: "dev-",
+			},
+			input: &name,
+			want:  utils.Ptr("dev-my-network"),
+		},
+		{
+			name:         "prefix is not set",
+			providerData: &ProviderData{},
+			input:        &name,
+			want:         &name,
+		},
+		{
+			name: "name is nil",
+			providerData: &ProviderData{
+				NamePrefix: "dev-",
+			},
+			input: nil,
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.providerData.AddNamePrefix(tt.input)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("AddNamePrefix() mismatch (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProviderData_RemoveNamePrefix(t *testing.T) {
+	tests := []struct {
+		name         string
+		providerData *ProviderData
+		input        types.String
+		want         types.String
+	}{
+		{
+			name: "prefixed name",
+			providerData: &ProviderData{
+				NamePrefix: "dev-",
+			},
+			input: types.StringValue("dev-my-network"),
+			want:  types.StringValue("my-network"),
+		},
+		{
+			name: "name without prefix",
+			providerData: &ProviderData{
+				NamePrefix: "dev-",
+			},
+			input: types.StringValue("prd-my-network"),
+			want:  types.StringValue("prd-my-network"),
+		},
+		{
+			name:         "prefix is not set",
+			providerData: &ProviderData{},
+			input:        types.StringValue("dev-my-network"),
+			want:         types.StringValue("dev-my-network"),
+		},
+		{
+			name: "null name",
+			providerData: &ProviderData{
+				NamePrefix: "dev-",
+			},
+			input: types.StringNull(),
+			want:  types.StringNull(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.providerData.RemoveNamePrefix(tt.input); !got.Equal(tt.want) {
+				t.Errorf("RemoveNamePrefix() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProviderData_MergeDefaultLabels(t *testing.T) {
+	tests := []struct {
+		name         string
+		providerData *ProviderData
+		input        *map[string]interface{}
+		want         *map[string]interface{}
+	}{
+		{
+			name:         "no default labels",
+			providerData: &ProviderData{},
+			input:        &map[string]interface{}{"foo": "bar"},
+			want:         &map[string]interface{}{"foo": "bar"},
+		},
+		{
+			name: "default labels are added",
+			providerData: &ProviderData{
+				DefaultLabels: map[string]string{"team": "platform"},
+			},
+			input: &map[string]interface{}{"foo": "bar"},
+			want:  &map[string]interface{}{"foo": "bar", "team": "platform"},
+		},
+		{
+			name: "resource labels take precedence",
+			providerData: &ProviderData{
+				DefaultLabels: map[string]string{"team": "platform"},
+			},
+			input: &map[string]interface{}{"team": "data"},
+			want:  &map[string]interface{}{"team": "data"},
+		},
+		{
+			name: "removed resource label falls back to default",
+			providerData: &ProviderData{
+				DefaultLabels: map[string]string{"team": "platform"},
+			},
+			input: &map[string]interface{}{"team": nil, "foo": nil},
+			want:  &map[string]interface{}{"team": "platform", "foo": nil},
+		},
+		{
+			name: "nil labels",
+			providerData: &ProviderData{
+				DefaultLabels: map[string]string{"team": "platform"},
+			},
+			input: nil,
+			want:  &map[string]interface{}{"team": "platform"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.providerData.MergeDefaultLabels(tt.input)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("MergeDefaultLabels() mismatch (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProviderData_MergeDefaultStringLabels(t *testing.T) {
+	tests := []struct {
+		name         string
+		providerData *ProviderData
+		input        *map[string]string
+		want         *map[string]string
+	}{
+		{
+			name:         "no default labels",
+			providerData: &ProviderData{},
+			input:        &map[string]string{"foo": "bar"},
+			want:         &map[string]string{"foo": "bar"},
+		},
+		{
+			name: "default labels are added",
+			providerData: &ProviderData{
+				DefaultLabels: map[string]string{"team": "platform"},
+			},
+			input: &map[string]string{"foo": "bar"},
+			want:  &map[string]string{"foo": "bar", "team": "platform"},
+		},
+		{
+			name: "resource labels take precedence",
+			providerData: &ProviderData{
+				DefaultLabels: map[string]string{"team": "platform"},
+			},
+			input: &map[string]string{"team": "data"},
+			want:  &map[string]string{"team": "data"},
+		},
+		{
+			name: "nil labels",
+			providerData: &ProviderData{
+				DefaultLabels: map[string]string{"team": "platform"},
+			},
+			input: nil,
+			want:  &map[string]string{"team": "platform"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.providerData.MergeDefaultStringLabels(tt.input)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("MergeDefaultStringLabels() mismatch (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProviderData_RemoveDefaultLabels(t *testing.T) {
+	defaultLabels := map[string]string{"team": "platform"}
+	tests := []struct {
+		name         string
+		providerData *ProviderData
+		labels       types.Map
+		priorLabels  types.Map
+		want         types.Map
+	}{
+		{
+			name:         "no default labels",
+			providerData: &ProviderData{},
+			labels:       types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("platform")}),
+			priorLabels:  types.MapNull(types.StringType),
+			want:         types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("platform")}),
+		},
+		{
+			name:         "default label is removed",
+			providerData: &ProviderData{DefaultLabels: defaultLabels},
+			labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"team": types.StringValue("platform"),
+				"foo":  types.StringValue("bar"),
+			}),
+			priorLabels: types.MapValueMust(types.StringType, map[string]attr.Value{"foo": types.StringValue("bar")}),
+			want:        types.MapValueMust(types.StringType, map[string]attr.Value{"foo": types.StringValue("bar")}),
+		},
+		{
+			name:         "only default labels and no prior labels",
+			providerData: &ProviderData{DefaultLabels: defaultLabels},
+			labels:       types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("platform")}),
+			priorLabels:  types.MapNull(types.StringType),
+			want:         types.MapNull(types.StringType),
+		},
+		{
+			name:         "default label also set on resource level is kept",
+			providerData: &ProviderData{DefaultLabels: defaultLabels},
+			labels:       types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("platform")}),
+			priorLabels:  types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("platform")}),
+			want:         types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("platform")}),
+		},
+		{
+			name:         "overridden default label is kept",
+			providerData: &ProviderData{DefaultLabels: defaultLabels},
+			labels:       types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("data")}),
+			priorLabels:  types.MapNull(types.StringType),
+			want:         types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("data")}),
+		},
+		{
+			name:         "null labels",
+			providerData: &ProviderData{DefaultLabels: defaultLabels},
+			labels:       types.MapNull(types.StringType),
+			priorLabels:  types.MapNull(types.StringType),
+			want:         types.MapNull(types.StringType),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.providerData.RemoveDefaultLabels(tt.labels, tt.priorLabels); !got.Equal(tt.want) {
+				t.Errorf("RemoveDefaultLabels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveFromStateWithWarning(t *testing.T) {
+	stateType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+	resp := &resource.ReadResponse{
+		State: tfsdk.State{
+			Schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{Computed: true},
+				},
+			},
+			Raw: tftypes.NewValue(stateType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "pid,iid"),
+			}),
+		},
+	}
+
+	RemoveFromStateWithWarning(context.Background(), resp, "stackit_example", "pid,iid")
+
+	if !resp.State.Raw.IsNull() {
+		t.Fatalf("Expected resource to be removed from state, got %s", resp.State.Raw)
+	}
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+	}
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 {
+		t.Fatalf("Expected 1 warning, got %d", len(warnings))
+	}
+	expected := `The stackit_example "pid,iid" was removed outside of Terraform`
+	if warnings[0].Summary() != expected {
+		t.Fatalf("Expected warning %q, got %q", expected, warnings[0].Summary())
+	}
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DiagnosticsFileEnvVar is the env var with the path of a file, to which all warnings and errors of the provider are appended,
+// so that CI systems can evaluate them after Terraform finished. Each line of the file is a JSON object.
+const DiagnosticsFileEnvVar = "STACKIT_TF_DIAGNOSTICS_FILE"
+
+// diagnosticRecord is a line of the diagnostics file.
+type diagnosticRecord struct {
+	Time     time.Time `json:"time"`
+	Severity string    `json:"severity"`
+	Summary  string    `json:"summary"`
+	Detail   string    `json:"detail"`
+}
+
+// diagnosticsFileMutex serializes the writes of concurrent operations to the diagnostics file.
+var diagnosticsFileMutex sync.Mutex
+
+// recordDiagnostic appends the diagnostic to the diagnostics file, if it is configured.
+// The file is optional, so failures to write it are logged, but don't fail the operation.
+func recordDiagnostic(ctx context.Context, severity diag.Severity, summary, detail string) {
+	path := os.Getenv(DiagnosticsFileEnvVar)
+	if path == "" {
+		return
+	}
+	if err := appendDiagnostic(path, diagnosticRecord{
+		Time:     time.Now().UTC(),
+		Severity: severity.String(),
+		Summary:  summary,
+		Detail:   detail,
+	}); err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Writing diagnostics file %q: %v", path, err))
+	}
+}
+
+func appendDiagnostic(path string, record diagnosticRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("encoding diagnostic: %w", err)
+	}
+
+	diagnosticsFileMutex.Lock()
+	defer diagnosticsFileMutex.Unlock()
+
+	// Appending keeps the diagnostics of all Terraform commands and provider processes of a CI run
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(append(line, '\n'))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,74 @@
+package core
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func readDiagnosticsFile(t *testing.T, path string) []diagnosticRecord {
+	t.Helper()
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Opening diagnostics file: %v", err)
+	}
+	defer file.Close()
+
+	var records []diagnosticRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record diagnosticRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("Line %q isn't valid JSON: %v", scanner.Text(), err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestDiagnosticsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "diagnostics.jsonl")
+	t.Setenv(DiagnosticsFileEnvVar, path)
+
+	var diags diag.Diagnostics
+	LogAndAddWarning(context.Background(), &diags, "Warning summary", "Warning detail")
+	LogAndAddError(context.Background(), &diags, "Error summary", "Error detail")
+	LogAndAddWarningBeta(context.Background(), &diags, "stackit_example", Resource)
+
+	expected := []diagnosticRecord{
+		{Severity: "Warning", Summary: "Warning summary", Detail: "Warning detail"},
+		{Severity: "Error", Summary: "Error summary", Detail: "Error detail"},
+		{Severity: "Warning", Summary: `The resource "stackit_example" is in beta`, Detail: `The resource "stackit_example" is in beta and may be subject to breaking changes in the future. Use with caution.`},
+	}
+	records := readDiagnosticsFile(t, path)
+	diff := cmp.Diff(records, expected, cmpopts.IgnoreFields(diagnosticRecord{}, "Time"))
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+	for _, record := range records {
+		if record.Time.IsZero() {
+			t.Fatalf("Time of %q isn't set", record.Summary)
+		}
+	}
+	if len(diags) != len(expected) {
+		t.Fatalf("Expected %d diagnostics, got %d", len(expected), len(diags))
+	}
+}
+
+func TestDiagnosticsFileNotWritable(t *testing.T) {
+	t.Setenv(DiagnosticsFileEnvVar, filepath.Join(t.TempDir(), "missing", "diagnostics.jsonl"))
+
+	var diags diag.Diagnostics
+	LogAndAddWarning(context.Background(), &diags, "Warning summary", "Warning detail")
+
+	if len(diags) != 1 || diags.HasError() {
+		t.Fatalf("Expected only the warning, got %v", diags)
+	}
+}
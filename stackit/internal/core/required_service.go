@@ -0,0 +1,116 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/serviceenablement"
+	"github.com/stackitcloud/stackit-sdk-go/services/serviceenablement/wait"
+)
+
+// ServiceEnablementClient is the part of the service enablement API needed to check and enable the services resources depend on.
+type ServiceEnablementClient interface {
+	GetServiceStatusRegionalExecute(ctx context.Context, region, projectId, serviceId string) (*serviceenablement.ServiceStatus, error)
+	EnableServiceRegionalExecute(ctx context.Context, region, projectId, serviceId string) error
+}
+
+// RequiredService is a service which has to be enabled in a project, before a resource can be managed there.
+// Resources create it in Configure and make their first API call in a project, usually in Create, with CallWithRequiredService.
+type RequiredService struct {
+	client      ServiceEnablementClient
+	serviceId   string
+	displayName string
+	autoEnable  bool
+	wait        WaitSettings
+}
+
+// NewRequiredService returns the service with the given ID, e.g. "cloud.stackit.ske".
+// If autoEnable is set, the service is enabled on demand, otherwise resources fail if it isn't enabled yet.
+// The wait settings apply while waiting for the service to be enabled.
+func NewRequiredService(client ServiceEnablementClient, serviceId, displayName string, autoEnable bool, waitSettings WaitSettings) *RequiredService {
+	return &RequiredService{
+		client:      client,
+		serviceId:   serviceId,
+		displayName: displayName,
+		autoEnable:  autoEnable,
+		wait:        waitSettings,
+	}
+}
+
+// Enable enables the service in the project and region and waits until it is enabled, if it isn't enabled yet.
+// It is meant for resources which manage the enablement itself, resources depending on the service use CallWithRequiredService.
+func (s *RequiredService) Enable(ctx context.Context, projectId, region string) error {
+	enabled, err := s.isEnabled(ctx, projectId, region)
+	if err != nil || enabled {
+		return err
+	}
+	return s.enable(ctx, projectId, region)
+}
+
+// CallWithRequiredService calls the API of a service, which has to be enabled in the project and region.
+// Instead of checking the service upfront like Enable, the call is made right away. Only if it fails, as the service isn't enabled,
+// the service is enabled like in Enable and the call is retried once.
+func CallWithRequiredService[T any](ctx context.Context, s *RequiredService, projectId, region string, call func() (T, error)) (T, error) {
+	resp, err := call()
+	if !mayBeNotEnabled(err) {
+		return resp, err
+	}
+	// The APIs don't return a dedicated error if the service isn't enabled, so its status is checked
+	enabled, statusErr := s.isEnabled(ctx, projectId, region)
+	if statusErr != nil || enabled {
+		return resp, err
+	}
+
+	err = s.enable(ctx, projectId, region)
+	if err != nil {
+		return resp, err
+	}
+	tflog.Info(ctx, fmt.Sprintf("Retrying call after enabling %s", s.displayName), map[string]any{"service_id": s.serviceId})
+	return call()
+}
+
+// mayBeNotEnabled reports whether an API error may be caused by the service not being enabled in the project.
+func mayBeNotEnabled(err error) bool {
+	var oapiErr *oapierror.GenericOpenAPIError
+	if !errors.As(err, &oapiErr) {
+		return false
+	}
+	return oapiErr.StatusCode == http.StatusForbidden || oapiErr.StatusCode == http.StatusNotFound
+}
+
+func (s *RequiredService) isEnabled(ctx context.Context, projectId, region string) (bool, error) {
+	status, err := s.client.GetServiceStatusRegionalExecute(ctx, region, projectId, s.serviceId)
+	if err != nil {
+		return false, s.wrapError(region, "checking status of", err)
+	}
+	return status != nil && status.GetState() == serviceenablement.SERVICESTATUSSTATE_ENABLED, nil
+}
+
+func (s *RequiredService) enable(ctx context.Context, projectId, region string) error {
+	if !s.autoEnable {
+		return fmt.Errorf("%s is not enabled in project %s and region %s, enable it before managing this resource", s.displayName, projectId, region)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Enabling %s", s.displayName), map[string]any{"service_id": s.serviceId})
+	err := s.client.EnableServiceRegionalExecute(ctx, region, projectId, s.serviceId)
+	if err != nil {
+		return s.wrapError(region, "enabling", err)
+	}
+	_, err = ConfigureWaitHandler(wait.EnableServiceWaitHandler(ctx, s.client, region, projectId, s.serviceId), s.wait, WaitCreate).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("waiting for %s to be enabled: %w", s.displayName, err)
+	}
+	return nil
+}
+
+func (s *RequiredService) wrapError(region, action string, err error) error {
+	var oapiErr *oapierror.GenericOpenAPIError
+	if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%s not available in region %s: %w", s.displayName, region, err)
+	}
+	return fmt.Errorf("%s %s: %w", action, s.displayName, err)
+}
@@ -0,0 +1,161 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/serviceenablement"
+)
+
+type serviceEnablementClientMocked struct {
+	state       serviceenablement.ServiceStatusState
+	getFails    bool
+	errorCode   int
+	enableCalls int
+}
+
+func (c *serviceEnablementClientMocked) GetServiceStatusRegionalExecute(_ context.Context, _, _, _ string) (*serviceenablement.ServiceStatus, error) {
+	if c.getFails {
+		return nil, &oapierror.GenericOpenAPIError{StatusCode: c.errorCode}
+	}
+	return &serviceenablement.ServiceStatus{State: c.state.Ptr()}, nil
+}
+
+func (c *serviceEnablementClientMocked) EnableServiceRegionalExecute(_ context.Context, _, _, _ string) error {
+	c.enableCalls++
+	return &oapierror.GenericOpenAPIError{StatusCode: http.StatusForbidden}
+}
+
+func TestRequiredServiceEnable(t *testing.T) {
+	tests := []struct {
+		description     string
+		client          *serviceEnablementClientMocked
+		autoEnable      bool
+		expectedEnables int
+		isValid         bool
+	}{
+		{
+			description: "already enabled",
+			client:      &serviceEnablementClientMocked{state: serviceenablement.SERVICESTATUSSTATE_ENABLED},
+			autoEnable:  true,
+			isValid:     true,
+		},
+		{
+			description: "disabled without auto-enable",
+			client:      &serviceEnablementClientMocked{state: serviceenablement.SERVICESTATUSSTATE_DISABLED},
+			autoEnable:  false,
+			isValid:     false,
+		},
+		{
+			description:     "disabled with auto-enable, enabling fails",
+			client:          &serviceEnablementClientMocked{state: serviceenablement.SERVICESTATUSSTATE_DISABLED},
+			autoEnable:      true,
+			expectedEnables: 1,
+			isValid:         false,
+		},
+		{
+			description: "not available in region",
+			client:      &serviceEnablementClientMocked{getFails: true, errorCode: http.StatusNotFound},
+			autoEnable:  true,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			s := NewRequiredService(tt.client, "cloud.stackit.example", "Example service", tt.autoEnable, WaitSettings{})
+			err := s.Enable(context.Background(), "pid", "eu01")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.client.enableCalls != tt.expectedEnables {
+				t.Fatalf("expected %d enable calls, got %d", tt.expectedEnables, tt.client.enableCalls)
+			}
+		})
+	}
+}
+
+func TestCallWithRequiredService(t *testing.T) {
+	tests := []struct {
+		description     string
+		client          *serviceEnablementClientMocked
+		autoEnable      bool
+		callErrorCode   int
+		expectedCalls   int
+		expectedEnables int
+		isValid         bool
+	}{
+		{
+			description:   "call succeeds",
+			client:        &serviceEnablementClientMocked{getFails: true},
+			autoEnable:    true,
+			expectedCalls: 1,
+			isValid:       true,
+		},
+		{
+			description:   "call fails, unrelated error",
+			client:        &serviceEnablementClientMocked{getFails: true},
+			autoEnable:    true,
+			callErrorCode: http.StatusBadRequest,
+			expectedCalls: 1,
+			isValid:       false,
+		},
+		{
+			description:   "call fails, service enabled",
+			client:        &serviceEnablementClientMocked{state: serviceenablement.SERVICESTATUSSTATE_ENABLED},
+			autoEnable:    true,
+			callErrorCode: http.StatusForbidden,
+			expectedCalls: 1,
+			isValid:       false,
+		},
+		{
+			description:   "call fails, disabled without auto-enable",
+			client:        &serviceEnablementClientMocked{state: serviceenablement.SERVICESTATUSSTATE_DISABLED},
+			autoEnable:    false,
+			callErrorCode: http.StatusNotFound,
+			expectedCalls: 1,
+			isValid:       false,
+		},
+		{
+			description:     "call fails, disabled with auto-enable, enabling fails",
+			client:          &serviceEnablementClientMocked{state: serviceenablement.SERVICESTATUSSTATE_DISABLED},
+			autoEnable:      true,
+			callErrorCode:   http.StatusForbidden,
+			expectedCalls:   1,
+			expectedEnables: 1,
+			isValid:         false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			s := NewRequiredService(tt.client, "cloud.stackit.example", "Example service", tt.autoEnable, WaitSettings{})
+			calls := 0
+			resp, err := CallWithRequiredService(context.Background(), s, "pid", "eu01", func() (string, error) {
+				calls++
+				if tt.callErrorCode != 0 {
+					return "", &oapierror.GenericOpenAPIError{StatusCode: tt.callErrorCode}
+				}
+				return "resp", nil
+			})
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid && resp != "resp" {
+				t.Fatalf("expected response %q, got %q", "resp", resp)
+			}
+			if calls != tt.expectedCalls {
+				t.Fatalf("expected %d calls, got %d", tt.expectedCalls, calls)
+			}
+			if tt.client.enableCalls != tt.expectedEnables {
+				t.Fatalf("expected %d enable calls, got %d", tt.expectedEnables, tt.client.enableCalls)
+			}
+		})
+	}
+}
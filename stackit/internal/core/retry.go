@@ -0,0 +1,155 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultMaxRetries is the default number of retries for transient API errors
+	DefaultMaxRetries = 3
+	// DefaultRetryWaitMax is the default maximum wait time between two retries
+	DefaultRetryWaitMax = 30 * time.Second
+
+	retryWaitMin = 1 * time.Second
+)
+
+// RetryRoundTripper retries requests which failed with a transient error.
+//
+// Idempotent requests are retried with exponential backoff and jitter if they were rate limited (429)
+// or failed with a gateway error (502, 503, 504). Other requests, e.g. POST, are never retried,
+// as they could create a resource twice.
+type RetryRoundTripper struct {
+	next         http.RoundTripper
+	maxRetries   int
+	retryWaitMin time.Duration
+	retryWaitMax time.Duration
+	// sleep waits for the given duration or until the context is done. Can be overwritten in tests.
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// NewRetryRoundTripper wraps the given round tripper with retries. A maxRetries of 0 disables retries.
+func NewRetryRoundTripper(next http.RoundTripper, maxRetries int, retryWaitMax time.Duration) *RetryRoundTripper {
+	waitMin := retryWaitMin
+	if retryWaitMax < waitMin {
+		waitMin = retryWaitMax
+	}
+	return &RetryRoundTripper{
+		next:         next,
+		maxRetries:   maxRetries,
+		retryWaitMin: waitMin,
+		retryWaitMax: retryWaitMax,
+		sleep:        sleepWithContext,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rt *RetryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 {
+			var err error
+			attemptReq, err = rewindRequest(req)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := rt.next.RoundTrip(attemptReq)
+		if err != nil || attempt >= rt.maxRetries || !isRetryable(req, resp) || !isRewindable(req) {
+			return resp, err
+		}
+
+		wait := rt.backoff(attempt, resp)
+		tflog.Warn(ctx, "Retrying request after transient error", map[string]interface{}{
+			"method":      req.Method,
+			"url":         req.URL.String(),
+			"status_code": resp.StatusCode,
+			"attempt":     attempt + 1,
+			"max_retries": rt.maxRetries,
+			"wait":        wait.String(),
+		})
+
+		// Drain and close the body, so the underlying connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		if err := rt.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// backoff returns the time to wait before the next attempt.
+// A valid Retry-After header takes precedence over the exponential backoff, both are capped at retryWaitMax.
+func (rt *RetryRoundTripper) backoff(attempt int, resp *http.Response) time.Duration {
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+		return min(time.Duration(seconds)*time.Second, rt.retryWaitMax)
+	}
+
+	wait := rt.retryWaitMax
+	if attempt < 32 {
+		wait = min(rt.retryWaitMin<<attempt, rt.retryWaitMax)
+	}
+	// Add jitter, so parallel requests don't retry at the same time
+	if half := int64(wait / 2); half > 0 {
+		wait = time.Duration(half + rand.Int64N(half+1)) //nolint:gosec // jitter doesn't need a secure random number
+	}
+	return wait
+}
+
+func isRetryable(req *http.Request, resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	default:
+		return false
+	}
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// isRewindable returns whether the request body can be sent again.
+func isRewindable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// rewindRequest returns a copy of the request with a fresh body, so it can be sent again.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	newReq := req.Clone(req.Context())
+	if req.Body == nil || req.GetBody == nil {
+		return newReq, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("rewind request body: %w", err)
+	}
+	newReq.Body = body
+	return newReq, nil
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,284 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetryRoundTripper(t *testing.T) {
+	tests := []struct {
+		description      string
+		method           string
+		body             string
+		statusCodes      []int
+		maxRetries       int
+		expectedStatus   int
+		expectedAttempts int
+	}{
+		{
+			description:      "success without retry",
+			method:           http.MethodGet,
+			statusCodes:      []int{http.StatusOK},
+			maxRetries:       3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 1,
+		},
+		{
+			description:      "get retried on gateway errors",
+			method:           http.MethodGet,
+			statusCodes:      []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout, http.StatusOK},
+			maxRetries:       3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 4,
+		},
+		{
+			description:      "put retried with body",
+			method:           http.MethodPut,
+			body:             `{"name":"example"}`,
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries:       3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 2,
+		},
+		{
+			description:      "delete retried when rate limited",
+			method:           http.MethodDelete,
+			statusCodes:      []int{http.StatusTooManyRequests, http.StatusAccepted},
+			maxRetries:       3,
+			expectedStatus:   http.StatusAccepted,
+			expectedAttempts: 2,
+		},
+		{
+			description:      "post not retried when rate limited",
+			method:           http.MethodPost,
+			body:             `{"name":"example"}`,
+			statusCodes:      []int{http.StatusTooManyRequests, http.StatusCreated},
+			maxRetries:       3,
+			expectedStatus:   http.StatusTooManyRequests,
+			expectedAttempts: 1,
+		},
+		{
+			description:      "patch not retried when rate limited",
+			method:           http.MethodPatch,
+			body:             `{"name":"example"}`,
+			statusCodes:      []int{http.StatusTooManyRequests, http.StatusOK},
+			maxRetries:       3,
+			expectedStatus:   http.StatusTooManyRequests,
+			expectedAttempts: 1,
+		},
+		{
+			description:      "post not retried on gateway error",
+			method:           http.MethodPost,
+			body:             `{"name":"example"}`,
+			statusCodes:      []int{http.StatusBadGateway, http.StatusCreated},
+			maxRetries:       3,
+			expectedStatus:   http.StatusBadGateway,
+			expectedAttempts: 1,
+		},
+		{
+			description:      "internal server error not retried",
+			method:           http.MethodGet,
+			statusCodes:      []int{http.StatusInternalServerError, http.StatusOK},
+			maxRetries:       3,
+			expectedStatus:   http.StatusInternalServerError,
+			expectedAttempts: 1,
+		},
+		{
+			description:      "client error not retried",
+			method:           http.MethodDelete,
+			statusCodes:      []int{http.StatusNotFound, http.StatusOK},
+			maxRetries:       3,
+			expectedStatus:   http.StatusNotFound,
+			expectedAttempts: 1,
+		},
+		{
+			description:      "retries exhausted",
+			method:           http.MethodGet,
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			maxRetries:       2,
+			expectedStatus:   http.StatusServiceUnavailable,
+			expectedAttempts: 3,
+		},
+		{
+			description:      "retries disabled",
+			method:           http.MethodGet,
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries:       0,
+			expectedStatus:   http.StatusServiceUnavailable,
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			attempts := 0
+			next := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				if req.Body != nil {
+					body, err := io.ReadAll(req.Body)
+					if err != nil {
+						t.Fatalf("reading body: %v", err)
+					}
+					if string(body) != tt.body {
+						t.Fatalf("attempt %d: expected body %q, got %q", attempts+1, tt.body, string(body))
+					}
+				}
+				statusCode := tt.statusCodes[attempts]
+				attempts++
+				return &http.Response{
+					StatusCode: statusCode,
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader("")),
+				}, nil
+			})
+
+			rt := NewRetryRoundTripper(next, tt.maxRetries, DefaultRetryWaitMax)
+			var waits []time.Duration
+			rt.sleep = func(_ context.Context, d time.Duration) error {
+				waits = append(waits, d)
+				return nil
+			}
+
+			var body io.Reader
+			if tt.body != "" {
+				body = bytes.NewBufferString(tt.body)
+			}
+			req, err := http.NewRequest(tt.method, "https://example.com", body)
+			if err != nil {
+				t.Fatalf("creating request: %v", err)
+			}
+
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.StatusCode != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+			if attempts != tt.expectedAttempts {
+				t.Fatalf("expected %d attempts, got %d", tt.expectedAttempts, attempts)
+			}
+			if len(waits) != tt.expectedAttempts-1 {
+				t.Fatalf("expected %d waits, got %d", tt.expectedAttempts-1, len(waits))
+			}
+		})
+	}
+}
+
+func TestRetryRoundTripperContextCanceled(t *testing.T) {
+	next := roundTripperFunc(func(_ *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	})
+	rt := NewRetryRoundTripper(next, 3, DefaultRetryWaitMax)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+
+	_, err = rt.RoundTrip(req)
+	if err == nil {
+		t.Fatalf("expected error, got none")
+	}
+}
+
+func TestRetryRoundTripperBackoff(t *testing.T) {
+	tests := []struct {
+		description  string
+		attempt      int
+		retryAfter   string
+		retryWaitMax time.Duration
+		expectedMin  time.Duration
+		expectedMax  time.Duration
+	}{
+		{
+			description:  "first attempt",
+			attempt:      0,
+			retryWaitMax: 30 * time.Second,
+			expectedMin:  500 * time.Millisecond,
+			expectedMax:  1 * time.Second,
+		},
+		{
+			description:  "third attempt",
+			attempt:      2,
+			retryWaitMax: 30 * time.Second,
+			expectedMin:  2 * time.Second,
+			expectedMax:  4 * time.Second,
+		},
+		{
+			description:  "capped at retry_wait_max",
+			attempt:      10,
+			retryWaitMax: 30 * time.Second,
+			expectedMin:  15 * time.Second,
+			expectedMax:  30 * time.Second,
+		},
+		{
+			description:  "huge attempt",
+			attempt:      100,
+			retryWaitMax: 30 * time.Second,
+			expectedMin:  15 * time.Second,
+			expectedMax:  30 * time.Second,
+		},
+		{
+			description:  "retry after header",
+			attempt:      0,
+			retryAfter:   "5",
+			retryWaitMax: 30 * time.Second,
+			expectedMin:  5 * time.Second,
+			expectedMax:  5 * time.Second,
+		},
+		{
+			description:  "retry after header capped at retry_wait_max",
+			attempt:      0,
+			retryAfter:   "120",
+			retryWaitMax: 30 * time.Second,
+			expectedMin:  30 * time.Second,
+			expectedMax:  30 * time.Second,
+		},
+		{
+			description:  "invalid retry after header",
+			attempt:      0,
+			retryAfter:   "soon",
+			retryWaitMax: 30 * time.Second,
+			expectedMin:  500 * time.Millisecond,
+			expectedMax:  1 * time.Second,
+		},
+		{
+			description:  "retry_wait_max lower than minimum wait",
+			attempt:      0,
+			retryWaitMax: 100 * time.Millisecond,
+			expectedMin:  50 * time.Millisecond,
+			expectedMax:  100 * time.Millisecond,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			rt := NewRetryRoundTripper(http.DefaultTransport, 3, tt.retryWaitMax)
+			resp := &http.Response{Header: http.Header{}}
+			if tt.retryAfter != "" {
+				resp.Header.Set("Retry-After", tt.retryAfter)
+			}
+			wait := rt.backoff(tt.attempt, resp)
+			if wait < tt.expectedMin || wait > tt.expectedMax {
+				t.Fatalf("expected wait between %s and %s, got %s", tt.expectedMin, tt.expectedMax, wait)
+			}
+		})
+	}
+}
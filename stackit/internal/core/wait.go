@@ -0,0 +1,61 @@
+package core
+
+import (
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+)
+
+// WaitOperation is the operation on a resource which a wait handler waits for.
+type WaitOperation int
+
+const (
+	WaitCreate WaitOperation = iota
+	WaitUpdate
+	WaitDelete
+)
+
+// WaitSettings configures how often and how long the wait handlers of the SDK poll the API.
+// Zero values keep the settings of the wait handlers.
+type WaitSettings struct {
+	PollInterval  time.Duration
+	CreateTimeout time.Duration
+	UpdateTimeout time.Duration
+	DeleteTimeout time.Duration
+}
+
+func (s WaitSettings) timeout(operation WaitOperation) time.Duration {
+	switch operation {
+	case WaitCreate:
+		return s.CreateTimeout
+	case WaitUpdate:
+		return s.UpdateTimeout
+	case WaitDelete:
+		return s.DeleteTimeout
+	default:
+		return 0
+	}
+}
+
+// ConfigureWaitHandler applies the wait settings of the provider to a wait handler of the SDK.
+// A configured timeout replaces the default timeout of the wait handler. Resources which need a specific timeout
+// must use ConfigureWaitHandlerWithTimeout instead.
+func ConfigureWaitHandler[T any](handler *wait.AsyncActionHandler[T], settings WaitSettings, operation WaitOperation) *wait.AsyncActionHandler[T] {
+	if settings.PollInterval > 0 {
+		handler.SetThrottle(settings.PollInterval)
+	}
+	if timeout := settings.timeout(operation); timeout > 0 {
+		handler.SetTimeout(timeout)
+	}
+	return handler
+}
+
+// ConfigureWaitHandlerWithTimeout applies the wait settings of the provider to a wait handler of the SDK,
+// for resources which set their own timeout because the operation is known to take long.
+// The larger of the timeout of the resource and the configured timeout is used, so a configured timeout never
+// shortens the timeout of the resource.
+func ConfigureWaitHandlerWithTimeout[T any](handler *wait.AsyncActionHandler[T], settings WaitSettings, operation WaitOperation, timeout time.Duration) *wait.AsyncActionHandler[T] {
+	handler = ConfigureWaitHandler(handler, settings, operation)
+	handler.SetTimeout(max(timeout, settings.timeout(operation)))
+	return handler
+}
@@ -0,0 +1,113 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+)
+
+func TestConfigureWaitHandler(t *testing.T) {
+	tests := []struct {
+		description string
+		settings    WaitSettings
+		operation   WaitOperation
+		timesOut    bool
+	}{
+		{
+			description: "timeout of the handler",
+			settings:    WaitSettings{PollInterval: 10 * time.Millisecond},
+			operation:   WaitCreate,
+			timesOut:    false,
+		},
+		{
+			description: "timeout of the operation",
+			settings:    WaitSettings{PollInterval: 10 * time.Millisecond, CreateTimeout: 50 * time.Millisecond},
+			operation:   WaitCreate,
+			timesOut:    true,
+		},
+		{
+			description: "timeout of another operation",
+			settings:    WaitSettings{PollInterval: 10 * time.Millisecond, DeleteTimeout: 50 * time.Millisecond},
+			operation:   WaitUpdate,
+			timesOut:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			// The check finishes after 200ms, which is longer than the configured timeouts
+			// and way shorter than the default poll interval of 5s.
+			finishAt := time.Now().Add(200 * time.Millisecond)
+			handler := wait.New(func() (bool, *string, error) {
+				result := "done"
+				return time.Now().After(finishAt), &result, nil
+			})
+			handler.SetTimeout(time.Second)
+
+			start := time.Now()
+			_, err := ConfigureWaitHandler(handler, tt.settings, tt.operation).WaitWithContext(context.Background())
+			if tt.timesOut && err == nil {
+				t.Fatalf("Should have timed out")
+			}
+			if !tt.timesOut && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Fatalf("Poll interval wasn't applied, waited %s", elapsed)
+			}
+		})
+	}
+}
+
+func TestConfigureWaitHandlerWithTimeout(t *testing.T) {
+	tests := []struct {
+		description string
+		settings    WaitSettings
+		timeout     time.Duration
+		timesOut    bool
+	}{
+		{
+			description: "timeout of the resource",
+			settings:    WaitSettings{PollInterval: 10 * time.Millisecond},
+			timeout:     time.Second,
+			timesOut:    false,
+		},
+		{
+			description: "shorter configured timeout keeps timeout of the resource",
+			settings:    WaitSettings{PollInterval: 10 * time.Millisecond, CreateTimeout: 50 * time.Millisecond},
+			timeout:     time.Second,
+			timesOut:    false,
+		},
+		{
+			description: "longer configured timeout",
+			settings:    WaitSettings{PollInterval: 10 * time.Millisecond, CreateTimeout: time.Second},
+			timeout:     50 * time.Millisecond,
+			timesOut:    false,
+		},
+		{
+			description: "short timeout of the resource",
+			settings:    WaitSettings{PollInterval: 10 * time.Millisecond},
+			timeout:     50 * time.Millisecond,
+			timesOut:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			// The check finishes after 200ms
+			finishAt := time.Now().Add(200 * time.Millisecond)
+			handler := wait.New(func() (bool, *string, error) {
+				result := "done"
+				return time.Now().After(finishAt), &result, nil
+			})
+
+			_, err := ConfigureWaitHandlerWithTimeout(handler, tt.settings, WaitCreate, tt.timeout).WaitWithContext(context.Background())
+			if tt.timesOut && err == nil {
+				t.Fatalf("Should have timed out")
+			}
+			if !tt.timesOut && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package features
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+)
+
+// Deprecation holds the information about a deprecated resource or datasource and how to migrate away from it.
+type Deprecation struct {
+	// RemovalDate is the (human-readable) date after which the resource may be removed, e.g. "December 17, 2025". Optional.
+	RemovalDate string
+	// Replacement is the name of the resource that should be used instead, e.g. "stackit_service_account_key". Optional.
+	Replacement string
+	// MigrationGuide is a link to further documentation about the migration. Optional.
+	MigrationGuide string
+	// MigrationSnippet is a Terraform configuration snippet showing how to use the replacement. Optional.
+	MigrationSnippet string
+}
+
+// Message returns a single-line deprecation message for the given resource.
+//
+// It can be used as the DeprecationMessage of a schema.
+func (d *Deprecation) Message(resourceName string, resourceType core.ResourceType) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("The %s %q is deprecated", resourceType, resourceName))
+	if d.RemovalDate != "" {
+		sb.WriteString(fmt.Sprintf(" and will be removed on %s", d.RemovalDate))
+	}
+	sb.WriteString(".")
+	if d.Replacement != "" {
+		sb.WriteString(fmt.Sprintf(" Use %q instead.", d.Replacement))
+	}
+	if d.MigrationGuide != "" {
+		sb.WriteString(fmt.Sprintf(" See %s for migration instructions.", d.MigrationGuide))
+	}
+	return sb.String()
+}
+
+// CheckDeprecation logs and adds a warning with the deprecation details, including the migration snippet if one is set.
+//
+// Should be called in the Configure method of a deprecated resource.
+func CheckDeprecation(ctx context.Context, diags *diag.Diagnostics, resourceName string, resourceType core.ResourceType, deprecation *Deprecation) {
+	if deprecation == nil {
+		return
+	}
+	warnTitle := fmt.Sprintf("The %s %q is deprecated", resourceType, resourceName)
+	warnContent := deprecation.Message(resourceName, resourceType)
+	if deprecation.MigrationSnippet != "" {
+		warnContent = fmt.Sprintf("%s\n\nExample configuration:\n\n%s", warnContent, strings.TrimSpace(deprecation.MigrationSnippet))
+	}
+	core.LogAndAddWarning(ctx, diags, warnTitle, warnContent)
+}
+
+// AddDeprecationDescription appends a deprecation callout, including the migration snippet if one is set, to the description.
+func AddDeprecationDescription(description, resourceName string, resourceType core.ResourceType, deprecation *Deprecation) string {
+	if deprecation == nil {
+		return description
+	}
+	// Callout block: https://developer.hashicorp.com/terraform/registry/providers/docs#callouts
+	description = fmt.Sprintf("%s\n\n!> %s", description, deprecation.Message(resourceName, resourceType))
+	if deprecation.MigrationSnippet != "" {
+		description = fmt.Sprintf("%s\n\n```terraform\n%s\n```", description, strings.TrimSpace(deprecation.MigrationSnippet))
+	}
+	return description
+}
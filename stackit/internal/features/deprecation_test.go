@@ -0,0 +1,151 @@
+package features
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestDeprecationMessage(t *testing.T) {
+	tests := []struct {
+		description string
+		deprecation Deprecation
+		expected    string
+	}{
+		{
+			description: "only name",
+			deprecation: Deprecation{},
+			expected:    `The resource "stackit_test" is deprecated.`,
+		},
+		{
+			description: "all fields",
+			deprecation: Deprecation{
+				RemovalDate:      "January 1, 2030",
+				Replacement:      "stackit_test_v2",
+				MigrationGuide:   "https://example.com/migrate",
+				MigrationSnippet: "resource \"stackit_test_v2\" \"example\" {}",
+			},
+			expected: `The resource "stackit_test" is deprecated and will be removed on January 1, 2030. Use "stackit_test_v2" instead. See https://example.com/migrate for migration instructions.`,
+		},
+		{
+			description: "replacement only",
+			deprecation: Deprecation{
+				Replacement: "stackit_test_v2",
+			},
+			expected: `The resource "stackit_test" is deprecated. Use "stackit_test_v2" instead.`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			result := tt.deprecation.Message("stackit_test", "resource")
+			if result != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCheckDeprecation(t *testing.T) {
+	tests := []struct {
+		description     string
+		deprecation     *Deprecation
+		expectWarn      bool
+		expectedContent []string
+	}{
+		{
+			description: "not deprecated",
+			deprecation: nil,
+			expectWarn:  false,
+		},
+		{
+			description: "deprecated without snippet",
+			deprecation: &Deprecation{
+				Replacement: "stackit_test_v2",
+			},
+			expectWarn:      true,
+			expectedContent: []string{`Use "stackit_test_v2" instead.`},
+		},
+		{
+			description: "deprecated with snippet",
+			deprecation: &Deprecation{
+				Replacement:      "stackit_test_v2",
+				MigrationSnippet: "\nresource \"stackit_test_v2\" \"example\" {}\n",
+			},
+			expectWarn: true,
+			expectedContent: []string{
+				`Use "stackit_test_v2" instead.`,
+				"Example configuration:\n\nresource \"stackit_test_v2\" \"example\" {}",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := diag.Diagnostics{}
+			CheckDeprecation(context.Background(), &diags, "stackit_test", "resource", tt.deprecation)
+
+			if diags.HasError() {
+				t.Fatalf("Expected no error, got %d", diags.ErrorsCount())
+			}
+			if !tt.expectWarn {
+				if diags.WarningsCount() > 0 {
+					t.Fatalf("Expected no warning, got %d", diags.WarningsCount())
+				}
+				return
+			}
+			if diags.WarningsCount() != 1 {
+				t.Fatalf("Expected one warning, got %d", diags.WarningsCount())
+			}
+			warning := diags.Warnings()[0]
+			if warning.Summary() != `The resource "stackit_test" is deprecated` {
+				t.Fatalf("Unexpected warning summary %q", warning.Summary())
+			}
+			for _, content := range tt.expectedContent {
+				if !strings.Contains(warning.Detail(), content) {
+					t.Fatalf("Expected warning detail to contain %q, got %q", content, warning.Detail())
+				}
+			}
+		})
+	}
+}
+
+func TestAddDeprecationDescription(t *testing.T) {
+	tests := []struct {
+		description string
+		deprecation *Deprecation
+		expected    string
+	}{
+		{
+			description: "not deprecated",
+			deprecation: nil,
+			expected:    "Test resource.",
+		},
+		{
+			description: "deprecated without snippet",
+			deprecation: &Deprecation{
+				Replacement: "stackit_test_v2",
+			},
+			expected: "Test resource.\n\n!> The resource \"stackit_test\" is deprecated. Use \"stackit_test_v2\" instead.",
+		},
+		{
+			description: "deprecated with snippet",
+			deprecation: &Deprecation{
+				Replacement:      "stackit_test_v2",
+				MigrationSnippet: "resource \"stackit_test_v2\" \"example\" {}\n",
+			},
+			expected: "Test resource.\n\n!> The resource \"stackit_test\" is deprecated. Use \"stackit_test_v2\" instead.\n\n```terraform\nresource \"stackit_test_v2\" \"example\" {}\n```",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			result := AddDeprecationDescription("Test resource.", "stackit_test", "resource", tt.deprecation)
+			if result != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
@@ -37,11 +37,14 @@ var (
 	_ resource.Resource                = &roleAssignmentResource{}
 	_ resource.ResourceWithConfigure   = &roleAssignmentResource{}
 	_ resource.ResourceWithImportState = &roleAssignmentResource{}
+	_ resource.ResourceWithIdentity    = &roleAssignmentResource{}
 
 	errRoleAssignmentNotFound       = errors.New("response members did not contain expected role assignment")
 	errRoleAssignmentDuplicateFound = errors.New("found a duplicate role assignment.")
 )
 
+var resourceIdentity = utils.Identity{"resource_id", "role", "subject"}
+
 // Provider's internal model
 type Model struct {
 	Id         types.String `tfsdk:"id"` // needed by TF
@@ -74,6 +77,11 @@ func (r *roleAssignmentResource) Metadata(_ context.Context, req resource.Metada
 	resp.TypeName = fmt.Sprintf("%s_authorization_%s_role_assignment", req.ProviderTypeName, r.apiName)
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *roleAssignmentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *roleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
@@ -98,7 +106,7 @@ func (r *roleAssignmentResource) Configure(ctx context.Context, req resource.Con
 func (r *roleAssignmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	descriptions := map[string]string{
 		"main":        features.AddExperimentDescription(fmt.Sprintf("%s Role Assignment resource schema.", r.apiName), features.IamExperiment, core.Resource),
-		"id":          "Terraform's internal resource identifier. It is structured as \"[resource_id],[role],[subject]\".",
+		"id":          "Terraform's internal resource identifier. It is structured as \"`resource_id`,`role`,`subject`\".",
 		"resource_id": fmt.Sprintf("%s Resource to assign the role to.", r.apiName),
 		"role":        "Role to be assigned",
 		"subject":     "Identifier of user, service account or client. Usually email address or name in case of clients",
@@ -145,6 +153,8 @@ func (r *roleAssignmentResource) Schema(_ context.Context, _ resource.SchemaRequ
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *roleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -191,6 +201,8 @@ func (r *roleAssignmentResource) Create(ctx context.Context, req resource.Create
 
 // Read refreshes the Terraform state with the latest data.
 func (r *roleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -265,11 +277,12 @@ func (r *roleAssignmentResource) Delete(ctx context.Context, req resource.Delete
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the project role assignment resource import identifier is: resource_id,role,subject
 func (r *roleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			fmt.Sprintf("Error importing %s role assignment", r.apiName),
-			fmt.Sprintf("Expected import identifier with format [resource_id],[role],[subject], got %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format [resource_id],[role],[subject], got %q", importId),
 		)
 		return
 	}
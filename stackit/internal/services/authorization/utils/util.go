@@ -19,7 +19,9 @@ func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags
 	if providerData.AuthorizationCustomEndpoint != "" {
 		apiClientConfigOptions = append(apiClientConfigOptions, config.WithEndpoint(providerData.AuthorizationCustomEndpoint))
 	}
-	apiClient, err := authorization.NewAPIClient(apiClientConfigOptions...)
+	apiClient, err := core.CachedClient(providerData.Clients, "authorization", "", providerData.AuthorizationCustomEndpoint, func() (*authorization.APIClient, error) {
+		return authorization.NewAPIClient(apiClientConfigOptions...)
+	})
 	if err != nil {
 		core.LogAndAddError(ctx, diags, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the resource configuration", err))
 		return nil
@@ -14,7 +14,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
@@ -30,9 +29,9 @@ var instanceResource = map[string]string{
 	"config_backend_origin_url": "https://test-backend-1.cdn-dev.runs.onstackit.cloud",
 	"config_regions":            "\"EU\", \"US\"",
 	"config_regions_updated":    "\"EU\", \"US\", \"ASIA\"",
-	"blocked_countries":         "\"CU\", \"AQ\"", // Do NOT use DE or AT here, because the request might be blocked by bunny at the time of creation - don't lock yourself out
-	"custom_domain_prefix":      uuid.NewString(), // we use a different domain prefix each test run due to inconsistent upstream release of domains, which might impair consecutive test runs
-	"dns_name":                  fmt.Sprintf("tf-acc-%s.stackit.gg", strings.Split(uuid.NewString(), "-")[0]),
+	"blocked_countries":         "\"CU\", \"AQ\"",      // Do NOT use DE or AT here, because the request might be blocked by bunny at the time of creation - don't lock yourself out
+	"custom_domain_prefix":      testutil.UUIDString(), // we use a different domain prefix each test run due to inconsistent upstream release of domains, which might impair consecutive test runs
+	"dns_name":                  fmt.Sprintf("tf-acc-%s.stackit.gg", strings.Split(testutil.UUIDString(), "-")[0]),
 }
 
 func configResources(regions string, geofencingCountries []string) string {
@@ -57,10 +56,15 @@ func configResources(regions string, geofencingCountries []string) string {
 						}
 						regions           = [%s]
 						blocked_countries = [%s]
+						blocked_ips       = ["192.0.2.0/24"]
             
 						optimizer = {
 							enabled = true
 						}
+
+						cache = {
+							default_duration = "P1D"
+						}
 					}
 				}
 
@@ -156,11 +160,11 @@ func makeCertAndKey(t *testing.T, organization string) (cert, key []byte) {
 }
 func TestAccCDNDistributionResource(t *testing.T) {
 	fullDomainName := fmt.Sprintf("%s.%s", instanceResource["custom_domain_prefix"], instanceResource["dns_name"])
-	organization := fmt.Sprintf("organization-%s", uuid.NewString())
+	organization := fmt.Sprintf("organization-%s", testutil.UUIDString())
 	cert, key := makeCertAndKey(t, organization)
 	geofencing := []string{"DE", "ES"}
 
-	organization_updated := fmt.Sprintf("organization-updated-%s", uuid.NewString())
+	organization_updated := fmt.Sprintf("organization-updated-%s", testutil.UUIDString())
 	cert_updated, key_updated := makeCertAndKey(t, organization_updated)
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
@@ -181,8 +185,10 @@ func TestAccCDNDistributionResource(t *testing.T) {
 					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.regions.0", "EU"),
 					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.regions.1", "US"),
 					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.blocked_countries.#", "2"),
-					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.blocked_countries.0", "CU"),
-					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.blocked_countries.1", "AQ"),
+					resource.TestCheckTypeSetElemAttr("stackit_cdn_distribution.distribution", "config.blocked_countries.*", "CU"),
+					resource.TestCheckTypeSetElemAttr("stackit_cdn_distribution.distribution", "config.blocked_countries.*", "AQ"),
+					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.blocked_ips.#", "1"),
+					resource.TestCheckTypeSetElemAttr("stackit_cdn_distribution.distribution", "config.blocked_ips.*", "192.0.2.0/24"),
 					resource.TestCheckResourceAttr(
 						"stackit_cdn_distribution.distribution",
 						fmt.Sprintf("config.backend.geofencing.%s.0", instanceResource["config_backend_origin_url"]),
@@ -194,6 +200,7 @@ func TestAccCDNDistributionResource(t *testing.T) {
 						"ES",
 					),
 					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.optimizer.enabled", "true"),
+					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.cache.default_duration", "P1D"),
 					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "project_id", testutil.ProjectId),
 					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "status", "ACTIVE"),
 				),
@@ -288,12 +295,14 @@ func TestAccCDNDistributionResource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.stackit_cdn_distribution.distribution", "config.regions.0", "EU"),
 					resource.TestCheckResourceAttr("data.stackit_cdn_distribution.distribution", "config.regions.1", "US"),
 					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.blocked_countries.#", "2"),
-					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.blocked_countries.0", "CU"),
-					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.blocked_countries.1", "AQ"),
+					resource.TestCheckTypeSetElemAttr("stackit_cdn_distribution.distribution", "config.blocked_countries.*", "CU"),
+					resource.TestCheckTypeSetElemAttr("stackit_cdn_distribution.distribution", "config.blocked_countries.*", "AQ"),
 					resource.TestCheckResourceAttr("data.stackit_cdn_distribution.distribution", "config.optimizer.enabled", "true"),
+					resource.TestCheckResourceAttr("data.stackit_cdn_distribution.distribution", "config.cache.default_duration", "P1D"),
 					resource.TestCheckResourceAttr("data.stackit_cdn_distribution.distribution", "project_id", testutil.ProjectId),
 					resource.TestCheckResourceAttr("data.stackit_cdn_distribution.distribution", "status", "ACTIVE"),
 					resource.TestCheckResourceAttr("data.stackit_cdn_custom_domain.custom_domain", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.stackit_cdn_custom_domain.custom_domain", "certificate.type", "custom"),
 					resource.TestCheckResourceAttr("data.stackit_cdn_custom_domain.custom_domain", "certificate.version", "1"),
 					resource.TestCheckResourceAttr("data.stackit_cdn_custom_domain.custom_domain", "name", fullDomainName),
 					resource.TestCheckResourceAttrPair("stackit_cdn_distribution.distribution", "distribution_id", "stackit_cdn_custom_domain.custom_domain", "distribution_id"),
@@ -318,9 +327,10 @@ func TestAccCDNDistributionResource(t *testing.T) {
 					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.regions.1", "US"),
 					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.regions.2", "ASIA"),
 					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.blocked_countries.#", "2"),
-					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.blocked_countries.0", "CU"),
-					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.blocked_countries.1", "AQ"),
+					resource.TestCheckTypeSetElemAttr("stackit_cdn_distribution.distribution", "config.blocked_countries.*", "CU"),
+					resource.TestCheckTypeSetElemAttr("stackit_cdn_distribution.distribution", "config.blocked_countries.*", "AQ"),
 					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.optimizer.enabled", "true"),
+					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "config.cache.default_duration", "P1D"),
 					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "project_id", testutil.ProjectId),
 					resource.TestCheckResourceAttr("stackit_cdn_distribution.distribution", "status", "ACTIVE"),
 					resource.TestCheckResourceAttr("stackit_cdn_custom_domain.custom_domain", "status", "ACTIVE"),
@@ -29,9 +29,16 @@ var (
 )
 
 var certificateDataSourceTypes = map[string]attr.Type{
+	"type":    types.StringType,
 	"version": types.Int64Type,
 }
 
+var certificateDataSourceSchemaDescriptions = map[string]string{
+	"main":    "Metadata of the TLS certificate used by the custom domain.",
+	"type":    "The type of the certificate. `managed` certificates are issued by STACKIT, `custom` certificates are provided by the user.",
+	"version": "The version of the custom certificate. Not set for managed certificates.",
+}
+
 type customDomainDataSource struct {
 	client *cdn.APIClient
 }
@@ -75,8 +82,8 @@ func (r *customDomainDataSource) Metadata(_ context.Context, req datasource.Meta
 
 func (r *customDomainDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: features.AddBetaDescription("CDN distribution data source schema.", core.Datasource),
-		Description:         "CDN distribution data source schema.",
+		MarkdownDescription: features.AddBetaDescription("CDN custom domain data source schema.", core.Datasource),
+		Description:         "CDN custom domain data source schema.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: customDomainSchemaDescriptions["id"],
@@ -94,6 +101,7 @@ func (r *customDomainDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 			"project_id": schema.StringAttribute{
 				Description: customDomainSchemaDescriptions["project_id"],
 				Required:    true,
+				Validators:  []validator.String{validate.UUID()},
 			},
 			"status": schema.StringAttribute{
 				Computed:    true,
@@ -105,11 +113,15 @@ func (r *customDomainDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Description: customDomainSchemaDescriptions["errors"],
 			},
 			"certificate": schema.SingleNestedAttribute{
-				Description: certificateSchemaDescriptions["main"],
-				Optional:    true,
+				Description: certificateDataSourceSchemaDescriptions["main"],
+				Computed:    true,
 				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: certificateDataSourceSchemaDescriptions["type"],
+						Computed:    true,
+					},
 					"version": schema.Int64Attribute{
-						Description: certificateSchemaDescriptions["version"],
+						Description: certificateDataSourceSchemaDescriptions["version"],
 						Computed:    true,
 					},
 				},
@@ -190,24 +202,19 @@ func mapCustomDomainDataSourceFields(customDomainResponse *cdn.GetCustomDomainRe
 		return fmt.Errorf("Certificate error in normalizer: %w", err)
 	}
 
-	// If the certificate is managed, the certificate block in the state should be null.
-	if normalizedCert.Type == "managed" {
-		model.Certificate = types.ObjectNull(certificateDataSourceTypes)
-	} else {
-		// For custom certificates, we only care about the version.
-		version := types.Int64Null()
-		if normalizedCert.Version != nil {
-			version = types.Int64Value(*normalizedCert.Version)
-		}
-
-		certificateObj, diags := types.ObjectValue(certificateDataSourceTypes, map[string]attr.Value{
-			"version": version,
-		})
-		if diags.HasError() {
-			return fmt.Errorf("failed to map certificate: %w", core.DiagsToError(diags))
-		}
-		model.Certificate = certificateObj
+	// Managed certificates don't have a version, so it stays null for them
+	version := types.Int64Null()
+	if normalizedCert.Version != nil {
+		version = types.Int64Value(*normalizedCert.Version)
+	}
+	certificateObj, diags := types.ObjectValue(certificateDataSourceTypes, map[string]attr.Value{
+		"type":    types.StringValue(normalizedCert.Type),
+		"version": version,
+	})
+	if diags.HasError() {
+		return fmt.Errorf("failed to map certificate: %w", core.DiagsToError(diags))
 	}
+	model.Certificate = certificateObj
 
 	model.ID = types.StringValue(fmt.Sprintf("%s,%s,%s", projectId, distributionId, *customDomainResponse.CustomDomain.Name))
 	model.Status = types.StringValue(string(*customDomainResponse.CustomDomain.Status))
@@ -14,10 +14,17 @@ func TestMapDataSourceFields(t *testing.T) {
 
 	// Expected certificate object when a custom certificate is returned
 	certAttributes := map[string]attr.Value{
+		"type":    types.StringValue("custom"),
 		"version": types.Int64Value(3),
 	}
 	certificateObj, _ := types.ObjectValue(certificateDataSourceTypes, certAttributes)
 
+	// Expected certificate object when a managed certificate is returned
+	managedCertificateObj, _ := types.ObjectValue(certificateDataSourceTypes, map[string]attr.Value{
+		"type":    types.StringValue("managed"),
+		"version": types.Int64Null(),
+	})
+
 	// Helper to create expected model instances
 	expectedModel := func(mods ...func(*customDomainDataSourceModel)) *customDomainDataSourceModel {
 		model := &customDomainDataSourceModel{
@@ -85,7 +92,7 @@ func TestMapDataSourceFields(t *testing.T) {
 		},
 		"happy_path_managed_cert": {
 			Expected: expectedModel(func(m *customDomainDataSourceModel) {
-				m.Certificate = types.ObjectNull(certificateDataSourceTypes)
+				m.Certificate = managedCertificateObj
 			}),
 			Input: customDomainFixture(func(gcdr *cdn.GetCustomDomainResponse) {
 				gcdr.Certificate = getRespManaged
@@ -107,6 +114,13 @@ func TestMapDataSourceFields(t *testing.T) {
 			Input:    nil,
 			IsValid:  false,
 		},
+		"sad_path_certificate_missing": {
+			Expected: expectedModel(),
+			Input: customDomainFixture(func(d *cdn.GetCustomDomainResponse) {
+				d.Certificate = nil
+			}),
+			IsValid: false,
+		},
 		"sad_path_name_missing": {
 			Expected: expectedModel(),
 			Input: customDomainFixture(func(d *cdn.GetCustomDomainResponse) {
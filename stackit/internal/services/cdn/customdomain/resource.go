@@ -15,13 +15,17 @@ import (
 	cdnUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/cdn/utils"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -38,22 +42,31 @@ var (
 	_ resource.Resource                = &customDomainResource{}
 	_ resource.ResourceWithConfigure   = &customDomainResource{}
 	_ resource.ResourceWithImportState = &customDomainResource{}
+	_ resource.ResourceWithIdentity    = &customDomainResource{}
 )
+
+var resourceIdentity = utils.Identity{"project_id", "distribution_id", "name"}
 var certificateSchemaDescriptions = map[string]string{
 	"main":        "The TLS certificate for the custom domain. If omitted, a managed certificate will be used. If the block is specified, a custom certificate is used.",
 	"certificate": "The PEM-encoded TLS certificate. Required for custom certificates.",
-	"private_key": "The PEM-encoded private key for the certificate. Required for custom certificates. The certificate will be updated if this field is changed.",
-	"version":     "A version identifier for the certificate. Required for custom certificates. The certificate will be updated if this field is changed.",
+	"private_key": "The PEM-encoded private key for the certificate. Required for custom certificates, unless `private_key_wo` is used. The certificate will be updated if this field is changed.",
+	"private_key_wo": "The PEM-encoded private key for the certificate. Write-only alternative to `private_key`, which isn't persisted in the Terraform state. " +
+		"Changes to this field are only applied when `private_key_wo_version` is changed. Requires Terraform 1.11 or later.",
+	"private_key_wo_version": "Version of `private_key_wo`. Change it to update the private key and the certificate.",
+	"version":                "A version identifier for the certificate. Required for custom certificates. The certificate will be updated if this field is changed.",
 }
 
 var certificateTypes = map[string]attr.Type{
 	"version":     types.Int64Type,
 	"certificate": types.StringType,
 	"private_key": types.StringType,
+	// write-only attributes are always null in the state
+	"private_key_wo":         types.StringType,
+	"private_key_wo_version": types.Int64Type,
 }
 
 var customDomainSchemaDescriptions = map[string]string{
-	"id":              "Terraform's internal resource identifier. It is structured as \"`project_id`,`distribution_id`\".",
+	"id":              "Terraform's internal resource identifier. It is structured as \"`project_id`,`distribution_id`,`name`\".",
 	"distribution_id": "CDN distribution ID",
 	"project_id":      "STACKIT project ID associated with the distribution",
 	"status":          "Status of the distribution",
@@ -61,9 +74,11 @@ var customDomainSchemaDescriptions = map[string]string{
 }
 
 type CertificateModel struct {
-	Certificate types.String `tfsdk:"certificate"`
-	PrivateKey  types.String `tfsdk:"private_key"`
-	Version     types.Int64  `tfsdk:"version"`
+	Certificate         types.String `tfsdk:"certificate"`
+	PrivateKey          types.String `tfsdk:"private_key"`
+	PrivateKeyWo        types.String `tfsdk:"private_key_wo"`
+	PrivateKeyWoVersion types.Int64  `tfsdk:"private_key_wo_version"`
+	Version             types.Int64  `tfsdk:"version"`
 }
 
 type CustomDomainModel struct {
@@ -77,7 +92,8 @@ type CustomDomainModel struct {
 }
 
 type customDomainResource struct {
-	client *cdn.APIClient
+	client       *cdn.APIClient
+	providerData core.ProviderData
 }
 
 func NewCustomDomainResource() resource.Resource {
@@ -105,6 +121,7 @@ func (r *customDomainResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 	r.client = apiClient
+	r.providerData = providerData
 	tflog.Info(ctx, "CDN client configured")
 }
 
@@ -112,6 +129,11 @@ func (r *customDomainResource) Metadata(_ context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_cdn_custom_domain"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *customDomainResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 func (r *customDomainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: features.AddBetaDescription("CDN distribution data source schema.", core.Resource),
@@ -126,6 +148,7 @@ func (r *customDomainResource) Schema(_ context.Context, _ resource.SchemaReques
 				Required:    true,
 				Optional:    false,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
@@ -159,6 +182,22 @@ func (r *customDomainResource) Schema(_ context.Context, _ resource.SchemaReques
 						Description: certificateSchemaDescriptions["private_key"],
 						Optional:    true,
 						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("private_key_wo")),
+						},
+					},
+					"private_key_wo": schema.StringAttribute{
+						Description: certificateSchemaDescriptions["private_key_wo"],
+						Optional:    true,
+						Sensitive:   true,
+						WriteOnly:   true,
+					},
+					"private_key_wo_version": schema.Int64Attribute{
+						Description: certificateSchemaDescriptions["private_key_wo_version"],
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("private_key_wo")),
+						},
 					},
 					"version": schema.Int64Attribute{
 						Description: certificateSchemaDescriptions["version"],
@@ -180,6 +219,8 @@ func (r *customDomainResource) Schema(_ context.Context, _ resource.SchemaReques
 }
 
 func (r *customDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var model CustomDomainModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -195,7 +236,12 @@ func (r *customDomainResource) Create(ctx context.Context, req resource.CreateRe
 	ctx = tflog.SetField(ctx, "distribution_id", distributionId)
 	name := model.Name.ValueString()
 	ctx = tflog.SetField(ctx, "name", name)
-	certificate, err := toCertificatePayload(ctx, &model)
+
+	privateKeyWo := getWriteOnlyPrivateKey(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	certificate, err := toCertificatePayload(ctx, &model, privateKeyWo)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating CDN custom domain", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -213,7 +259,7 @@ func (r *customDomainResource) Create(ctx context.Context, req resource.CreateRe
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.CreateCDNCustomDomainWaitHandler(ctx, r.client, projectId, distributionId, name).SetTimeout(5 * time.Minute).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandlerWithTimeout(wait.CreateCDNCustomDomainWaitHandler(ctx, r.client, projectId, distributionId, name), r.providerData.Wait, core.WaitCreate, 5*time.Minute).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating CDN custom domain", fmt.Sprintf("Waiting for create: %v", err))
 		return
@@ -239,6 +285,8 @@ func (r *customDomainResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *customDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model CustomDomainModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -261,7 +309,7 @@ func (r *customDomainResource) Read(ctx context.Context, req resource.ReadReques
 		// n.b. err is caught here if of type *oapierror.GenericOpenAPIError, which the stackit SDK client returns
 		if errors.As(err, &oapiErr) {
 			if oapiErr.StatusCode == http.StatusNotFound {
-				resp.State.RemoveResource(ctx)
+				core.RemoveFromStateWithWarning(ctx, resp, "stackit_cdn_custom_domain", model.ID.ValueString())
 				return
 			}
 		}
@@ -286,6 +334,8 @@ func (r *customDomainResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *customDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model CustomDomainModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -302,7 +352,11 @@ func (r *customDomainResource) Update(ctx context.Context, req resource.UpdateRe
 	name := model.Name.ValueString()
 	ctx = tflog.SetField(ctx, "name", name)
 
-	certificate, err := toCertificatePayload(ctx, &model)
+	privateKeyWo := getWriteOnlyPrivateKey(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	certificate, err := toCertificatePayload(ctx, &model, privateKeyWo)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating CDN custom domain", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -320,7 +374,7 @@ func (r *customDomainResource) Update(ctx context.Context, req resource.UpdateRe
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.CreateCDNCustomDomainWaitHandler(ctx, r.client, projectId, distributionId, name).SetTimeout(5 * time.Minute).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandlerWithTimeout(wait.CreateCDNCustomDomainWaitHandler(ctx, r.client, projectId, distributionId, name), r.providerData.Wait, core.WaitUpdate, 5*time.Minute).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating CDN custom domain certificate", fmt.Sprintf("Waiting for update: %v", err))
 		return
@@ -368,7 +422,7 @@ func (r *customDomainResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.DeleteCDNCustomDomainWaitHandler(ctx, r.client, projectId, distributionId, name).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.DeleteCDNCustomDomainWaitHandler(ctx, r.client, projectId, distributionId, name), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Delete CDN custom domain", fmt.Sprintf("Waiting for deletion: %v", err))
 		return
@@ -377,10 +431,11 @@ func (r *customDomainResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *customDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing CDN custom domain", fmt.Sprintf("Expected import identifier on the format: [project_id]%q[distribution_id]%q[custom_domain_name], got %q", core.Separator, core.Separator, req.ID))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing CDN custom domain", fmt.Sprintf("Expected import identifier on the format: [project_id]%q[distribution_id]%q[custom_domain_name], got %q", core.Separator, core.Separator, importId))
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("distribution_id"), idParts[1])...)
@@ -416,9 +471,18 @@ func normalizeCertificate(certInput cdn.GetCustomDomainResponseGetCertificateAtt
 	return Certificate{}, errors.New("certificate structure is empty, neither custom nor managed is set")
 }
 
+// getWriteOnlyPrivateKey returns the write-only private key of the certificate.
+// Write-only attributes are never part of the plan or state, so it has to be read from the configuration.
+func getWriteOnlyPrivateKey(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) types.String {
+	var privateKeyWo types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("certificate").AtName("private_key_wo"), &privateKeyWo)...)
+	return privateKeyWo
+}
+
 // toCertificatePayload constructs the certificate part of the payload for the API request.
 // It defaults to a managed certificate if the certificate block is omitted, otherwise it creates a custom certificate.
-func toCertificatePayload(ctx context.Context, model *CustomDomainModel) (*cdn.PutCustomDomainPayloadCertificate, error) {
+// The private key is taken from privateKeyWo, if the write-only private key is used.
+func toCertificatePayload(ctx context.Context, model *CustomDomainModel, privateKeyWo types.String) (*cdn.PutCustomDomainPayloadCertificate, error) {
 	// If the certificate block is not specified, default to a managed certificate.
 	if model.Certificate.IsNull() {
 		managedCert := cdn.NewPutCustomDomainManagedCertificate("managed")
@@ -433,12 +497,16 @@ func toCertificatePayload(ctx context.Context, model *CustomDomainModel) (*cdn.P
 		return nil, fmt.Errorf("invalid certificate or private key: %w", core.DiagsToError(respDiags))
 	}
 
-	if utils.IsUndefined(certModel.Certificate) || utils.IsUndefined(certModel.PrivateKey) {
-		return nil, fmt.Errorf(`"certificate" and "private_key" must be set`)
+	privateKey := certModel.PrivateKey
+	if utils.IsUndefined(privateKey) {
+		privateKey = privateKeyWo
+	}
+	if utils.IsUndefined(certModel.Certificate) || utils.IsUndefined(privateKey) {
+		return nil, fmt.Errorf(`"certificate" and "private_key" or "private_key_wo" must be set`)
 	}
 
 	certStr := base64.StdEncoding.EncodeToString([]byte(certModel.Certificate.ValueString()))
-	keyStr := base64.StdEncoding.EncodeToString([]byte(certModel.PrivateKey.ValueString()))
+	keyStr := base64.StdEncoding.EncodeToString([]byte(privateKey.ValueString()))
 
 	if certStr == "" || keyStr == "" {
 		return nil, errors.New("invalid certificate or private key. Please check if the string of the public certificate and private key in PEM format")
@@ -484,9 +552,11 @@ func mapCustomDomainResourceFields(customDomainResponse *cdn.GetCustomDomainResp
 		// If the certificate is custom, we need to preserve the user-configured
 		// certificate and private key from the plan/state, and only update the computed version.
 		certAttributes := map[string]attr.Value{
-			"certificate": types.StringNull(), // Default to null
-			"private_key": types.StringNull(), // Default to null
-			"version":     types.Int64Null(),
+			"certificate":            types.StringNull(), // Default to null
+			"private_key":            types.StringNull(), // Default to null
+			"private_key_wo":         types.StringNull(), // Write-only, never stored
+			"private_key_wo_version": types.Int64Null(),
+			"version":                types.Int64Null(),
 		}
 
 		// Get existing values from the model's certificate object if it exists
@@ -498,6 +568,9 @@ func mapCustomDomainResourceFields(customDomainResponse *cdn.GetCustomDomainResp
 			if val, ok := existingAttrs["private_key"]; ok {
 				certAttributes["private_key"] = val
 			}
+			if val, ok := existingAttrs["private_key_wo_version"]; ok {
+				certAttributes["private_key_wo_version"] = val
+			}
 		}
 
 		// Set the computed version from the API response
@@ -24,9 +24,11 @@ import (
 func TestMapFields(t *testing.T) {
 	// Redefine certificateTypes locally for testing, matching the updated schema
 	certificateTypes := map[string]attr.Type{
-		"version":     types.Int64Type,
-		"certificate": types.StringType,
-		"private_key": types.StringType,
+		"version":                types.Int64Type,
+		"certificate":            types.StringType,
+		"private_key":            types.StringType,
+		"private_key_wo":         types.StringType,
+		"private_key_wo_version": types.Int64Type,
 	}
 
 	const dummyCert = "dummy-cert-pem"
@@ -36,9 +38,11 @@ func TestMapFields(t *testing.T) {
 
 	// Expected object when a custom certificate is returned
 	certAttributes := map[string]attr.Value{
-		"version":     types.Int64Value(3),
-		"certificate": types.StringValue(dummyCert),
-		"private_key": types.StringValue(dummyKey),
+		"version":                types.Int64Value(3),
+		"certificate":            types.StringValue(dummyCert),
+		"private_key":            types.StringValue(dummyKey),
+		"private_key_wo":         types.StringNull(),
+		"private_key_wo_version": types.Int64Null(),
 	}
 	certificateObj, _ := types.ObjectValue(certificateTypes, certAttributes)
 
@@ -106,9 +110,33 @@ func TestMapFields(t *testing.T) {
 			IsValid: true,
 			InitialModel: expectedModel(func(m *CustomDomainModel) {
 				m.Certificate = basetypes.NewObjectValueMust(certificateTypes, map[string]attr.Value{
-					"certificate": types.StringValue(dummyCert),
-					"private_key": types.StringValue(dummyKey),
-					"version":     types.Int64Null(),
+					"certificate":            types.StringValue(dummyCert),
+					"private_key":            types.StringValue(dummyKey),
+					"private_key_wo":         types.StringNull(),
+					"private_key_wo_version": types.Int64Null(),
+					"version":                types.Int64Null(),
+				})
+			}),
+		},
+		"happy_path_custom_cert_write_only_private_key": {
+			Expected: expectedModel(func(m *CustomDomainModel) {
+				m.Certificate = basetypes.NewObjectValueMust(certificateTypes, map[string]attr.Value{
+					"version":                types.Int64Value(3),
+					"certificate":            types.StringValue(dummyCert),
+					"private_key":            types.StringNull(),
+					"private_key_wo":         types.StringNull(),
+					"private_key_wo_version": types.Int64Value(2),
+				})
+			}),
+			Input:   customDomainFixture(),
+			IsValid: true,
+			InitialModel: expectedModel(func(m *CustomDomainModel) {
+				m.Certificate = basetypes.NewObjectValueMust(certificateTypes, map[string]attr.Value{
+					"certificate":            types.StringValue(dummyCert),
+					"private_key":            types.StringNull(),
+					"private_key_wo":         types.StringNull(),
+					"private_key_wo_version": types.Int64Value(2),
+					"version":                types.Int64Null(),
 				})
 			}),
 		},
@@ -133,9 +161,11 @@ func TestMapFields(t *testing.T) {
 			IsValid: true,
 			InitialModel: expectedModel(func(m *CustomDomainModel) {
 				m.Certificate = basetypes.NewObjectValueMust(certificateTypes, map[string]attr.Value{
-					"certificate": types.StringValue(dummyCert),
-					"private_key": types.StringValue(dummyKey),
-					"version":     types.Int64Null(),
+					"certificate":            types.StringValue(dummyCert),
+					"private_key":            types.StringValue(dummyKey),
+					"private_key_wo":         types.StringNull(),
+					"private_key_wo_version": types.Int64Null(),
+					"version":                types.Int64Null(),
 				})
 			}),
 		},
@@ -223,6 +253,7 @@ func TestToCertificatePayload(t *testing.T) {
 
 	tests := map[string]struct {
 		model           *CustomDomainModel
+		privateKeyWo    types.String
 		expectedPayload *cdn.PutCustomDomainPayloadCertificate
 		expectErr       bool
 		expectedErrMsg  string
@@ -241,12 +272,33 @@ func TestToCertificatePayload(t *testing.T) {
 				Certificate: basetypes.NewObjectValueMust(
 					certificateTypes,
 					map[string]attr.Value{
-						"version":     types.Int64Null(),
-						"certificate": types.StringValue(certPEM),
-						"private_key": types.StringValue(keyPEM),
+						"version":                types.Int64Null(),
+						"certificate":            types.StringValue(certPEM),
+						"private_key":            types.StringValue(keyPEM),
+						"private_key_wo":         types.StringNull(),
+						"private_key_wo_version": types.Int64Null(),
+					},
+				),
+			},
+			expectedPayload: &cdn.PutCustomDomainPayloadCertificate{
+				PutCustomDomainCustomCertificate: cdn.NewPutCustomDomainCustomCertificate(certBase64, keyBase64, "custom"),
+			},
+			expectErr: false,
+		},
+		"success_custom_certificate_write_only_private_key": {
+			model: &CustomDomainModel{
+				Certificate: basetypes.NewObjectValueMust(
+					certificateTypes,
+					map[string]attr.Value{
+						"version":                types.Int64Null(),
+						"certificate":            types.StringValue(certPEM),
+						"private_key":            types.StringNull(),
+						"private_key_wo":         types.StringNull(),
+						"private_key_wo_version": types.Int64Value(1),
 					},
 				),
 			},
+			privateKeyWo: types.StringValue(keyPEM),
 			expectedPayload: &cdn.PutCustomDomainPayloadCertificate{
 				PutCustomDomainCustomCertificate: cdn.NewPutCustomDomainCustomCertificate(certBase64, keyBase64, "custom"),
 			},
@@ -257,9 +309,11 @@ func TestToCertificatePayload(t *testing.T) {
 				Certificate: basetypes.NewObjectValueMust(
 					certificateTypes,
 					map[string]attr.Value{
-						"version":     types.Int64Null(),
-						"certificate": types.StringValue(""), // Empty certificate
-						"private_key": types.StringValue(keyPEM),
+						"version":                types.Int64Null(),
+						"certificate":            types.StringValue(""), // Empty certificate
+						"private_key":            types.StringValue(keyPEM),
+						"private_key_wo":         types.StringNull(),
+						"private_key_wo_version": types.Int64Null(),
 					},
 				),
 			},
@@ -272,20 +326,22 @@ func TestToCertificatePayload(t *testing.T) {
 				Certificate: basetypes.NewObjectValueMust(
 					certificateTypes,
 					map[string]attr.Value{
-						"version":     types.Int64Null(),
-						"certificate": types.StringNull(),
-						"private_key": types.StringNull(),
+						"version":                types.Int64Null(),
+						"certificate":            types.StringNull(),
+						"private_key":            types.StringNull(),
+						"private_key_wo":         types.StringNull(),
+						"private_key_wo_version": types.Int64Null(),
 					},
 				),
 			},
 			expectErr:      true,
-			expectedErrMsg: `"certificate" and "private_key" must be set`,
+			expectedErrMsg: `"certificate" and "private_key" or "private_key_wo" must be set`,
 		},
 	}
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			payload, err := toCertificatePayload(context.Background(), tt.model)
+			payload, err := toCertificatePayload(context.Background(), tt.model, tt.privateKeyWo)
 			if tt.expectErr {
 				if err == nil {
 					t.Fatalf("expected err, but got none")
@@ -7,6 +7,7 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	cdnUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/cdn/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -19,6 +20,34 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
+// dataSourceBackendTypes are the backend attributes of the data source. Unlike the resource, it has no bucket_id,
+// as the CDN API doesn't know which bucket an origin URL belongs to.
+var dataSourceBackendTypes = map[string]attr.Type{
+	"type":                   types.StringType,
+	"origin_url":             types.StringType,
+	"origin_request_headers": types.MapType{ElemType: types.StringType},
+	"geofencing":             geofencingTypes,
+}
+
+var dataSourceConfigTypes = map[string]attr.Type{
+	"backend":           types.ObjectType{AttrTypes: dataSourceBackendTypes},
+	"regions":           types.ListType{ElemType: types.StringType},
+	"blocked_countries": types.SetType{ElemType: types.StringType},
+	"blocked_ips":       types.SetType{ElemType: types.StringType},
+	"optimizer": types.ObjectType{
+		AttrTypes: optimizerTypes,
+	},
+	"cache": types.ObjectType{
+		AttrTypes: cacheTypes,
+	},
+	"waf": types.ObjectType{
+		AttrTypes: wafTypes,
+	},
+	"logging": types.ObjectType{
+		AttrTypes: loggingTypes,
+	},
+}
+
 type distributionDataSource struct {
 	client *cdn.APIClient
 }
@@ -96,6 +125,17 @@ func (r *distributionDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Computed:    true,
 				Description: schemaDescriptions["errors"],
 			},
+			"error_details": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: schemaDescriptions["error_details"],
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: errorDetailsDataSourceAttributes(),
+				},
+			},
+			"domains_status": schema.StringAttribute{
+				Computed:    true,
+				Description: schemaDescriptions["domains_status"],
+			},
 			"domains": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: schemaDescriptions["domains"],
@@ -118,6 +158,13 @@ func (r *distributionDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 							Description: schemaDescriptions["domain_errors"],
 							ElementType: types.StringType,
 						},
+						"error_details": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: schemaDescriptions["domain_error_details"],
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: errorDetailsDataSourceAttributes(),
+							},
+						},
 					},
 				},
 			},
@@ -143,7 +190,7 @@ func (r *distributionDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 								ElementType: types.StringType,
 							},
 							"geofencing": schema.MapAttribute{
-								Description: "A map of URLs to a list of countries where content is allowed.",
+								Description: "A map of alternative origin URLs to lists of ISO 3166-1 alpha-2 country codes. Requests from these countries are routed to the alternative origin. A country can only be assigned to one URL.",
 								Computed:    true,
 								ElementType: types.ListType{
 									ElemType: types.StringType,
@@ -156,11 +203,16 @@ func (r *distributionDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 						Description: schemaDescriptions["config_regions"],
 						ElementType: types.StringType,
 					},
-					"blocked_countries": schema.ListAttribute{
+					"blocked_countries": schema.SetAttribute{
 						Optional:    true,
 						Description: schemaDescriptions["config_blocked_countries"],
 						ElementType: types.StringType,
 					},
+					"blocked_ips": schema.SetAttribute{
+						Computed:    true,
+						Description: schemaDescriptions["config_blocked_ips"],
+						ElementType: types.StringType,
+					},
 					"optimizer": schema.SingleNestedAttribute{
 						Description: schemaDescriptions["config_optimizer"],
 						Computed:    true,
@@ -170,12 +222,72 @@ func (r *distributionDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 							},
 						},
 					},
+					"cache": schema.SingleNestedAttribute{
+						Description: schemaDescriptions["config_cache"],
+						Computed:    true,
+						Attributes: map[string]schema.Attribute{
+							"default_duration": schema.StringAttribute{
+								Description: schemaDescriptions["config_cache_default_duration"],
+								Computed:    true,
+							},
+						},
+					},
+					"waf": schema.SingleNestedAttribute{
+						Description: "Configuration of the Web Application Firewall (WAF) of the distribution.",
+						Computed:    true,
+						Attributes: map[string]schema.Attribute{
+							"mode": schema.StringAttribute{
+								Description: "The mode of the WAF.",
+								Computed:    true,
+							},
+							"type": schema.StringAttribute{
+								Description: "The type of the WAF.",
+								Computed:    true,
+							},
+						},
+					},
+					"logging": schema.SingleNestedAttribute{
+						Description: "Configuration of the sink to which the access logs of the distribution are pushed.",
+						Computed:    true,
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								Description: "The type of the log sink.",
+								Computed:    true,
+							},
+							"push_url": schema.StringAttribute{
+								Description: "The push URL of the log sink.",
+								Computed:    true,
+							},
+							"username": schema.StringAttribute{
+								Description: "Always null, because the credentials of the log sink aren't returned by the API.",
+								Computed:    true,
+							},
+							"password": schema.StringAttribute{
+								Description: "Always null, because the credentials of the log sink aren't returned by the API.",
+								Computed:    true,
+								Sensitive:   true,
+							},
+						},
+					},
 				},
 			},
 		},
 	}
 }
 
+func errorDetailsDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"key": schema.StringAttribute{
+			Computed:    true,
+			Description: schemaDescriptions["error_key"],
+		},
+		"message": schema.StringAttribute{
+			Computed:    true,
+			Description: schemaDescriptions["error_message"],
+		},
+	}
+}
+
 func (r *distributionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
 	var model Model
 	diags := req.Config.Get(ctx, &model)
@@ -204,7 +316,7 @@ func (r *distributionDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	ctx = core.LogResponse(ctx)
 
-	err = mapFields(ctx, distributionResp.Distribution, &model)
+	err = mapDataSourceFields(ctx, distributionResp.Distribution, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading CDN distribution", fmt.Sprintf("Error processing API response: %v", err))
 		return
@@ -212,3 +324,8 @@ func (r *distributionDataSource) Read(ctx context.Context, req datasource.ReadRe
 	diags = resp.State.Set(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 }
+
+// mapDataSourceFields maps the distribution into the model of the data source, whose config has no bucket_id.
+func mapDataSourceFields(ctx context.Context, distribution *cdn.Distribution, model *Model) error {
+	return mapFieldsWithTypes(ctx, distribution, model, dataSourceConfigTypes, dataSourceBackendTypes)
+}
@@ -0,0 +1,59 @@
+package cdn
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/services/cdn"
+)
+
+func TestMapDataSourceFields(t *testing.T) {
+	createdAt := time.Now()
+	updatedAt := time.Now()
+	distribution := &cdn.Distribution{
+		Config: &cdn.Config{
+			Backend: &cdn.ConfigBackend{
+				HttpBackend: &cdn.HttpBackend{
+					OriginUrl: cdn.PtrString("https://my-bucket.object.storage.eu01.onstackit.cloud"),
+					Type:      cdn.PtrString("http"),
+				},
+			},
+			Regions: &[]cdn.Region{"EU"},
+		},
+		CreatedAt: &createdAt,
+		Domains:   &[]cdn.Domain{},
+		Id:        cdn.PtrString("test-distribution-id"),
+		ProjectId: cdn.PtrString("test-project-id"),
+		Status:    cdn.DISTRIBUTIONSTATUS_ACTIVE.Ptr(),
+		UpdatedAt: &updatedAt,
+	}
+	expectedConfig := types.ObjectValueMust(dataSourceConfigTypes, map[string]attr.Value{
+		"backend": types.ObjectValueMust(dataSourceBackendTypes, map[string]attr.Value{
+			"type":                   types.StringValue("http"),
+			"origin_url":             types.StringValue("https://my-bucket.object.storage.eu01.onstackit.cloud"),
+			"origin_request_headers": types.MapNull(types.StringType),
+			"geofencing":             types.MapNull(geofencingTypes.ElemType),
+		}),
+		"regions":           types.ListValueMust(types.StringType, []attr.Value{types.StringValue("EU")}),
+		"blocked_countries": types.SetNull(types.StringType),
+		"blocked_ips":       types.SetNull(types.StringType),
+		"logging":           types.ObjectNull(loggingTypes),
+		"waf":               types.ObjectNull(wafTypes),
+		"optimizer":         types.ObjectNull(optimizerTypes),
+		"cache":             types.ObjectNull(cacheTypes),
+	})
+
+	model := &Model{}
+	err := mapDataSourceFields(context.Background(), distribution, model)
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	diff := cmp.Diff(expectedConfig, model.Config)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
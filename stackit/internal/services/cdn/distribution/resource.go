@@ -5,42 +5,56 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	sdkUtils "github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/cdn"
 	"github.com/stackitcloud/stackit-sdk-go/services/cdn/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/objectstorage"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
 	cdnUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/cdn/utils"
+	objectstorageUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/objectstorage/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &distributionResource{}
-	_ resource.ResourceWithConfigure   = &distributionResource{}
-	_ resource.ResourceWithImportState = &distributionResource{}
+	_ resource.Resource                   = &distributionResource{}
+	_ resource.ResourceWithConfigure      = &distributionResource{}
+	_ resource.ResourceWithImportState    = &distributionResource{}
+	_ resource.ResourceWithIdentity       = &distributionResource{}
+	_ resource.ResourceWithValidateConfig = &distributionResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "distribution_id"}
+
 var schemaDescriptions = map[string]string{
 	"id":                                    "Terraform's internal resource identifier. It is structured as \"`project_id`,`distribution_id`\".",
 	"distribution_id":                       "CDN distribution ID",
@@ -49,6 +63,11 @@ var schemaDescriptions = map[string]string{
 	"created_at":                            "Time when the distribution was created",
 	"updated_at":                            "Time when the distribution was last updated",
 	"errors":                                "List of distribution errors",
+	"error_details":                         "List of distribution errors with their key and human-readable message",
+	"error_key":                             "Machine-readable key of the error",
+	"error_message":                         "Human-readable message of the error",
+	"domains_status":                        "Aggregated status of all domains of the distribution. It is `ERROR` if any domain failed, `ACTIVE` if all domains are active and the status of the first pending domain otherwise",
+	"wait_for_domains_active":               "If set to `true`, creating and updating the distribution waits until all its domains are active and fails with the domain errors if a domain ends up in status `ERROR`. Defaults to `false`",
 	"domains":                               "List of configured domains for the distribution",
 	"config":                                "The distribution configuration",
 	"config_backend":                        "The configured backend for the distribution",
@@ -56,12 +75,25 @@ var schemaDescriptions = map[string]string{
 	"config_backend_type":                   "The configured backend type. ",
 	"config_optimizer":                      "Configuration for the Image Optimizer. This is a paid feature that automatically optimizes images to reduce their file size for faster delivery, leading to improved website performance and a better user experience.",
 	"config_backend_origin_url":             "The configured backend type for the distribution",
+	"config_backend_bucket_id":              "ID of a STACKIT object storage bucket to use as origin, e.g. `stackit_objectstorage_bucket.example.id`. It is structured as \"`project_id`,`region`,`name`\". The bucket's existence in the region is validated at apply time and `origin_url` is set to the bucket's virtual hosted style URL. Conflicts with `origin_url`.",
 	"config_backend_origin_request_headers": "The configured origin request headers for the backend",
-	"config_blocked_countries":              "The configured countries where distribution of content is blocked",
+	"config_blocked_countries":              "ISO 3166-1 alpha-2 codes of the countries where distribution of content is blocked",
+	"config_blocked_ips":                    "IP addresses or CIDR ranges from which requests are blocked",
+	"config_cache":                          "The cache configuration of the distribution. Only the default cache duration can be configured, as the CDN API doesn't support cache rules for specific paths",
+	"config_cache_default_duration":         "The default cache duration, applied when the origin's response does not contain a `Cache-Control` header. Must be an ISO 8601 duration, e.g. `P1DT2H30M`.",
+	"config_waf":                            "Configuration of the Web Application Firewall (WAF) of the distribution. If not set, the WAF configuration of the API is kept.",
+	"config_waf_mode":                       "The mode of the WAF. `LOG_ONLY` only logs requests which would have been blocked. ",
+	"config_waf_type":                       "The type of the WAF. Enabling the `PREMIUM` WAF causes additional fees. Defaults to `FREE`. ",
+	"config_logging":                        "Configuration of the sink to which the access logs of the distribution are pushed. If not set, no access logs are pushed.",
+	"config_logging_type":                   "The type of the log sink. Defaults to `loki`. ",
+	"config_logging_push_url":               "The push URL of the log sink, e.g. `stackit_observability_instance.example.logs_push_url`.",
+	"config_logging_username":               "The username to authenticate at the log sink, e.g. `stackit_observability_credential.example.username`. It's not returned by the API, so changes outside of Terraform aren't detected.",
+	"config_logging_password":               "The password to authenticate at the log sink, e.g. `stackit_observability_credential.example.password`. It's not returned by the API, so changes outside of Terraform aren't detected.",
 	"domain_name":                           "The name of the domain",
 	"domain_status":                         "The status of the domain",
 	"domain_type":                           "The type of the domain. Each distribution has one domain of type \"managed\", and domains of type \"custom\" may be additionally created by the user",
 	"domain_errors":                         "List of domain errors",
+	"domain_error_details":                  "List of domain errors with their key and human-readable message",
 }
 
 type Model struct {
@@ -72,24 +104,54 @@ type Model struct {
 	CreatedAt      types.String `tfsdk:"created_at"`      // When the distribution was created
 	UpdatedAt      types.String `tfsdk:"updated_at"`      // When the distribution was last updated
 	Errors         types.List   `tfsdk:"errors"`          // Any errors that the distribution has
+	ErrorDetails   types.List   `tfsdk:"error_details"`   // Any errors that the distribution has, with their key
 	Domains        types.List   `tfsdk:"domains"`         // The domains associated with the distribution
+	DomainsStatus  types.String `tfsdk:"domains_status"`  // The aggregated status of the domains
 	Config         types.Object `tfsdk:"config"`          // the configuration of the distribution
 }
 
+// resourceModel extends the model shared with the data source by the attributes which only apply to the resource
+type resourceModel struct {
+	Model
+	WaitForDomainsActive types.Bool `tfsdk:"wait_for_domains_active"` // Whether to wait for all domains to become active
+	DeletionProtection   types.Bool `tfsdk:"deletion_protection"`     // Whether the distribution is protected from deletion
+}
+
 type distributionConfig struct {
 	Backend          backend      `tfsdk:"backend"`           // The backend associated with the distribution
 	Regions          *[]string    `tfsdk:"regions"`           // The regions in which data will be cached
 	BlockedCountries *[]string    `tfsdk:"blocked_countries"` // The countries for which content will be blocked
+	BlockedIPs       *[]string    `tfsdk:"blocked_ips"`       // The IP addresses and CIDR ranges for which content will be blocked
 	Optimizer        types.Object `tfsdk:"optimizer"`         // The optimizer configuration
+	Cache            types.Object `tfsdk:"cache"`             // The cache configuration
+	Waf              types.Object `tfsdk:"waf"`               // The WAF configuration
+	Logging          types.Object `tfsdk:"logging"`           // The log sink configuration
 }
 
 type optimizerConfig struct {
 	Enabled types.Bool `tfsdk:"enabled"`
 }
 
+type cacheConfig struct {
+	DefaultDuration types.String `tfsdk:"default_duration"`
+}
+
+type wafConfig struct {
+	Mode types.String `tfsdk:"mode"`
+	Type types.String `tfsdk:"type"`
+}
+
+type loggingConfig struct {
+	Type     types.String `tfsdk:"type"`
+	PushURL  types.String `tfsdk:"push_url"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
 type backend struct {
 	Type                 string                `tfsdk:"type"`                   // The type of the backend. Currently, only "http" backend is supported
-	OriginURL            string                `tfsdk:"origin_url"`             // The origin URL of the backend
+	OriginURL            types.String          `tfsdk:"origin_url"`             // The origin URL of the backend
+	BucketId             types.String          `tfsdk:"bucket_id"`              // The object storage bucket used as origin, if any
 	OriginRequestHeaders *map[string]string    `tfsdk:"origin_request_headers"` // Request headers that should be added by the CDN distribution to incoming requests
 	Geofencing           *map[string][]*string `tfsdk:"geofencing"`             // The geofencing is an object mapping multiple alternative origins to country codes.
 }
@@ -97,16 +159,49 @@ type backend struct {
 var configTypes = map[string]attr.Type{
 	"backend":           types.ObjectType{AttrTypes: backendTypes},
 	"regions":           types.ListType{ElemType: types.StringType},
-	"blocked_countries": types.ListType{ElemType: types.StringType},
+	"blocked_countries": types.SetType{ElemType: types.StringType},
+	"blocked_ips":       types.SetType{ElemType: types.StringType},
 	"optimizer": types.ObjectType{
 		AttrTypes: optimizerTypes,
 	},
+	"cache": types.ObjectType{
+		AttrTypes: cacheTypes,
+	},
+	"waf": types.ObjectType{
+		AttrTypes: wafTypes,
+	},
+	"logging": types.ObjectType{
+		AttrTypes: loggingTypes,
+	},
 }
 
 var optimizerTypes = map[string]attr.Type{
 	"enabled": types.BoolType,
 }
 
+var cacheTypes = map[string]attr.Type{
+	"default_duration": types.StringType,
+}
+
+var wafTypes = map[string]attr.Type{
+	"mode": types.StringType,
+	"type": types.StringType,
+}
+
+var loggingTypes = map[string]attr.Type{
+	"type":     types.StringType,
+	"push_url": types.StringType,
+	"username": types.StringType,
+	"password": types.StringType,
+}
+
+// lokiLogSinkType is the type of the only log sink currently supported by the API
+const lokiLogSinkType = "loki"
+
+// iso8601DurationRegex matches ISO 8601 durations as accepted by the CDN API, e.g. "P1DT2H30M".
+// The time part must contain at least one of hours, minutes and seconds, so "P1DT" is rejected. "P" alone is rejected in the schema.
+var iso8601DurationRegex = regexp.MustCompile(`^P(\d+Y)?(\d+M)?(\d+W)?(\d+D)?(T(\d+H(\d+M)?(\d+S)?|\d+M(\d+S)?|\d+S))?$`)
+
 var geofencingTypes = types.MapType{ElemType: types.ListType{
 	ElemType: types.StringType,
 }}
@@ -114,20 +209,28 @@ var geofencingTypes = types.MapType{ElemType: types.ListType{
 var backendTypes = map[string]attr.Type{
 	"type":                   types.StringType,
 	"origin_url":             types.StringType,
+	"bucket_id":              types.StringType,
 	"origin_request_headers": types.MapType{ElemType: types.StringType},
 	"geofencing":             geofencingTypes,
 }
 
 var domainTypes = map[string]attr.Type{
-	"name":   types.StringType,
-	"status": types.StringType,
-	"type":   types.StringType,
-	"errors": types.ListType{ElemType: types.StringType},
+	"name":          types.StringType,
+	"status":        types.StringType,
+	"type":          types.StringType,
+	"errors":        types.ListType{ElemType: types.StringType},
+	"error_details": types.ListType{ElemType: types.ObjectType{AttrTypes: errorDetailTypes}},
+}
+
+var errorDetailTypes = map[string]attr.Type{
+	"key":     types.StringType,
+	"message": types.StringType,
 }
 
 type distributionResource struct {
-	client       *cdn.APIClient
-	providerData core.ProviderData
+	client              *cdn.APIClient
+	objectStorageClient *objectstorage.APIClient
+	providerData        core.ProviderData
 }
 
 func NewDistributionResource() resource.Resource {
@@ -151,6 +254,12 @@ func (r *distributionResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 	r.client = apiClient
+
+	objectStorageApiClient := objectstorageUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.objectStorageClient = objectStorageApiClient
 	tflog.Info(ctx, "CDN client configured")
 }
 
@@ -158,8 +267,15 @@ func (r *distributionResource) Metadata(_ context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_cdn_distribution"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *distributionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 func (r *distributionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	backendOptions := []string{"http"}
+	wafModeOptions := sdkUtils.EnumSliceToStringSlice(cdn.AllowedWafModeEnumValues)
+	wafTypeOptions := sdkUtils.EnumSliceToStringSlice(cdn.AllowedWafTypeEnumValues)
 	resp.Schema = schema.Schema{
 		MarkdownDescription: features.AddBetaDescription("CDN distribution data source schema.", core.Resource),
 		Description:         "CDN distribution data source schema.",
@@ -201,6 +317,24 @@ func (r *distributionResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:    true,
 				Description: schemaDescriptions["errors"],
 			},
+			"error_details": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: schemaDescriptions["error_details"],
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: errorDetailsAttributes(),
+				},
+			},
+			"domains_status": schema.StringAttribute{
+				Computed:    true,
+				Description: schemaDescriptions["domains_status"],
+			},
+			"wait_for_domains_active": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: schemaDescriptions["wait_for_domains_active"],
+			},
+			"deletion_protection": utils.DeletionProtectionAttribute("CDN distribution"),
 			"domains": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: schemaDescriptions["domains"],
@@ -223,6 +357,13 @@ func (r *distributionResource) Schema(_ context.Context, _ resource.SchemaReques
 							Description: schemaDescriptions["domain_errors"],
 							ElementType: types.StringType,
 						},
+						"error_details": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: schemaDescriptions["domain_error_details"],
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: errorDetailsAttributes(),
+							},
+						},
 					},
 				},
 			},
@@ -244,6 +385,74 @@ func (r *distributionResource) Schema(_ context.Context, _ resource.SchemaReques
 							objectvalidator.AlsoRequires(path.MatchRelative().AtName("enabled")),
 						},
 					},
+					"cache": schema.SingleNestedAttribute{
+						Description: schemaDescriptions["config_cache"],
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"default_duration": schema.StringAttribute{
+								Description: schemaDescriptions["config_cache_default_duration"],
+								Required:    true,
+								Validators: []validator.String{
+									stringvalidator.RegexMatches(iso8601DurationRegex, "must be an ISO 8601 duration, e.g. P1DT2H30M"),
+									stringvalidator.NoneOf("P"),
+								},
+							},
+						},
+					},
+					"waf": schema.SingleNestedAttribute{
+						Description: schemaDescriptions["config_waf"],
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
+						Attributes: map[string]schema.Attribute{
+							"mode": schema.StringAttribute{
+								Description: schemaDescriptions["config_waf_mode"] + utils.FormatPossibleValues(wafModeOptions...),
+								Required:    true,
+								Validators: []validator.String{
+									stringvalidator.OneOf(wafModeOptions...),
+								},
+							},
+							"type": schema.StringAttribute{
+								Description: schemaDescriptions["config_waf_type"] + utils.FormatPossibleValues(wafTypeOptions...),
+								Optional:    true,
+								Computed:    true,
+								Default:     stringdefault.StaticString(string(cdn.WAFTYPE_FREE)),
+								Validators: []validator.String{
+									stringvalidator.OneOf(wafTypeOptions...),
+								},
+							},
+						},
+					},
+					"logging": schema.SingleNestedAttribute{
+						Description: schemaDescriptions["config_logging"],
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								Description: schemaDescriptions["config_logging_type"] + utils.FormatPossibleValues(lokiLogSinkType),
+								Optional:    true,
+								Computed:    true,
+								Default:     stringdefault.StaticString(lokiLogSinkType),
+								Validators: []validator.String{
+									stringvalidator.OneOf(lokiLogSinkType),
+								},
+							},
+							"push_url": schema.StringAttribute{
+								Description: schemaDescriptions["config_logging_push_url"],
+								Required:    true,
+							},
+							"username": schema.StringAttribute{
+								Description: schemaDescriptions["config_logging_username"],
+								Required:    true,
+							},
+							"password": schema.StringAttribute{
+								Description: schemaDescriptions["config_logging_password"],
+								Required:    true,
+								Sensitive:   true,
+							},
+						},
+					},
 					"backend": schema.SingleNestedAttribute{
 						Required:    true,
 						Description: schemaDescriptions["config_backend"],
@@ -254,8 +463,19 @@ func (r *distributionResource) Schema(_ context.Context, _ resource.SchemaReques
 								Validators:  []validator.String{stringvalidator.OneOf(backendOptions...)},
 							},
 							"origin_url": schema.StringAttribute{
-								Required:    true,
+								Optional:    true,
+								Computed:    true,
 								Description: schemaDescriptions["config_backend_origin_url"],
+								Validators: []validator.String{
+									stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("bucket_id")),
+								},
+								PlanModifiers: []planmodifier.String{
+									utils.UseStateForUnknownIf(hasBucketIdChanged, "sets `UseStateForUnknown` only if `bucket_id` has not changed"),
+								},
+							},
+							"bucket_id": schema.StringAttribute{
+								Optional:    true,
+								Description: schemaDescriptions["config_backend_bucket_id"],
 							},
 							"origin_request_headers": schema.MapAttribute{
 								Optional:    true,
@@ -263,7 +483,7 @@ func (r *distributionResource) Schema(_ context.Context, _ resource.SchemaReques
 								ElementType: types.StringType,
 							},
 							"geofencing": schema.MapAttribute{
-								Description: "A map of URLs to a list of countries where content is allowed.",
+								Description: "A map of alternative origin URLs to lists of ISO 3166-1 alpha-2 country codes. Requests from these countries are routed to the alternative origin. A country can only be assigned to one URL.",
 								Optional:    true,
 								ElementType: types.ListType{
 									ElemType: types.StringType,
@@ -279,10 +499,21 @@ func (r *distributionResource) Schema(_ context.Context, _ resource.SchemaReques
 						Description: schemaDescriptions["config_regions"],
 						ElementType: types.StringType,
 					},
-					"blocked_countries": schema.ListAttribute{
+					"blocked_countries": schema.SetAttribute{
 						Optional:    true,
 						Description: schemaDescriptions["config_blocked_countries"],
 						ElementType: types.StringType,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(countryCodeValidator{}),
+						},
+					},
+					"blocked_ips": schema.SetAttribute{
+						Optional:    true,
+						Description: schemaDescriptions["config_blocked_ips"],
+						ElementType: types.StringType,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.Any(validate.IP(false), validate.CIDR())),
+						},
 					},
 				},
 			},
@@ -290,8 +521,21 @@ func (r *distributionResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func errorDetailsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"key": schema.StringAttribute{
+			Computed:    true,
+			Description: schemaDescriptions["error_key"],
+		},
+		"message": schema.StringAttribute{
+			Computed:    true,
+			Description: schemaDescriptions["error_message"],
+		},
+	}
+}
+
 func (r *distributionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var model Model
+	var model resourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -304,7 +548,13 @@ func (r *distributionResource) ValidateConfig(ctx context.Context, req resource.
 			if diags.HasError() {
 				return
 			}
+			if bucketId := config.Backend.BucketId; !utils.IsUndefined(bucketId) {
+				if _, _, _, err := parseBucketId(bucketId.ValueString()); err != nil {
+					resp.Diagnostics.AddAttributeError(path.Root("config").AtName("backend").AtName("bucket_id"), "Invalid bucket ID", err.Error())
+				}
+			}
 			if geofencing := config.Backend.Geofencing; geofencing != nil {
+				assignedCountries := map[string]string{}
 				for url, region := range *geofencing {
 					if region == nil {
 						core.LogAndAddError(ctx, &resp.Diagnostics, "Invalid geofencing config", fmt.Sprintf("The list of countries for URL %q must not be null.", url))
@@ -320,6 +570,16 @@ func (r *distributionResource) ValidateConfig(ctx context.Context, req resource.
 							core.LogAndAddError(ctx, &resp.Diagnostics, "Invalid geofencing config", fmt.Sprintf("Found a null value in the country list for URL %q at index %d.", url, i))
 							break
 						}
+						country, err := validateISOCountryCode(*countryPtr)
+						if err != nil {
+							core.LogAndAddError(ctx, &resp.Diagnostics, "Invalid geofencing config", fmt.Sprintf("Invalid country in the country list for URL %q at index %d: %v", url, i, err))
+							continue
+						}
+						if otherUrl, ok := assignedCountries[country]; ok && otherUrl != url {
+							core.LogAndAddError(ctx, &resp.Diagnostics, "Invalid geofencing config", fmt.Sprintf("Country %q is assigned to the URLs %q and %q, but can only be assigned to one URL.", country, otherUrl, url))
+							continue
+						}
+						assignedCountries[country] = url
 					}
 				}
 			}
@@ -328,7 +588,9 @@ func (r *distributionResource) ValidateConfig(ctx context.Context, req resource.
 }
 
 func (r *distributionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	var model resourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -340,7 +602,13 @@ func (r *distributionResource) Create(ctx context.Context, req resource.CreateRe
 	projectId := model.ProjectId.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 
-	payload, err := toCreatePayload(ctx, &model)
+	err := r.resolveBucketOrigin(ctx, &model.Model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating CDN distribution", fmt.Sprintf("Resolving object storage backend: %v", err))
+		return
+	}
+
+	payload, err := toCreatePayload(ctx, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating CDN distribution", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -354,13 +622,13 @@ func (r *distributionResource) Create(ctx context.Context, req resource.CreateRe
 
 	ctx = core.LogResponse(ctx)
 
-	waitResp, err := wait.CreateDistributionPoolWaitHandler(ctx, r.client, projectId, *createResp.Distribution.Id).SetTimeout(5 * time.Minute).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandlerWithTimeout(wait.CreateDistributionPoolWaitHandler(ctx, r.client, projectId, *createResp.Distribution.Id), r.providerData.Wait, core.WaitCreate, 5*time.Minute).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating CDN distribution", fmt.Sprintf("Waiting for create: %v", err))
 		return
 	}
 
-	err = mapFields(ctx, waitResp.Distribution, &model)
+	err = mapFields(ctx, waitResp.Distribution, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating CDN distribution", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -371,11 +639,20 @@ func (r *distributionResource) Create(ctx context.Context, req resource.CreateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if model.WaitForDomainsActive.ValueBool() {
+		r.waitForDomainsActive(ctx, &model, &resp.State, &resp.Diagnostics, core.WaitCreate, "Error creating CDN distribution")
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	tflog.Info(ctx, "CDN distribution created")
 }
 
 func (r *distributionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var model resourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -395,7 +672,7 @@ func (r *distributionResource) Read(ctx context.Context, req resource.ReadReques
 		// n.b. err is caught here if of type *oapierror.GenericOpenAPIError, which the stackit SDK client returns
 		if errors.As(err, &oapiErr) {
 			if oapiErr.StatusCode == http.StatusNotFound {
-				resp.State.RemoveResource(ctx)
+				core.RemoveFromStateWithWarning(ctx, resp, "stackit_cdn_distribution", model.ID.ValueString())
 				return
 			}
 		}
@@ -405,7 +682,7 @@ func (r *distributionResource) Read(ctx context.Context, req resource.ReadReques
 
 	ctx = core.LogResponse(ctx)
 
-	err = mapFields(ctx, cdnResp.Distribution, &model)
+	err = mapFields(ctx, cdnResp.Distribution, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading CDN ditribution", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -420,7 +697,9 @@ func (r *distributionResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *distributionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var model resourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -434,6 +713,12 @@ func (r *distributionResource) Update(ctx context.Context, req resource.UpdateRe
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "distribution_id", distributionId)
 
+	err := r.resolveBucketOrigin(ctx, &model.Model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Update CDN distribution", fmt.Sprintf("Resolving object storage backend: %v", err))
+		return
+	}
+
 	configModel := distributionConfig{}
 	diags = model.Config.As(ctx, &configModel, basetypes.ObjectAsOptions{
 		UnhandledNullAsEmpty:    false,
@@ -454,54 +739,37 @@ func (r *distributionResource) Update(ctx context.Context, req resource.UpdateRe
 		regions = append(regions, *regionEnum)
 	}
 
-	// blockedCountries
-	// Use a pointer to a slice to distinguish between an empty list (unblock all) and nil (no change).
-	var blockedCountries *[]string
-	if configModel.BlockedCountries != nil {
-		// Use a temporary slice
-		tempBlockedCountries := []string{}
-
-		for _, blockedCountry := range *configModel.BlockedCountries {
-			validatedBlockedCountry, err := validateCountryCode(blockedCountry)
-			if err != nil {
-				core.LogAndAddError(ctx, &resp.Diagnostics, "Update CDN distribution", fmt.Sprintf("Blocked countries: %v", err))
-				return
-			}
-			tempBlockedCountries = append(tempBlockedCountries, validatedBlockedCountry)
-		}
-
-		// Point to the populated slice
-		blockedCountries = &tempBlockedCountries
+	// blockedCountries and blockedIPs
+	// An empty list unblocks all, so removing the attribute from the configuration removes all blocks.
+	blockedCountries, err := toBlockedCountriesPayload(configModel.BlockedCountries)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Update CDN distribution", fmt.Sprintf("Blocked countries: %v", err))
+		return
+	}
+	blockedIPs := []string{}
+	if configModel.BlockedIPs != nil {
+		blockedIPs = *configModel.BlockedIPs
 	}
 
-	geofencingPatch := map[string][]string{}
-	if configModel.Backend.Geofencing != nil {
-		gf := make(map[string][]string)
-		for url, countries := range *configModel.Backend.Geofencing {
-			countryStrings := make([]string, len(countries))
-			for i, countryPtr := range countries {
-				if countryPtr == nil {
-					core.LogAndAddError(ctx, &resp.Diagnostics, "Update CDN distribution", fmt.Sprintf("Geofencing url %q has a null value", url))
-					return
-				}
-				countryStrings[i] = *countryPtr
-			}
-			gf[url] = countryStrings
-		}
-		geofencingPatch = gf
+	// An empty map removes all geofencing rules
+	geofencingPatch, err := toGeofencingPayload(configModel.Backend.Geofencing)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Update CDN distribution", fmt.Sprintf("Geofencing: %v", err))
+		return
 	}
 
 	configPatch := &cdn.ConfigPatch{
 		Backend: &cdn.ConfigPatchBackend{
 			HttpBackendPatch: &cdn.HttpBackendPatch{
 				OriginRequestHeaders: configModel.Backend.OriginRequestHeaders,
-				OriginUrl:            &configModel.Backend.OriginURL,
+				OriginUrl:            configModel.Backend.OriginURL.ValueStringPointer(),
 				Type:                 &configModel.Backend.Type,
 				Geofencing:           &geofencingPatch, // Use the converted variable
 			},
 		},
 		Regions:          &regions,
-		BlockedCountries: blockedCountries,
+		BlockedCountries: &blockedCountries,
+		BlockedIPs:       &blockedIPs,
 	}
 
 	if !utils.IsUndefined(configModel.Optimizer) {
@@ -520,7 +788,43 @@ func (r *distributionResource) Update(ctx context.Context, req resource.UpdateRe
 		configPatch.Optimizer = optimizer
 	}
 
-	_, err := r.client.PatchDistribution(ctx, projectId, distributionId).PatchDistributionPayload(cdn.PatchDistributionPayload{
+	// An explicit null removes a previously configured default cache duration
+	configPatch.DefaultCacheDuration = cdn.NewNullableString(nil)
+	if !utils.IsUndefined(configModel.Cache) {
+		var cacheModel cacheConfig
+		diags = configModel.Cache.As(ctx, &cacheModel, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Update CDN distribution", "Error mapping cache config")
+			return
+		}
+		configPatch.DefaultCacheDuration = cdn.NewNullableString(conversion.StringValueToPointer(cacheModel.DefaultDuration))
+	}
+
+	if !utils.IsUndefined(configModel.Waf) {
+		waf, err := toWafConfig(ctx, configModel.Waf)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Update CDN distribution", fmt.Sprintf("Mapping WAF config: %v", err))
+			return
+		}
+		configPatch.Waf = &cdn.WafConfigPatch{
+			Mode: waf.Mode,
+			Type: waf.Type,
+		}
+	}
+
+	// An explicit null removes a previously configured log sink
+	configPatch.LogSink = cdn.NewNullableConfigPatchLogSink(nil)
+	if !utils.IsUndefined(configModel.Logging) {
+		logSink, err := toLogSinkPayload(ctx, configModel.Logging)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Update CDN distribution", fmt.Sprintf("Mapping logging config: %v", err))
+			return
+		}
+		configPatchLogSink := cdn.PatchLokiLogSinkAsConfigPatchLogSink(logSink)
+		configPatch.LogSink = cdn.NewNullableConfigPatchLogSink(&configPatchLogSink)
+	}
+
+	_, err = r.client.PatchDistribution(ctx, projectId, distributionId).PatchDistributionPayload(cdn.PatchDistributionPayload{
 		Config:   configPatch,
 		IntentId: cdn.PtrString(uuid.NewString()),
 	}).Execute()
@@ -531,13 +835,13 @@ func (r *distributionResource) Update(ctx context.Context, req resource.UpdateRe
 
 	ctx = core.LogResponse(ctx)
 
-	waitResp, err := wait.UpdateDistributionWaitHandler(ctx, r.client, projectId, distributionId).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandler(wait.UpdateDistributionWaitHandler(ctx, r.client, projectId, distributionId), r.providerData.Wait, core.WaitUpdate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Update CDN distribution", fmt.Sprintf("Waiting for update: %v", err))
 		return
 	}
 
-	err = mapFields(ctx, waitResp.Distribution, &model)
+	err = mapFields(ctx, waitResp.Distribution, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Update CDN distribution", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -548,11 +852,18 @@ func (r *distributionResource) Update(ctx context.Context, req resource.UpdateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if model.WaitForDomainsActive.ValueBool() {
+		r.waitForDomainsActive(ctx, &model, &resp.State, &resp.Diagnostics, core.WaitUpdate, "Update CDN distribution")
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	tflog.Info(ctx, "CDN distribution updated")
 }
 
 func (r *distributionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model resourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -566,6 +877,10 @@ func (r *distributionResource) Delete(ctx context.Context, req resource.DeleteRe
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "distribution_id", distributionId)
 
+	if utils.BlockDeletionIfProtected(ctx, req.State, "CDN distribution", &resp.Diagnostics) {
+		return
+	}
+
 	_, err := r.client.DeleteDistribution(ctx, projectId, distributionId).Execute()
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Delete CDN distribution", fmt.Sprintf("Delete distribution: %v", err))
@@ -573,7 +888,7 @@ func (r *distributionResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.DeleteDistributionWaitHandler(ctx, r.client, projectId, distributionId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.DeleteDistributionWaitHandler(ctx, r.client, projectId, distributionId), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Delete CDN distribution", fmt.Sprintf("Waiting for deletion: %v", err))
 		return
@@ -582,17 +897,68 @@ func (r *distributionResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *distributionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing CDN distribution", fmt.Sprintf("Expected import identifier on the format: [project_id]%q[distribution_id], got %q", core.Separator, req.ID))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing CDN distribution", fmt.Sprintf("Expected import identifier on the format: [project_id]%q[distribution_id], got %q", core.Separator, importId))
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("distribution_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_domains_active"), false)...)
 	tflog.Info(ctx, "CDN distribution state imported")
 }
 
+// waitForDomainsActive waits until all domains of the distribution are active and stores the refreshed distribution in the state.
+func (r *distributionResource) waitForDomainsActive(ctx context.Context, model *resourceModel, state *tfsdk.State, diags *diag.Diagnostics, operation core.WaitOperation, errorSummary string) {
+	projectId := model.ProjectId.ValueString()
+	distributionId := model.DistributionId.ValueString()
+
+	waitResp, err := core.ConfigureWaitHandler(cdnUtils.DomainsActiveWaitHandler(ctx, r.client, projectId, distributionId), r.providerData.Wait, operation).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, diags, errorSummary, fmt.Sprintf("Waiting for domains to become active: %v", err))
+		return
+	}
+
+	err = mapFields(ctx, waitResp.Distribution, &model.Model)
+	if err != nil {
+		core.LogAndAddError(ctx, diags, errorSummary, fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags.Append(state.Set(ctx, model)...)
+}
+
+// mapErrorDetails maps the errors of a distribution or domain to a list of objects with their key and human-readable message.
+func mapErrorDetails(statusErrors *[]cdn.StatusError) (types.List, error) {
+	errorDetails := []attr.Value{}
+	if statusErrors != nil {
+		for _, e := range *statusErrors {
+			if e.En == nil {
+				return types.ListNull(types.ObjectType{AttrTypes: errorDetailTypes}), fmt.Errorf("error description missing")
+			}
+			errorDetail, diags := types.ObjectValue(errorDetailTypes, map[string]attr.Value{
+				"key":     types.StringValue(string(e.GetKey())),
+				"message": types.StringValue(*e.En),
+			})
+			if diags.HasError() {
+				return types.ListNull(types.ObjectType{AttrTypes: errorDetailTypes}), core.DiagsToError(diags)
+			}
+			errorDetails = append(errorDetails, errorDetail)
+		}
+	}
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: errorDetailTypes}, errorDetails)
+	if diags.HasError() {
+		return types.ListNull(types.ObjectType{AttrTypes: errorDetailTypes}), core.DiagsToError(diags)
+	}
+	return list, nil
+}
+
 func mapFields(ctx context.Context, distribution *cdn.Distribution, model *Model) error {
+	return mapFieldsWithTypes(ctx, distribution, model, configTypes, backendTypes)
+}
+
+// mapFieldsWithTypes maps the distribution into a config with the given attribute types, as the config of the data source differs from the resource.
+func mapFieldsWithTypes(ctx context.Context, distribution *cdn.Distribution, model *Model, configAttrTypes, backendAttrTypes map[string]attr.Type) error {
 	if distribution == nil {
 		return fmt.Errorf("response input is nil")
 	}
@@ -640,6 +1006,12 @@ func mapFields(ctx context.Context, distribution *cdn.Distribution, model *Model
 	}
 	model.Errors = modelErrors
 
+	modelErrorDetails, err := mapErrorDetails(distribution.Errors)
+	if err != nil {
+		return err
+	}
+	model.ErrorDetails = modelErrorDetails
+
 	// regions
 	regions := []attr.Value{}
 	for _, r := range *distribution.Config.Regions {
@@ -650,19 +1022,6 @@ func mapFields(ctx context.Context, distribution *cdn.Distribution, model *Model
 		return core.DiagsToError(diags)
 	}
 
-	// blockedCountries
-	var blockedCountries []attr.Value
-	if distribution.Config != nil && distribution.Config.BlockedCountries != nil {
-		for _, c := range *distribution.Config.BlockedCountries {
-			blockedCountries = append(blockedCountries, types.StringValue(string(c)))
-		}
-	}
-
-	modelBlockedCountries, diags := types.ListValue(types.StringType, blockedCountries)
-	if diags.HasError() {
-		return core.DiagsToError(diags)
-	}
-
 	// originRequestHeaders
 	originRequestHeaders := types.MapNull(types.StringType)
 	if origHeaders := distribution.Config.Backend.HttpBackend.OriginRequestHeaders; origHeaders != nil && len(*origHeaders) > 0 {
@@ -680,6 +1039,8 @@ func mapFields(ctx context.Context, distribution *cdn.Distribution, model *Model
 	// geofencing
 	var oldConfig distributionConfig
 	oldGeofencingMap := make(map[string][]*string)
+	// the bucket reference is not known to the CDN API, so it is kept from the plan/state
+	bucketId := types.StringNull()
 	if !model.Config.IsNull() {
 		diags = model.Config.As(ctx, &oldConfig, basetypes.ObjectAsOptions{})
 		if diags.HasError() {
@@ -688,47 +1049,38 @@ func mapFields(ctx context.Context, distribution *cdn.Distribution, model *Model
 		if oldConfig.Backend.Geofencing != nil {
 			oldGeofencingMap = *oldConfig.Backend.Geofencing
 		}
-	}
-
-	reconciledGeofencingData := make(map[string][]string)
-	if geofencingAPI := distribution.Config.Backend.HttpBackend.Geofencing; geofencingAPI != nil && len(*geofencingAPI) > 0 {
-		newGeofencingMap := *geofencingAPI
-		for url, newCountries := range newGeofencingMap {
-			oldCountriesPtrs := oldGeofencingMap[url]
-
-			oldCountries := utils.ConvertPointerSliceToStringSlice(oldCountriesPtrs)
-
-			reconciledCountries := utils.ReconcileStringSlices(oldCountries, newCountries)
-			reconciledGeofencingData[url] = reconciledCountries
+		if !oldConfig.Backend.BucketId.IsUnknown() {
+			bucketId = oldConfig.Backend.BucketId
 		}
 	}
 
-	geofencingVal := types.MapNull(geofencingTypes.ElemType)
-	if len(reconciledGeofencingData) > 0 {
-		geofencingMapElems := make(map[string]attr.Value)
-		for url, countries := range reconciledGeofencingData {
-			listVal, diags := types.ListValueFrom(ctx, types.StringType, countries)
-			if diags.HasError() {
-				return core.DiagsToError(diags)
-			}
-			geofencingMapElems[url] = listVal
-		}
+	geofencingVal, err := mapGeofencing(ctx, oldGeofencingMap, distribution.Config.Backend.HttpBackend.Geofencing)
+	if err != nil {
+		return fmt.Errorf("mapping geofencing: %w", err)
+	}
 
-		var mappedGeofencing basetypes.MapValue
-		mappedGeofencing, diags = types.MapValue(geofencingTypes.ElemType, geofencingMapElems)
-		if diags.HasError() {
-			return core.DiagsToError(diags)
-		}
-		geofencingVal = mappedGeofencing
+	// blockedCountries and blockedIPs
+	modelBlockedCountries, err := mapBlockedSet(ctx, oldConfig.BlockedCountries, distribution.Config.BlockedCountries)
+	if err != nil {
+		return fmt.Errorf("mapping blocked countries: %w", err)
+	}
+	modelBlockedIPs, err := mapBlockedSet(ctx, oldConfig.BlockedIPs, distribution.Config.BlockedIPs)
+	if err != nil {
+		return fmt.Errorf("mapping blocked IPs: %w", err)
 	}
 
 	// note that httpbackend is hardcoded here as long as it is the only available backend
-	backend, diags := types.ObjectValue(backendTypes, map[string]attr.Value{
+	backendValues := map[string]attr.Value{
 		"type":                   types.StringValue(*distribution.Config.Backend.HttpBackend.Type),
 		"origin_url":             types.StringValue(*distribution.Config.Backend.HttpBackend.OriginUrl),
 		"origin_request_headers": originRequestHeaders,
 		"geofencing":             geofencingVal,
-	})
+	}
+	// the data source has no bucket_id, see dataSourceBackendTypes
+	if _, ok := backendAttrTypes["bucket_id"]; ok {
+		backendValues["bucket_id"] = bucketId
+	}
+	backend, diags := types.ObjectValue(backendAttrTypes, backendValues)
 	if diags.HasError() {
 		return core.DiagsToError(diags)
 	}
@@ -746,11 +1098,46 @@ func mapFields(ctx context.Context, distribution *cdn.Distribution, model *Model
 			}
 		}
 	}
-	cfg, diags := types.ObjectValue(configTypes, map[string]attr.Value{
+	cacheVal := types.ObjectNull(cacheTypes)
+	if defaultCacheDuration := distribution.Config.GetDefaultCacheDuration(); defaultCacheDuration != nil {
+		cacheVal, diags = types.ObjectValue(cacheTypes, map[string]attr.Value{
+			"default_duration": types.StringValue(*defaultCacheDuration),
+		})
+		if diags.HasError() {
+			return core.DiagsToError(diags)
+		}
+	}
+	wafVal := types.ObjectNull(wafTypes)
+	if waf := distribution.Config.Waf; waf != nil {
+		wafMode := types.StringNull()
+		if waf.Mode != nil {
+			wafMode = types.StringValue(string(*waf.Mode))
+		}
+		wafType := types.StringNull()
+		if waf.Type != nil {
+			wafType = types.StringValue(string(*waf.Type))
+		}
+		wafVal, diags = types.ObjectValue(wafTypes, map[string]attr.Value{
+			"mode": wafMode,
+			"type": wafType,
+		})
+		if diags.HasError() {
+			return core.DiagsToError(diags)
+		}
+	}
+	loggingVal, err := mapLogging(ctx, oldConfig.Logging, distribution.Config.LogSink)
+	if err != nil {
+		return fmt.Errorf("mapping logging: %w", err)
+	}
+	cfg, diags := types.ObjectValue(configAttrTypes, map[string]attr.Value{
 		"backend":           backend,
 		"regions":           modelRegions,
 		"blocked_countries": modelBlockedCountries,
+		"blocked_ips":       modelBlockedIPs,
 		"optimizer":         optimizerVal,
+		"cache":             cacheVal,
+		"waf":               wafVal,
+		"logging":           loggingVal,
 	})
 	if diags.HasError() {
 		return core.DiagsToError(diags)
@@ -773,14 +1160,19 @@ func mapFields(ctx context.Context, distribution *cdn.Distribution, model *Model
 			if diags.HasError() {
 				return core.DiagsToError(diags)
 			}
+			modelDomainErrorDetails, err := mapErrorDetails(d.Errors)
+			if err != nil {
+				return err
+			}
 			if d.Name == nil || d.Status == nil || d.Type == nil {
 				return fmt.Errorf("domain entry incomplete")
 			}
 			modelDomain, diags := types.ObjectValue(domainTypes, map[string]attr.Value{
-				"name":   types.StringValue(*d.Name),
-				"status": types.StringValue(string(*d.Status)),
-				"type":   types.StringValue(string(*d.Type)),
-				"errors": modelDomainErrors,
+				"name":          types.StringValue(*d.Name),
+				"status":        types.StringValue(string(*d.Status)),
+				"type":          types.StringValue(string(*d.Type)),
+				"errors":        modelDomainErrors,
+				"error_details": modelDomainErrorDetails,
 			})
 			if diags.HasError() {
 				return core.DiagsToError(diags)
@@ -795,6 +1187,7 @@ func mapFields(ctx context.Context, distribution *cdn.Distribution, model *Model
 		return core.DiagsToError(diags)
 	}
 	model.Domains = modelDomains
+	model.DomainsStatus = types.StringValue(string(cdnUtils.AggregateDomainsStatus(distribution.Domains)))
 	return nil
 }
 
@@ -816,9 +1209,29 @@ func toCreatePayload(ctx context.Context, model *Model) (*cdn.CreateDistribution
 		OriginUrl:            cfg.Backend.HttpBackend.OriginUrl,
 		Regions:              cfg.Regions,
 		BlockedCountries:     cfg.BlockedCountries,
+		BlockedIPs:           cfg.BlockedIPs,
 		OriginRequestHeaders: cfg.Backend.HttpBackend.OriginRequestHeaders,
 		Geofencing:           cfg.Backend.HttpBackend.Geofencing,
 		Optimizer:            optimizer,
+		Waf:                  cfg.Waf,
+	}
+	if cfg.DefaultCacheDuration != nil {
+		payload.DefaultCacheDuration = cfg.DefaultCacheDuration.Get()
+	}
+
+	// the credentials of the log sink aren't part of the distribution config, so the log sink is taken from the model
+	var configModel distributionConfig
+	diags := model.Config.As(ctx, &configModel, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+	if !utils.IsUndefined(configModel.Logging) {
+		logSink, err := toLogSinkPayload(ctx, configModel.Logging)
+		if err != nil {
+			return nil, fmt.Errorf("logging: %w", err)
+		}
+		payloadLogSink := cdn.PatchLokiLogSinkAsCreateDistributionPayloadLogSink(logSink)
+		payload.LogSink = &payloadLogSink
 	}
 
 	return payload, nil
@@ -851,34 +1264,21 @@ func convertConfig(ctx context.Context, model *Model) (*cdn.Config, error) {
 	}
 
 	// blockedCountries
-	var blockedCountries []string
-	if configModel.BlockedCountries != nil {
-		for _, blockedCountry := range *configModel.BlockedCountries {
-			validatedBlockedCountry, err := validateCountryCode(blockedCountry)
-			if err != nil {
-				return nil, err
-			}
-			blockedCountries = append(blockedCountries, validatedBlockedCountry)
-		}
+	blockedCountries, err := toBlockedCountriesPayload(configModel.BlockedCountries)
+	if err != nil {
+		return nil, err
+	}
+
+	// blockedIPs
+	blockedIPs := []string{}
+	if configModel.BlockedIPs != nil {
+		blockedIPs = *configModel.BlockedIPs
 	}
 
 	// geofencing
-	geofencing := map[string][]string{}
-	if configModel.Backend.Geofencing != nil {
-		for endpoint, countryCodes := range *configModel.Backend.Geofencing {
-			geofencingCountry := make([]string, len(countryCodes))
-			for i, countryCodePtr := range countryCodes {
-				if countryCodePtr == nil {
-					return nil, fmt.Errorf("geofencing url %q has a null value", endpoint)
-				}
-				validatedCountry, err := validateCountryCode(*countryCodePtr)
-				if err != nil {
-					return nil, err
-				}
-				geofencingCountry[i] = validatedCountry
-			}
-			geofencing[endpoint] = geofencingCountry
-		}
+	geofencing, err := toGeofencingPayload(configModel.Backend.Geofencing)
+	if err != nil {
+		return nil, err
 	}
 
 	// originRequestHeaders
@@ -893,13 +1293,14 @@ func convertConfig(ctx context.Context, model *Model) (*cdn.Config, error) {
 		Backend: &cdn.ConfigBackend{
 			HttpBackend: &cdn.HttpBackend{
 				OriginRequestHeaders: &originRequestHeaders,
-				OriginUrl:            &configModel.Backend.OriginURL,
+				OriginUrl:            configModel.Backend.OriginURL.ValueStringPointer(),
 				Type:                 &configModel.Backend.Type,
 				Geofencing:           &geofencing,
 			},
 		},
 		Regions:          &regions,
 		BlockedCountries: &blockedCountries,
+		BlockedIPs:       &blockedIPs,
 	}
 
 	if !utils.IsUndefined(configModel.Optimizer) {
@@ -914,9 +1315,304 @@ func convertConfig(ctx context.Context, model *Model) (*cdn.Config, error) {
 		}
 	}
 
+	if !utils.IsUndefined(configModel.Cache) {
+		var cacheModel cacheConfig
+		diags := configModel.Cache.As(ctx, &cacheModel, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return nil, core.DiagsToError(diags)
+		}
+
+		if !utils.IsUndefined(cacheModel.DefaultDuration) {
+			cdnConfig.DefaultCacheDuration = cdn.NewNullableString(cacheModel.DefaultDuration.ValueStringPointer())
+		}
+	}
+
+	if !utils.IsUndefined(configModel.Waf) {
+		waf, err := toWafConfig(ctx, configModel.Waf)
+		if err != nil {
+			return nil, err
+		}
+		cdnConfig.Waf = waf
+	}
+
 	return cdnConfig, nil
 }
 
+// toLogSinkPayload converts the logging config of the model into the log sink payload of the API.
+// Only Loki is supported as log sink, which is also used if no type is set.
+func toLogSinkPayload(ctx context.Context, loggingObject types.Object) (*cdn.PatchLokiLogSink, error) {
+	var loggingModel loggingConfig
+	diags := loggingObject.As(ctx, &loggingModel, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+	logSinkType := lokiLogSinkType
+	if !utils.IsUndefined(loggingModel.Type) {
+		logSinkType = loggingModel.Type.ValueString()
+	}
+	if logSinkType != lokiLogSinkType {
+		return nil, fmt.Errorf("unsupported log sink type %q", logSinkType)
+	}
+	return &cdn.PatchLokiLogSink{
+		Type:     cdn.PtrString(logSinkType),
+		PushUrl:  conversion.StringValueToPointer(loggingModel.PushURL),
+		Username: conversion.StringValueToPointer(loggingModel.Username),
+		Password: conversion.StringValueToPointer(loggingModel.Password),
+	}, nil
+}
+
+// mapLogging maps the log sink returned by the API. The credentials of the log sink aren't returned by the API,
+// so they are kept from the prior logging config.
+func mapLogging(ctx context.Context, priorLogging types.Object, logSink *cdn.ConfigLogSink) (types.Object, error) {
+	if logSink == nil || logSink.LokiLogSink == nil {
+		return types.ObjectNull(loggingTypes), nil
+	}
+
+	username := types.StringNull()
+	password := types.StringNull()
+	if !utils.IsUndefined(priorLogging) {
+		var priorModel loggingConfig
+		diags := priorLogging.As(ctx, &priorModel, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return types.ObjectNull(loggingTypes), core.DiagsToError(diags)
+		}
+		username = priorModel.Username
+		password = priorModel.Password
+	}
+
+	logging, diags := types.ObjectValue(loggingTypes, map[string]attr.Value{
+		"type":     types.StringPointerValue(logSink.LokiLogSink.Type),
+		"push_url": types.StringPointerValue(logSink.LokiLogSink.PushUrl),
+		"username": username,
+		"password": password,
+	})
+	if diags.HasError() {
+		return types.ObjectNull(loggingTypes), core.DiagsToError(diags)
+	}
+	return logging, nil
+}
+
+// toWafConfig maps the WAF configuration of the model. Explicitly enabled rules aren't managed by the provider.
+func toWafConfig(ctx context.Context, wafObject types.Object) (*cdn.WafConfig, error) {
+	var wafModel wafConfig
+	diags := wafObject.As(ctx, &wafModel, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+
+	waf := &cdn.WafConfig{
+		EnabledRuleIds: &[]string{},
+	}
+	if !utils.IsUndefined(wafModel.Mode) {
+		mode, err := cdn.NewWafModeFromValue(wafModel.Mode.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("WAF mode: %w", err)
+		}
+		waf.Mode = mode
+	}
+	wafType := cdn.WAFTYPE_FREE.Ptr()
+	if !utils.IsUndefined(wafModel.Type) {
+		var err error
+		wafType, err = cdn.NewWafTypeFromValue(wafModel.Type.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("WAF type: %w", err)
+		}
+	}
+	waf.Type = wafType
+	return waf, nil
+}
+
+// resolveBucketOrigin sets the origin URL of the backend if it references an object storage bucket.
+// It verifies that the bucket exists in the referenced region.
+func (r *distributionResource) resolveBucketOrigin(ctx context.Context, model *Model) error {
+	configModel := distributionConfig{}
+	diags := model.Config.As(ctx, &configModel, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	if utils.IsUndefined(configModel.Backend.BucketId) {
+		return nil
+	}
+
+	projectId, region, bucketName, err := parseBucketId(configModel.Backend.BucketId.ValueString())
+	if err != nil {
+		return err
+	}
+	bucketResp, err := r.objectStorageClient.GetBucket(ctx, projectId, region, bucketName).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("object storage bucket %q does not exist in region %q of project %q", bucketName, region, projectId)
+		}
+		return fmt.Errorf("reading object storage bucket %q: %w", bucketName, err)
+	}
+
+	originURL, err := bucketOriginURL(bucketResp)
+	if err != nil {
+		return err
+	}
+	tflog.Debug(ctx, "Resolved object storage bucket origin", map[string]any{"bucket_name": bucketName, "origin_url": originURL})
+
+	configModel.Backend.OriginURL = types.StringValue(originURL)
+	cfg, diags := types.ObjectValueFrom(ctx, configTypes, configModel)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.Config = cfg
+	return nil
+}
+
+// parseBucketId splits the Terraform ID of an object storage bucket into its components.
+func parseBucketId(bucketId string) (projectId, region, bucketName string, err error) {
+	idParts := strings.Split(bucketId, core.Separator)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return "", "", "", fmt.Errorf("expected bucket ID on the format [project_id]%q[region]%q[name], got %q", core.Separator, core.Separator, bucketId)
+	}
+	return idParts[0], idParts[1], idParts[2], nil
+}
+
+// bucketOriginURL returns the URL under which the CDN can reach the bucket
+func bucketOriginURL(bucketResp *objectstorage.GetBucketResponse) (string, error) {
+	if bucketResp == nil || bucketResp.Bucket == nil {
+		return "", fmt.Errorf("bucket response is empty")
+	}
+	originURL := bucketResp.Bucket.GetUrlVirtualHostedStyle()
+	if originURL == "" {
+		return "", fmt.Errorf("bucket %q has no virtual hosted style URL", bucketResp.Bucket.GetName())
+	}
+	return originURL, nil
+}
+
+func hasBucketIdChanged(ctx context.Context, request planmodifier.StringRequest, response *utils.UseStateForUnknownFuncResponse) { // nolint:gocritic // function signature required by Terraform
+	dependencyPath := request.Path.ParentPath().AtName("bucket_id")
+
+	var bucketIdPlan types.String
+	diags := request.Plan.GetAttribute(ctx, dependencyPath, &bucketIdPlan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var bucketIdState types.String
+	diags = request.State.GetAttribute(ctx, dependencyPath, &bucketIdState)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if bucketIdState == bucketIdPlan {
+		response.UseStateForUnknown = true
+		return
+	}
+}
+
+// toGeofencingPayload converts the geofencing config into the API representation, with validated upper case country codes.
+func toGeofencingPayload(geofencing *map[string][]*string) (map[string][]string, error) {
+	payload := map[string][]string{}
+	if geofencing == nil {
+		return payload, nil
+	}
+	for url, countryCodes := range *geofencing {
+		countries := make([]string, len(countryCodes))
+		for i, countryCodePtr := range countryCodes {
+			if countryCodePtr == nil {
+				return nil, fmt.Errorf("geofencing url %q has a null value", url)
+			}
+			validatedCountry, err := validateISOCountryCode(*countryCodePtr)
+			if err != nil {
+				return nil, fmt.Errorf("geofencing url %q: %w", url, err)
+			}
+			countries[i] = validatedCountry
+		}
+		payload[url] = countries
+	}
+	return payload, nil
+}
+
+// mapGeofencing maps the geofencing returned by the API. The order and spelling of the country codes
+// in the prior geofencing are kept, so that e.g. "de" in the configuration doesn't show up as drift to "DE".
+func mapGeofencing(ctx context.Context, priorGeofencing map[string][]*string, geofencing *map[string][]string) (types.Map, error) {
+	if geofencing == nil || len(*geofencing) == 0 {
+		return types.MapNull(geofencingTypes.ElemType), nil
+	}
+
+	elements := make(map[string]attr.Value, len(*geofencing))
+	for url, countries := range *geofencing {
+		priorCountries := utils.ConvertPointerSliceToStringSlice(priorGeofencing[url])
+		spelling := make(map[string]string, len(priorCountries))
+		for _, priorCountry := range priorCountries {
+			spelling[strings.ToUpper(priorCountry)] = priorCountry
+		}
+		apiCountries := make([]string, len(countries))
+		for i, country := range countries {
+			apiCountries[i] = country
+			if priorCountry, ok := spelling[strings.ToUpper(country)]; ok {
+				apiCountries[i] = priorCountry
+			}
+		}
+
+		list, diags := types.ListValueFrom(ctx, types.StringType, utils.ReconcileStringSlices(priorCountries, apiCountries))
+		if diags.HasError() {
+			return types.MapNull(geofencingTypes.ElemType), core.DiagsToError(diags)
+		}
+		elements[url] = list
+	}
+
+	mappedGeofencing, diags := types.MapValue(geofencingTypes.ElemType, elements)
+	if diags.HasError() {
+		return types.MapNull(geofencingTypes.ElemType), core.DiagsToError(diags)
+	}
+	return mappedGeofencing, nil
+}
+
+// toBlockedCountriesPayload converts the blocked countries into the API representation, with upper case country codes.
+// A null set is converted to an empty list, which unblocks all countries.
+func toBlockedCountriesPayload(blockedCountries *[]string) ([]string, error) {
+	payload := []string{}
+	if blockedCountries == nil {
+		return payload, nil
+	}
+	for _, blockedCountry := range *blockedCountries {
+		validatedBlockedCountry, err := validateCountryCode(blockedCountry)
+		if err != nil {
+			return nil, err
+		}
+		payload = append(payload, validatedBlockedCountry)
+	}
+	return payload, nil
+}
+
+// mapBlockedSet maps the blocked countries or IPs returned by the API. No blocked values are mapped to null, unless an empty set
+// is configured. The spelling of the prior values is kept, so that e.g. "de" in the configuration doesn't show up as drift to "DE".
+func mapBlockedSet(ctx context.Context, prior, blocked *[]string) (types.Set, error) {
+	if blocked == nil || len(*blocked) == 0 {
+		if prior != nil && len(*prior) == 0 {
+			return types.SetValueMust(types.StringType, []attr.Value{}), nil
+		}
+		return types.SetNull(types.StringType), nil
+	}
+
+	spelling := map[string]string{}
+	if prior != nil {
+		for _, priorValue := range *prior {
+			spelling[strings.ToUpper(priorValue)] = priorValue
+		}
+	}
+	values := make([]string, len(*blocked))
+	for i, value := range *blocked {
+		values[i] = value
+		if priorValue, ok := spelling[strings.ToUpper(value)]; ok {
+			values[i] = priorValue
+		}
+	}
+
+	set, diags := types.SetValueFrom(ctx, types.StringType, values)
+	if diags.HasError() {
+		return types.SetNull(types.StringType), core.DiagsToError(diags)
+	}
+	return set, nil
+}
+
 // validateCountryCode checks for a valid country user input. This is just a quick check
 // since the API already does a more thorough check.
 func validateCountryCode(country string) (string, error) {
@@ -938,3 +1634,38 @@ func validateCountryCode(country string) (string, error) {
 
 	return upperCountry, nil
 }
+
+// validateISOCountryCode checks that the user input is an ISO 3166-1 alpha-2 country code and returns it in upper case.
+// Unknown country codes would silently never match any request, so this check is stricter than validateCountryCode.
+func validateISOCountryCode(country string) (string, error) {
+	upperCountry, err := validateCountryCode(country)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := isoCountryCodes[upperCountry]; !ok {
+		return "", fmt.Errorf("country code '%s' is not an ISO 3166-1 alpha-2 country code", country)
+	}
+	return upperCountry, nil
+}
+
+// countryCodeValidator validates that a string is an ISO 3166-1 alpha-2 country code
+type countryCodeValidator struct{}
+
+var _ validator.String = countryCodeValidator{}
+
+func (v countryCodeValidator) Description(_ context.Context) string {
+	return "value must be an ISO 3166-1 alpha-2 country code"
+}
+
+func (v countryCodeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v countryCodeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) { // nolint:gocritic // function signature required by Terraform
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+	if _, err := validateISOCountryCode(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(req.Path, v.Description(ctx), req.ConfigValue.ValueString()))
+	}
+}
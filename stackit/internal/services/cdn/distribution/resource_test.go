@@ -8,7 +8,9 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/cdn"
+	"github.com/stackitcloud/stackit-sdk-go/services/objectstorage"
 )
 
 func TestToCreatePayload(t *testing.T) {
@@ -27,21 +29,35 @@ func TestToCreatePayload(t *testing.T) {
 	backend := types.ObjectValueMust(backendTypes, map[string]attr.Value{
 		"type":                   types.StringValue("http"),
 		"origin_url":             types.StringValue("https://www.mycoolapp.com"),
+		"bucket_id":              types.StringNull(),
 		"origin_request_headers": originRequestHeaders,
 		"geofencing":             geofencing,
 	})
 	regions := []attr.Value{types.StringValue("EU"), types.StringValue("US")}
 	regionsFixture := types.ListValueMust(types.StringType, regions)
 	blockedCountries := []attr.Value{types.StringValue("XX"), types.StringValue("YY"), types.StringValue("ZZ")}
-	blockedCountriesFixture := types.ListValueMust(types.StringType, blockedCountries)
+	blockedCountriesFixture := types.SetValueMust(types.StringType, blockedCountries)
+	blockedIPs := []attr.Value{types.StringValue("192.0.2.1"), types.StringValue("198.51.100.0/24")}
+	blockedIPsFixture := types.SetValueMust(types.StringType, blockedIPs)
 	optimizer := types.ObjectValueMust(optimizerTypes, map[string]attr.Value{
 		"enabled": types.BoolValue(true),
 	})
+	cache := types.ObjectValueMust(cacheTypes, map[string]attr.Value{
+		"default_duration": types.StringValue("P1DT2H30M"),
+	})
+	waf := types.ObjectValueMust(wafTypes, map[string]attr.Value{
+		"mode": types.StringValue("LOG_ONLY"),
+		"type": types.StringValue("PREMIUM"),
+	})
 	config := types.ObjectValueMust(configTypes, map[string]attr.Value{
 		"backend":           backend,
 		"regions":           regionsFixture,
 		"blocked_countries": blockedCountriesFixture,
+		"blocked_ips":       blockedIPsFixture,
+		"logging":           types.ObjectNull(loggingTypes),
+		"waf":               types.ObjectNull(wafTypes),
 		"optimizer":         types.ObjectNull(optimizerTypes),
+		"cache":             types.ObjectNull(cacheTypes),
 	})
 	modelFixture := func(mods ...func(*Model)) *Model {
 		model := &Model{
@@ -69,6 +85,7 @@ func TestToCreatePayload(t *testing.T) {
 				OriginUrl:        cdn.PtrString("https://www.mycoolapp.com"),
 				Regions:          &[]cdn.Region{"EU", "US"},
 				BlockedCountries: &[]string{"XX", "YY", "ZZ"},
+				BlockedIPs:       &[]string{"192.0.2.1", "198.51.100.0/24"},
 				Geofencing: &map[string][]string{
 					"https://de.mycoolapp.com": {"DE", "FR"},
 				},
@@ -82,6 +99,10 @@ func TestToCreatePayload(t *testing.T) {
 					"regions":           regionsFixture,
 					"optimizer":         optimizer,
 					"blocked_countries": blockedCountriesFixture,
+					"blocked_ips":       blockedIPsFixture,
+					"logging":           types.ObjectNull(loggingTypes),
+					"waf":               types.ObjectNull(wafTypes),
+					"cache":             types.ObjectNull(cacheTypes),
 				})
 			}),
 			Expected: &cdn.CreateDistributionPayload{
@@ -93,12 +114,116 @@ func TestToCreatePayload(t *testing.T) {
 				Regions:          &[]cdn.Region{"EU", "US"},
 				Optimizer:        cdn.NewOptimizer(true),
 				BlockedCountries: &[]string{"XX", "YY", "ZZ"},
+				BlockedIPs:       &[]string{"192.0.2.1", "198.51.100.0/24"},
 				Geofencing: &map[string][]string{
 					"https://de.mycoolapp.com": {"DE", "FR"},
 				},
 			},
 			IsValid: true,
 		},
+		"happy_path_with_cache": {
+			Input: modelFixture(func(m *Model) {
+				m.Config = types.ObjectValueMust(configTypes, map[string]attr.Value{
+					"backend":           backend,
+					"regions":           regionsFixture,
+					"optimizer":         types.ObjectNull(optimizerTypes),
+					"blocked_countries": blockedCountriesFixture,
+					"blocked_ips":       blockedIPsFixture,
+					"logging":           types.ObjectNull(loggingTypes),
+					"waf":               types.ObjectNull(wafTypes),
+					"cache":             cache,
+				})
+			}),
+			Expected: &cdn.CreateDistributionPayload{
+				OriginRequestHeaders: &map[string]string{
+					"testHeader0": "testHeaderValue0",
+					"testHeader1": "testHeaderValue1",
+				},
+				OriginUrl:            cdn.PtrString("https://www.mycoolapp.com"),
+				Regions:              &[]cdn.Region{"EU", "US"},
+				BlockedCountries:     &[]string{"XX", "YY", "ZZ"},
+				BlockedIPs:           &[]string{"192.0.2.1", "198.51.100.0/24"},
+				DefaultCacheDuration: cdn.PtrString("P1DT2H30M"),
+				Geofencing: &map[string][]string{
+					"https://de.mycoolapp.com": {"DE", "FR"},
+				},
+			},
+			IsValid: true,
+		},
+		"happy_path_with_waf": {
+			Input: modelFixture(func(m *Model) {
+				m.Config = types.ObjectValueMust(configTypes, map[string]attr.Value{
+					"backend":           backend,
+					"regions":           regionsFixture,
+					"optimizer":         types.ObjectNull(optimizerTypes),
+					"blocked_countries": blockedCountriesFixture,
+					"blocked_ips":       blockedIPsFixture,
+					"logging":           types.ObjectNull(loggingTypes),
+					"waf":               waf,
+					"cache":             types.ObjectNull(cacheTypes),
+				})
+			}),
+			Expected: &cdn.CreateDistributionPayload{
+				OriginRequestHeaders: &map[string]string{
+					"testHeader0": "testHeaderValue0",
+					"testHeader1": "testHeaderValue1",
+				},
+				OriginUrl:        cdn.PtrString("https://www.mycoolapp.com"),
+				Regions:          &[]cdn.Region{"EU", "US"},
+				BlockedCountries: &[]string{"XX", "YY", "ZZ"},
+				BlockedIPs:       &[]string{"192.0.2.1", "198.51.100.0/24"},
+				Geofencing: &map[string][]string{
+					"https://de.mycoolapp.com": {"DE", "FR"},
+				},
+				Waf: &cdn.WafConfig{
+					EnabledRuleIds: &[]string{},
+					Mode:           cdn.WAFMODE_LOG_ONLY.Ptr(),
+					Type:           cdn.WAFTYPE_PREMIUM.Ptr(),
+				},
+			},
+			IsValid: true,
+		},
+		"happy_path_with_logging": {
+			Input: modelFixture(func(m *Model) {
+				m.Config = types.ObjectValueMust(configTypes, map[string]attr.Value{
+					"backend":           backend,
+					"regions":           regionsFixture,
+					"optimizer":         types.ObjectNull(optimizerTypes),
+					"blocked_countries": blockedCountriesFixture,
+					"blocked_ips":       blockedIPsFixture,
+					"logging": types.ObjectValueMust(loggingTypes, map[string]attr.Value{
+						"type":     types.StringValue("loki"),
+						"push_url": types.StringValue("https://logs.example.com/push"),
+						"username": types.StringValue("user"),
+						"password": types.StringValue("password"),
+					}),
+					"waf":   types.ObjectNull(wafTypes),
+					"cache": types.ObjectNull(cacheTypes),
+				})
+			}),
+			Expected: &cdn.CreateDistributionPayload{
+				OriginRequestHeaders: &map[string]string{
+					"testHeader0": "testHeaderValue0",
+					"testHeader1": "testHeaderValue1",
+				},
+				OriginUrl:        cdn.PtrString("https://www.mycoolapp.com"),
+				Regions:          &[]cdn.Region{"EU", "US"},
+				BlockedCountries: &[]string{"XX", "YY", "ZZ"},
+				BlockedIPs:       &[]string{"192.0.2.1", "198.51.100.0/24"},
+				Geofencing: &map[string][]string{
+					"https://de.mycoolapp.com": {"DE", "FR"},
+				},
+				LogSink: &cdn.CreateDistributionPayloadLogSink{
+					PatchLokiLogSink: &cdn.PatchLokiLogSink{
+						Type:     cdn.PtrString("loki"),
+						PushUrl:  cdn.PtrString("https://logs.example.com/push"),
+						Username: cdn.PtrString("user"),
+						Password: cdn.PtrString("password"),
+					},
+				},
+			},
+			IsValid: true,
+		},
 		"sad_path_model_nil": {
 			Input:    nil,
 			Expected: nil,
@@ -150,19 +275,30 @@ func TestConvertConfig(t *testing.T) {
 	backend := types.ObjectValueMust(backendTypes, map[string]attr.Value{
 		"type":                   types.StringValue("http"),
 		"origin_url":             types.StringValue("https://www.mycoolapp.com"),
+		"bucket_id":              types.StringNull(),
 		"origin_request_headers": originRequestHeaders,
 		"geofencing":             geofencing,
 	})
 	regions := []attr.Value{types.StringValue("EU"), types.StringValue("US")}
 	regionsFixture := types.ListValueMust(types.StringType, regions)
 	blockedCountries := []attr.Value{types.StringValue("XX"), types.StringValue("YY"), types.StringValue("ZZ")}
-	blockedCountriesFixture := types.ListValueMust(types.StringType, blockedCountries)
+	blockedCountriesFixture := types.SetValueMust(types.StringType, blockedCountries)
+	blockedIPs := []attr.Value{types.StringValue("192.0.2.1"), types.StringValue("198.51.100.0/24")}
+	blockedIPsFixture := types.SetValueMust(types.StringType, blockedIPs)
 	optimizer := types.ObjectValueMust(optimizerTypes, map[string]attr.Value{"enabled": types.BoolValue(true)})
+	waf := types.ObjectValueMust(wafTypes, map[string]attr.Value{
+		"mode": types.StringValue("ENABLED"),
+		"type": types.StringNull(),
+	})
 	config := types.ObjectValueMust(configTypes, map[string]attr.Value{
 		"backend":           backend,
 		"regions":           regionsFixture,
 		"optimizer":         types.ObjectNull(optimizerTypes),
 		"blocked_countries": blockedCountriesFixture,
+		"blocked_ips":       blockedIPsFixture,
+		"logging":           types.ObjectNull(loggingTypes),
+		"waf":               types.ObjectNull(wafTypes),
+		"cache":             types.ObjectNull(cacheTypes),
 	})
 	modelFixture := func(mods ...func(*Model)) *Model {
 		model := &Model{
@@ -198,6 +334,7 @@ func TestConvertConfig(t *testing.T) {
 				},
 				Regions:          &[]cdn.Region{"EU", "US"},
 				BlockedCountries: &[]string{"XX", "YY", "ZZ"},
+				BlockedIPs:       &[]string{"192.0.2.1", "198.51.100.0/24"},
 			},
 			IsValid: true,
 		},
@@ -208,6 +345,10 @@ func TestConvertConfig(t *testing.T) {
 					"regions":           regionsFixture,
 					"optimizer":         optimizer,
 					"blocked_countries": blockedCountriesFixture,
+					"blocked_ips":       blockedIPsFixture,
+					"logging":           types.ObjectNull(loggingTypes),
+					"waf":               types.ObjectNull(wafTypes),
+					"cache":             types.ObjectNull(cacheTypes),
 				})
 			}),
 			Expected: &cdn.Config{
@@ -227,9 +368,67 @@ func TestConvertConfig(t *testing.T) {
 				Regions:          &[]cdn.Region{"EU", "US"},
 				Optimizer:        cdn.NewOptimizer(true),
 				BlockedCountries: &[]string{"XX", "YY", "ZZ"},
+				BlockedIPs:       &[]string{"192.0.2.1", "198.51.100.0/24"},
 			},
 			IsValid: true,
 		},
+		"happy_path_with_waf": {
+			Input: modelFixture(func(m *Model) {
+				m.Config = types.ObjectValueMust(configTypes, map[string]attr.Value{
+					"backend":           backend,
+					"regions":           regionsFixture,
+					"optimizer":         types.ObjectNull(optimizerTypes),
+					"blocked_countries": blockedCountriesFixture,
+					"blocked_ips":       blockedIPsFixture,
+					"logging":           types.ObjectNull(loggingTypes),
+					"waf":               waf,
+					"cache":             types.ObjectNull(cacheTypes),
+				})
+			}),
+			Expected: &cdn.Config{
+				Backend: &cdn.ConfigBackend{
+					HttpBackend: &cdn.HttpBackend{
+						OriginRequestHeaders: &map[string]string{
+							"testHeader0": "testHeaderValue0",
+							"testHeader1": "testHeaderValue1",
+						},
+						OriginUrl: cdn.PtrString("https://www.mycoolapp.com"),
+						Type:      cdn.PtrString("http"),
+						Geofencing: &map[string][]string{
+							"https://de.mycoolapp.com": {"DE", "FR"},
+						},
+					},
+				},
+				Regions:          &[]cdn.Region{"EU", "US"},
+				BlockedCountries: &[]string{"XX", "YY", "ZZ"},
+				BlockedIPs:       &[]string{"192.0.2.1", "198.51.100.0/24"},
+				Waf: &cdn.WafConfig{
+					EnabledRuleIds: &[]string{},
+					Mode:           cdn.WAFMODE_ENABLED.Ptr(),
+					Type:           cdn.WAFTYPE_FREE.Ptr(),
+				},
+			},
+			IsValid: true,
+		},
+		"sad_path_invalid_waf_mode": {
+			Input: modelFixture(func(m *Model) {
+				m.Config = types.ObjectValueMust(configTypes, map[string]attr.Value{
+					"backend":           backend,
+					"regions":           regionsFixture,
+					"optimizer":         types.ObjectNull(optimizerTypes),
+					"blocked_countries": blockedCountriesFixture,
+					"blocked_ips":       blockedIPsFixture,
+					"logging":           types.ObjectNull(loggingTypes),
+					"waf": types.ObjectValueMust(wafTypes, map[string]attr.Value{
+						"mode": types.StringValue("INVALID"),
+						"type": types.StringNull(),
+					}),
+					"cache": types.ObjectNull(cacheTypes),
+				})
+			}),
+			Expected: nil,
+			IsValid:  false,
+		},
 		"sad_path_model_nil": {
 			Input:    nil,
 			Expected: nil,
@@ -273,13 +472,16 @@ func TestMapFields(t *testing.T) {
 	backend := types.ObjectValueMust(backendTypes, map[string]attr.Value{
 		"type":                   types.StringValue("http"),
 		"origin_url":             types.StringValue("https://www.mycoolapp.com"),
+		"bucket_id":              types.StringNull(),
 		"origin_request_headers": originRequestHeaders,
 		"geofencing":             types.MapNull(geofencingTypes.ElemType),
 	})
 	regions := []attr.Value{types.StringValue("EU"), types.StringValue("US")}
 	regionsFixture := types.ListValueMust(types.StringType, regions)
 	blockedCountries := []attr.Value{types.StringValue("XX"), types.StringValue("YY"), types.StringValue("ZZ")}
-	blockedCountriesFixture := types.ListValueMust(types.StringType, blockedCountries)
+	blockedCountriesFixture := types.SetValueMust(types.StringType, blockedCountries)
+	blockedIPs := []attr.Value{types.StringValue("192.0.2.1"), types.StringValue("198.51.100.0/24")}
+	blockedIPsFixture := types.SetValueMust(types.StringType, blockedIPs)
 	geofencingCountries := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("DE"), types.StringValue("BR")})
 	geofencing := types.MapValueMust(geofencingTypes.ElemType, map[string]attr.Value{
 		"test/": geofencingCountries,
@@ -292,15 +494,21 @@ func TestMapFields(t *testing.T) {
 		"backend":           backend,
 		"regions":           regionsFixture,
 		"blocked_countries": blockedCountriesFixture,
+		"blocked_ips":       blockedIPsFixture,
+		"logging":           types.ObjectNull(loggingTypes),
+		"waf":               types.ObjectNull(wafTypes),
 		"optimizer":         types.ObjectNull(optimizerTypes),
+		"cache":             types.ObjectNull(cacheTypes),
 	})
 
 	emtpyErrorsList := types.ListValueMust(types.StringType, []attr.Value{})
+	emptyErrorDetailsList := types.ListValueMust(types.ObjectType{AttrTypes: errorDetailTypes}, []attr.Value{})
 	managedDomain := types.ObjectValueMust(domainTypes, map[string]attr.Value{
-		"name":   types.StringValue("test.stackit-cdn.com"),
-		"status": types.StringValue("ACTIVE"),
-		"type":   types.StringValue("managed"),
-		"errors": types.ListValueMust(types.StringType, []attr.Value{}),
+		"name":          types.StringValue("test.stackit-cdn.com"),
+		"status":        types.StringValue("ACTIVE"),
+		"type":          types.StringValue("managed"),
+		"errors":        types.ListValueMust(types.StringType, []attr.Value{}),
+		"error_details": emptyErrorDetailsList,
 	})
 	domains := types.ListValueMust(types.ObjectType{AttrTypes: domainTypes}, []attr.Value{managedDomain})
 	expectedModel := func(mods ...func(*Model)) *Model {
@@ -313,7 +521,9 @@ func TestMapFields(t *testing.T) {
 			CreatedAt:      types.StringValue(createdAt.String()),
 			UpdatedAt:      types.StringValue(updatedAt.String()),
 			Errors:         emtpyErrorsList,
+			ErrorDetails:   emptyErrorDetailsList,
 			Domains:        domains,
+			DomainsStatus:  types.StringValue("ACTIVE"),
 		}
 		for _, mod := range mods {
 			mod(model)
@@ -335,6 +545,7 @@ func TestMapFields(t *testing.T) {
 				},
 				Regions:          &[]cdn.Region{"EU", "US"},
 				BlockedCountries: &[]string{"XX", "YY", "ZZ"},
+				BlockedIPs:       &[]string{"192.0.2.1", "198.51.100.0/24"},
 				Optimizer:        nil,
 			},
 			CreatedAt: &createdAt,
@@ -372,6 +583,10 @@ func TestMapFields(t *testing.T) {
 					"regions":           regionsFixture,
 					"optimizer":         optimizer,
 					"blocked_countries": blockedCountriesFixture,
+					"blocked_ips":       blockedIPsFixture,
+					"logging":           types.ObjectNull(loggingTypes),
+					"waf":               types.ObjectNull(wafTypes),
+					"cache":             types.ObjectNull(cacheTypes),
 				})
 			}),
 			Input: distributionFixture(func(d *cdn.Distribution) {
@@ -381,11 +596,57 @@ func TestMapFields(t *testing.T) {
 			}),
 			IsValid: true,
 		},
+		"happy_path_with_waf": {
+			Expected: expectedModel(func(m *Model) {
+				m.Config = types.ObjectValueMust(configTypes, map[string]attr.Value{
+					"backend":           backend,
+					"regions":           regionsFixture,
+					"optimizer":         types.ObjectNull(optimizerTypes),
+					"blocked_countries": blockedCountriesFixture,
+					"blocked_ips":       blockedIPsFixture,
+					"logging":           types.ObjectNull(loggingTypes),
+					"waf": types.ObjectValueMust(wafTypes, map[string]attr.Value{
+						"mode": types.StringValue("ENABLED"),
+						"type": types.StringValue("PREMIUM"),
+					}),
+					"cache": types.ObjectNull(cacheTypes),
+				})
+			}),
+			Input: distributionFixture(func(d *cdn.Distribution) {
+				d.Config.Waf = &cdn.WafConfig{
+					EnabledRuleIds: &[]string{"rule-1"},
+					Mode:           cdn.WAFMODE_ENABLED.Ptr(),
+					Type:           cdn.WAFTYPE_PREMIUM.Ptr(),
+				}
+			}),
+			IsValid: true,
+		},
+		"happy_path_with_cache": {
+			Expected: expectedModel(func(m *Model) {
+				m.Config = types.ObjectValueMust(configTypes, map[string]attr.Value{
+					"backend":           backend,
+					"regions":           regionsFixture,
+					"optimizer":         types.ObjectNull(optimizerTypes),
+					"blocked_countries": blockedCountriesFixture,
+					"blocked_ips":       blockedIPsFixture,
+					"logging":           types.ObjectNull(loggingTypes),
+					"waf":               types.ObjectNull(wafTypes),
+					"cache": types.ObjectValueMust(cacheTypes, map[string]attr.Value{
+						"default_duration": types.StringValue("P1D"),
+					}),
+				})
+			}),
+			Input: distributionFixture(func(d *cdn.Distribution) {
+				d.Config.DefaultCacheDuration = cdn.NewNullableString(cdn.PtrString("P1D"))
+			}),
+			IsValid: true,
+		},
 		"happy_path_with_geofencing": {
 			Expected: expectedModel(func(m *Model) {
 				backendWithGeofencing := types.ObjectValueMust(backendTypes, map[string]attr.Value{
 					"type":                   types.StringValue("http"),
 					"origin_url":             types.StringValue("https://www.mycoolapp.com"),
+					"bucket_id":              types.StringNull(),
 					"origin_request_headers": originRequestHeaders,
 					"geofencing":             geofencing,
 				})
@@ -394,6 +655,10 @@ func TestMapFields(t *testing.T) {
 					"regions":           regionsFixture,
 					"optimizer":         types.ObjectNull(optimizerTypes),
 					"blocked_countries": blockedCountriesFixture,
+					"blocked_ips":       blockedIPsFixture,
+					"logging":           types.ObjectNull(loggingTypes),
+					"waf":               types.ObjectNull(wafTypes),
+					"cache":             types.ObjectNull(cacheTypes),
 				})
 			}),
 			Input: distributionFixture(func(d *cdn.Distribution) {
@@ -413,16 +678,18 @@ func TestMapFields(t *testing.T) {
 		"happy_path_custom_domain": {
 			Expected: expectedModel(func(m *Model) {
 				managedDomain := types.ObjectValueMust(domainTypes, map[string]attr.Value{
-					"name":   types.StringValue("test.stackit-cdn.com"),
-					"status": types.StringValue("ACTIVE"),
-					"type":   types.StringValue("managed"),
-					"errors": types.ListValueMust(types.StringType, []attr.Value{}),
+					"name":          types.StringValue("test.stackit-cdn.com"),
+					"status":        types.StringValue("ACTIVE"),
+					"type":          types.StringValue("managed"),
+					"errors":        types.ListValueMust(types.StringType, []attr.Value{}),
+					"error_details": emptyErrorDetailsList,
 				})
 				customDomain := types.ObjectValueMust(domainTypes, map[string]attr.Value{
-					"name":   types.StringValue("mycoolapp.info"),
-					"status": types.StringValue("ACTIVE"),
-					"type":   types.StringValue("custom"),
-					"errors": types.ListValueMust(types.StringType, []attr.Value{}),
+					"name":          types.StringValue("mycoolapp.info"),
+					"status":        types.StringValue("ACTIVE"),
+					"type":          types.StringValue("custom"),
+					"errors":        types.ListValueMust(types.StringType, []attr.Value{}),
+					"error_details": emptyErrorDetailsList,
 				})
 				domains := types.ListValueMust(types.ObjectType{AttrTypes: domainTypes}, []attr.Value{managedDomain, customDomain})
 				m.Domains = domains
@@ -443,6 +710,72 @@ func TestMapFields(t *testing.T) {
 			}),
 			IsValid: true,
 		},
+		"happy_path_domain_errors": {
+			Expected: expectedModel(func(m *Model) {
+				managedDomain := types.ObjectValueMust(domainTypes, map[string]attr.Value{
+					"name":          types.StringValue("test.stackit-cdn.com"),
+					"status":        types.StringValue("ACTIVE"),
+					"type":          types.StringValue("managed"),
+					"errors":        types.ListValueMust(types.StringType, []attr.Value{}),
+					"error_details": emptyErrorDetailsList,
+				})
+				customDomain := types.ObjectValueMust(domainTypes, map[string]attr.Value{
+					"name":   types.StringValue("mycoolapp.info"),
+					"status": types.StringValue("ERROR"),
+					"type":   types.StringValue("custom"),
+					"errors": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("CNAME record missing")}),
+					"error_details": types.ListValueMust(types.ObjectType{AttrTypes: errorDetailTypes}, []attr.Value{
+						types.ObjectValueMust(errorDetailTypes, map[string]attr.Value{
+							"key":     types.StringValue("CUSTOM_DOMAIN_CNAME_MISSING"),
+							"message": types.StringValue("CNAME record missing"),
+						}),
+					}),
+				})
+				m.Domains = types.ListValueMust(types.ObjectType{AttrTypes: domainTypes}, []attr.Value{managedDomain, customDomain})
+				m.DomainsStatus = types.StringValue("ERROR")
+			}),
+			Input: distributionFixture(func(d *cdn.Distribution) {
+				d.Domains = &[]cdn.Domain{
+					{
+						Name:   cdn.PtrString("test.stackit-cdn.com"),
+						Status: cdn.DOMAINSTATUS_ACTIVE.Ptr(),
+						Type:   cdn.DOMAINTYPE_MANAGED.Ptr(),
+					},
+					{
+						Name:   cdn.PtrString("mycoolapp.info"),
+						Status: cdn.DOMAINSTATUS_ERROR.Ptr(),
+						Type:   cdn.DOMAINTYPE_CUSTOM.Ptr(),
+						Errors: &[]cdn.StatusError{
+							{
+								En:  cdn.PtrString("CNAME record missing"),
+								Key: cdn.STATUSERRORKEY_CUSTOM_DOMAIN_CNAME_MISSING.Ptr(),
+							},
+						},
+					},
+				}
+			}),
+			IsValid: true,
+		},
+		"happy_path_distribution_errors": {
+			Expected: expectedModel(func(m *Model) {
+				m.Errors = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("unknown error")})
+				m.ErrorDetails = types.ListValueMust(types.ObjectType{AttrTypes: errorDetailTypes}, []attr.Value{
+					types.ObjectValueMust(errorDetailTypes, map[string]attr.Value{
+						"key":     types.StringValue("UNKNOWN"),
+						"message": types.StringValue("unknown error"),
+					}),
+				})
+			}),
+			Input: distributionFixture(func(d *cdn.Distribution) {
+				d.Errors = &[]cdn.StatusError{
+					{
+						En:  cdn.PtrString("unknown error"),
+						Key: cdn.STATUSERRORKEY_UNKNOWN.Ptr(),
+					},
+				}
+			}),
+			IsValid: true,
+		},
 		"sad_path_distribution_nil": {
 			Expected: nil,
 			Input:    nil,
@@ -587,3 +920,504 @@ func TestValidateCountryCode(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateISOCountryCode(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expected    string
+		isValid     bool
+	}{
+		{
+			description: "lowercase",
+			input:       "de",
+			expected:    "DE",
+			isValid:     true,
+		},
+		{
+			description: "uppercase",
+			input:       "AT",
+			expected:    "AT",
+			isValid:     true,
+		},
+		{
+			description: "not assigned",
+			input:       "XX",
+			isValid:     false,
+		},
+		{
+			description: "alpha-3",
+			input:       "DEU",
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := validateISOCountryCode(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if output != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestToGeofencingPayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *map[string][]*string
+		expected    map[string][]string
+		isValid     bool
+	}{
+		{
+			description: "no geofencing",
+			input:       nil,
+			expected:    map[string][]string{},
+			isValid:     true,
+		},
+		{
+			description: "country codes are upper cased",
+			input: &map[string][]*string{
+				"https://de.example.com": {utils.Ptr("de"), utils.Ptr("AT")},
+				"https://us.example.com": {utils.Ptr("US")},
+			},
+			expected: map[string][]string{
+				"https://de.example.com": {"DE", "AT"},
+				"https://us.example.com": {"US"},
+			},
+			isValid: true,
+		},
+		{
+			description: "null country code",
+			input: &map[string][]*string{
+				"https://de.example.com": {nil},
+			},
+			isValid: false,
+		},
+		{
+			description: "invalid country code",
+			input: &map[string][]*string{
+				"https://de.example.com": {utils.Ptr("XX")},
+			},
+			isValid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toGeofencingPayload(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestMapGeofencing(t *testing.T) {
+	countries := func(countries ...string) types.List {
+		values := []attr.Value{}
+		for _, c := range countries {
+			values = append(values, types.StringValue(c))
+		}
+		return types.ListValueMust(types.StringType, values)
+	}
+	tests := []struct {
+		description string
+		prior       map[string][]*string
+		input       *map[string][]string
+		expected    types.Map
+	}{
+		{
+			description: "no geofencing",
+			prior:       map[string][]*string{},
+			input:       nil,
+			expected:    types.MapNull(geofencingTypes.ElemType),
+		},
+		{
+			description: "empty geofencing",
+			prior:       map[string][]*string{},
+			input:       &map[string][]string{},
+			expected:    types.MapNull(geofencingTypes.ElemType),
+		},
+		{
+			description: "imported",
+			prior:       map[string][]*string{},
+			input: &map[string][]string{
+				"https://de.example.com": {"DE", "AT"},
+			},
+			expected: types.MapValueMust(geofencingTypes.ElemType, map[string]attr.Value{
+				"https://de.example.com": countries("DE", "AT"),
+			}),
+		},
+		{
+			description: "order and spelling of prior geofencing are kept",
+			prior: map[string][]*string{
+				"https://de.example.com": {utils.Ptr("at"), utils.Ptr("de")},
+			},
+			input: &map[string][]string{
+				"https://de.example.com": {"DE", "AT"},
+			},
+			expected: types.MapValueMust(geofencingTypes.ElemType, map[string]attr.Value{
+				"https://de.example.com": countries("at", "de"),
+			}),
+		},
+		{
+			description: "drift",
+			prior: map[string][]*string{
+				"https://de.example.com": {utils.Ptr("DE"), utils.Ptr("AT")},
+				"https://us.example.com": {utils.Ptr("US")},
+			},
+			input: &map[string][]string{
+				"https://de.example.com": {"CH", "DE"},
+			},
+			expected: types.MapValueMust(geofencingTypes.ElemType, map[string]attr.Value{
+				"https://de.example.com": countries("DE", "CH"),
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := mapGeofencing(context.Background(), tt.prior, tt.input)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapBlockedSet(t *testing.T) {
+	values := func(values ...string) types.Set {
+		elements := []attr.Value{}
+		for _, v := range values {
+			elements = append(elements, types.StringValue(v))
+		}
+		return types.SetValueMust(types.StringType, elements)
+	}
+	tests := []struct {
+		description string
+		prior       *[]string
+		input       *[]string
+		expected    types.Set
+	}{
+		{
+			description: "not blocked",
+			prior:       nil,
+			input:       nil,
+			expected:    types.SetNull(types.StringType),
+		},
+		{
+			description: "empty list",
+			prior:       nil,
+			input:       &[]string{},
+			expected:    types.SetNull(types.StringType),
+		},
+		{
+			description: "configured empty",
+			prior:       &[]string{},
+			input:       &[]string{},
+			expected:    values(),
+		},
+		{
+			description: "imported",
+			prior:       nil,
+			input:       &[]string{"DE", "192.0.2.1"},
+			expected:    values("DE", "192.0.2.1"),
+		},
+		{
+			description: "spelling of prior values is kept",
+			prior:       &[]string{"at", "de"},
+			input:       &[]string{"DE", "AT"},
+			expected:    values("at", "de"),
+		},
+		{
+			description: "drift",
+			prior:       &[]string{"DE", "AT"},
+			input:       &[]string{"CH", "DE"},
+			expected:    values("CH", "DE"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := mapBlockedSet(context.Background(), tt.prior, tt.input)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapLogging(t *testing.T) {
+	logging := func(username, password types.String) types.Object {
+		return types.ObjectValueMust(loggingTypes, map[string]attr.Value{
+			"type":     types.StringValue("loki"),
+			"push_url": types.StringValue("https://logs.example.com/push"),
+			"username": username,
+			"password": password,
+		})
+	}
+	lokiLogSink := &cdn.ConfigLogSink{
+		LokiLogSink: &cdn.LokiLogSink{
+			Type:    cdn.PtrString("loki"),
+			PushUrl: cdn.PtrString("https://logs.example.com/push"),
+		},
+	}
+	tests := []struct {
+		description string
+		prior       types.Object
+		input       *cdn.ConfigLogSink
+		expected    types.Object
+	}{
+		{
+			description: "no log sink",
+			prior:       types.ObjectNull(loggingTypes),
+			input:       nil,
+			expected:    types.ObjectNull(loggingTypes),
+		},
+		{
+			description: "log sink removed outside of terraform",
+			prior:       logging(types.StringValue("user"), types.StringValue("password")),
+			input:       nil,
+			expected:    types.ObjectNull(loggingTypes),
+		},
+		{
+			description: "credentials are kept from prior logging",
+			prior:       logging(types.StringValue("user"), types.StringValue("password")),
+			input:       lokiLogSink,
+			expected:    logging(types.StringValue("user"), types.StringValue("password")),
+		},
+		{
+			description: "imported",
+			prior:       types.ObjectNull(loggingTypes),
+			input:       lokiLogSink,
+			expected:    logging(types.StringNull(), types.StringNull()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := mapLogging(context.Background(), tt.prior, tt.input)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIso8601DurationRegex(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expected    bool
+	}{
+		{
+			"date_and_time",
+			"P1DT2H30M",
+			true,
+		},
+		{
+			"days",
+			"P1D",
+			true,
+		},
+		{
+			"weeks",
+			"P2W",
+			true,
+		},
+		{
+			"hours",
+			"PT1H",
+			true,
+		},
+		{
+			"minutes_and_seconds",
+			"PT5M30S",
+			true,
+		},
+		{
+			"seconds",
+			"PT30S",
+			true,
+		},
+		{
+			"all_parts",
+			"P1Y2M3W4DT5H6M7S",
+			true,
+		},
+		{
+			"empty_time_after_days",
+			"P1DT",
+			false,
+		},
+		{
+			"empty_time_after_years",
+			"P1YT",
+			false,
+		},
+		{
+			"empty_time",
+			"PT",
+			false,
+		},
+		{
+			"wrong_order",
+			"PT30S5M",
+			false,
+		},
+		{
+			"no_designator",
+			"1D",
+			false,
+		},
+		{
+			"lower_case",
+			"p1d",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if actual := iso8601DurationRegex.MatchString(tt.input); actual != tt.expected {
+				t.Fatalf("Match of %q not as expected: expected %t, got %t", tt.input, tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestParseBucketId(t *testing.T) {
+	tests := []struct {
+		description        string
+		input              string
+		expectedProjectId  string
+		expectedRegion     string
+		expectedBucketName string
+		isValid            bool
+	}{
+		{
+			"ok",
+			"pid,eu01,my-bucket",
+			"pid",
+			"eu01",
+			"my-bucket",
+			true,
+		},
+		{
+			"missing_region",
+			"pid,my-bucket",
+			"",
+			"",
+			"",
+			false,
+		},
+		{
+			"empty_name",
+			"pid,eu01,",
+			"",
+			"",
+			"",
+			false,
+		},
+		{
+			"too_many_parts",
+			"pid,eu01,my-bucket,extra",
+			"",
+			"",
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			projectId, region, bucketName, err := parseBucketId(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				if projectId != tt.expectedProjectId || region != tt.expectedRegion || bucketName != tt.expectedBucketName {
+					t.Fatalf("Parsed bucket ID not as expected: got (%q, %q, %q)", projectId, region, bucketName)
+				}
+			}
+		})
+	}
+}
+
+func TestBucketOriginURL(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *objectstorage.GetBucketResponse
+		expected    string
+		isValid     bool
+	}{
+		{
+			"ok",
+			&objectstorage.GetBucketResponse{
+				Bucket: &objectstorage.Bucket{
+					Name:                  utils.Ptr("my-bucket"),
+					Region:                utils.Ptr("eu01"),
+					UrlPathStyle:          utils.Ptr("https://object.storage.eu01.onstackit.cloud/my-bucket"),
+					UrlVirtualHostedStyle: utils.Ptr("https://my-bucket.object.storage.eu01.onstackit.cloud"),
+				},
+			},
+			"https://my-bucket.object.storage.eu01.onstackit.cloud",
+			true,
+		},
+		{
+			"missing_url",
+			&objectstorage.GetBucketResponse{
+				Bucket: &objectstorage.Bucket{
+					Name:   utils.Ptr("my-bucket"),
+					Region: utils.Ptr("eu01"),
+				},
+			},
+			"",
+			false,
+		},
+		{
+			"nil_response",
+			nil,
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := bucketOriginURL(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid && output != tt.expected {
+				t.Fatalf("Origin URL not as expected: got %q, want %q", output, tt.expected)
+			}
+		})
+	}
+}
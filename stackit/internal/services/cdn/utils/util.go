@@ -19,7 +19,9 @@ func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags
 	if providerData.CdnCustomEndpoint != "" {
 		apiClientConfigOptions = append(apiClientConfigOptions, config.WithEndpoint(providerData.CdnCustomEndpoint))
 	}
-	apiClient, err := cdn.NewAPIClient(apiClientConfigOptions...)
+	apiClient, err := core.CachedClient(providerData.Clients, "cdn", "", providerData.CdnCustomEndpoint, func() (*cdn.APIClient, error) {
+		return cdn.NewAPIClient(apiClientConfigOptions...)
+	})
 	if err != nil {
 		core.LogAndAddError(ctx, diags, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the resource configuration", err))
 		return nil
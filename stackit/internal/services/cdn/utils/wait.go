@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/cdn"
+	cdnWait "github.com/stackitcloud/stackit-sdk-go/services/cdn/wait"
+)
+
+// DomainsActiveWaitHandler waits until all domains of the distribution are active.
+// It fails with the errors of the domains, if a domain ends up in status ERROR.
+func DomainsActiveWaitHandler(ctx context.Context, a cdnWait.APIClientInterface, projectId, distributionId string) *wait.AsyncActionHandler[cdn.GetDistributionResponse] {
+	handler := wait.New(func() (waitFinished bool, response *cdn.GetDistributionResponse, err error) {
+		distributionResp, err := a.GetDistributionExecute(ctx, projectId, distributionId)
+		if err != nil {
+			return false, nil, err
+		}
+		if distributionResp == nil || distributionResp.Distribution == nil {
+			return false, nil, fmt.Errorf("could not get distribution from response for project %s and distribution %s", projectId, distributionId)
+		}
+		switch AggregateDomainsStatus(distributionResp.Distribution.Domains) {
+		case cdn.DOMAINSTATUS_ACTIVE:
+			return true, distributionResp, nil
+		case cdn.DOMAINSTATUS_ERROR:
+			return true, distributionResp, fmt.Errorf("domains of distribution %s failed: %s", distributionId, domainErrorsSummary(distributionResp.Distribution.Domains))
+		}
+		return false, nil, nil
+	})
+	handler.SetTimeout(30 * time.Minute)
+	return handler
+}
+
+// AggregateDomainsStatus returns ERROR if any domain failed, ACTIVE if all domains are active
+// and the status of the first pending domain otherwise.
+func AggregateDomainsStatus(domains *[]cdn.Domain) cdn.DomainStatus {
+	if domains == nil {
+		return cdn.DOMAINSTATUS_ACTIVE
+	}
+	status := cdn.DOMAINSTATUS_ACTIVE
+	for _, d := range *domains {
+		switch d.GetStatus() {
+		case cdn.DOMAINSTATUS_ERROR:
+			return cdn.DOMAINSTATUS_ERROR
+		case cdn.DOMAINSTATUS_ACTIVE:
+			continue
+		}
+		if status == cdn.DOMAINSTATUS_ACTIVE {
+			status = d.GetStatus()
+		}
+	}
+	return status
+}
+
+// domainErrorsSummary lists the human-readable errors of all failed domains, e.g. for error messages.
+func domainErrorsSummary(domains *[]cdn.Domain) string {
+	if domains == nil {
+		return ""
+	}
+	summaries := []string{}
+	for _, d := range *domains {
+		if d.GetStatus() != cdn.DOMAINSTATUS_ERROR {
+			continue
+		}
+		messages := []string{}
+		for _, e := range d.GetErrors() {
+			messages = append(messages, e.GetEn())
+		}
+		if len(messages) == 0 {
+			messages = append(messages, "no error details available")
+		}
+		summaries = append(summaries, fmt.Sprintf("%s: %s", d.GetName(), strings.Join(messages, "; ")))
+	}
+	return strings.Join(summaries, ", ")
+}
@@ -0,0 +1,197 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/cdn"
+)
+
+type apiClientMocked struct {
+	getFails  bool
+	responses []*cdn.GetDistributionResponse
+	calls     int
+}
+
+// GetDistributionExecute returns the mocked responses in order, the last one is repeated.
+func (a *apiClientMocked) GetDistributionExecute(_ context.Context, _, _ string) (*cdn.GetDistributionResponse, error) {
+	if a.getFails {
+		return nil, &oapierror.GenericOpenAPIError{
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+	idx := min(a.calls, len(a.responses)-1)
+	a.calls++
+	return a.responses[idx], nil
+}
+
+func (a *apiClientMocked) GetCustomDomainExecute(_ context.Context, _, _, _ string) (*cdn.GetCustomDomainResponse, error) {
+	return nil, nil
+}
+
+func TestAggregateDomainsStatus(t *testing.T) {
+	domain := func(status cdn.DomainStatus) cdn.Domain {
+		return cdn.Domain{Status: status.Ptr()}
+	}
+	tests := []struct {
+		description string
+		input       *[]cdn.Domain
+		expected    cdn.DomainStatus
+	}{
+		{
+			description: "no domains",
+			input:       nil,
+			expected:    cdn.DOMAINSTATUS_ACTIVE,
+		},
+		{
+			description: "all active",
+			input:       &[]cdn.Domain{domain(cdn.DOMAINSTATUS_ACTIVE), domain(cdn.DOMAINSTATUS_ACTIVE)},
+			expected:    cdn.DOMAINSTATUS_ACTIVE,
+		},
+		{
+			description: "first pending status",
+			input:       &[]cdn.Domain{domain(cdn.DOMAINSTATUS_ACTIVE), domain(cdn.DOMAINSTATUS_UPDATING), domain(cdn.DOMAINSTATUS_CREATING)},
+			expected:    cdn.DOMAINSTATUS_UPDATING,
+		},
+		{
+			description: "error wins",
+			input:       &[]cdn.Domain{domain(cdn.DOMAINSTATUS_CREATING), domain(cdn.DOMAINSTATUS_ERROR)},
+			expected:    cdn.DOMAINSTATUS_ERROR,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := AggregateDomainsStatus(tt.input)
+			if output != tt.expected {
+				t.Fatalf("expected %s, got %s", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestDomainsActiveWaitHandler(t *testing.T) {
+	distribution := func(statuses ...cdn.DomainStatus) *cdn.GetDistributionResponse {
+		domains := []cdn.Domain{}
+		for _, status := range statuses {
+			d := cdn.Domain{
+				Name:   cdn.PtrString("mycoolapp.info"),
+				Status: status.Ptr(),
+			}
+			if status == cdn.DOMAINSTATUS_ERROR {
+				d.Errors = &[]cdn.StatusError{
+					{En: cdn.PtrString("CNAME record missing")},
+				}
+			}
+			domains = append(domains, d)
+		}
+		return &cdn.GetDistributionResponse{
+			Distribution: &cdn.Distribution{Domains: &domains},
+		}
+	}
+	tests := []struct {
+		description string
+		responses   []*cdn.GetDistributionResponse
+		getFails    bool
+		wantErr     bool
+		wantErrMsg  string
+		wantResp    *cdn.GetDistributionResponse
+	}{
+		{
+			description: "domains become active",
+			responses: []*cdn.GetDistributionResponse{
+				distribution(cdn.DOMAINSTATUS_ACTIVE, cdn.DOMAINSTATUS_CREATING),
+				distribution(cdn.DOMAINSTATUS_ACTIVE, cdn.DOMAINSTATUS_ACTIVE),
+			},
+			wantErr:  false,
+			wantResp: distribution(cdn.DOMAINSTATUS_ACTIVE, cdn.DOMAINSTATUS_ACTIVE),
+		},
+		{
+			description: "domain fails",
+			responses: []*cdn.GetDistributionResponse{
+				distribution(cdn.DOMAINSTATUS_ACTIVE, cdn.DOMAINSTATUS_CREATING),
+				distribution(cdn.DOMAINSTATUS_ACTIVE, cdn.DOMAINSTATUS_ERROR),
+			},
+			wantErr:    true,
+			wantErrMsg: "domains of distribution did failed: mycoolapp.info: CNAME record missing",
+			wantResp:   distribution(cdn.DOMAINSTATUS_ACTIVE, cdn.DOMAINSTATUS_ERROR),
+		},
+		{
+			description: "domain fails while other domain is pending",
+			responses: []*cdn.GetDistributionResponse{
+				distribution(cdn.DOMAINSTATUS_CREATING, cdn.DOMAINSTATUS_ERROR),
+			},
+			wantErr:    true,
+			wantErrMsg: "domains of distribution did failed: mycoolapp.info: CNAME record missing",
+			wantResp:   distribution(cdn.DOMAINSTATUS_CREATING, cdn.DOMAINSTATUS_ERROR),
+		},
+		{
+			description: "wait interrupted by timeout",
+			responses: []*cdn.GetDistributionResponse{
+				distribution(cdn.DOMAINSTATUS_CREATING),
+			},
+			wantErr: true,
+		},
+		{
+			description: "get fails",
+			getFails:    true,
+			wantErr:     true,
+			wantErrMsg:  "500",
+		},
+		{
+			description: "missing distribution",
+			responses:   []*cdn.GetDistributionResponse{{}},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			apiClient := &apiClientMocked{
+				getFails:  tt.getFails,
+				responses: tt.responses,
+			}
+
+			handler := DomainsActiveWaitHandler(context.Background(), apiClient, "pid", "did")
+			response, err := handler.SetThrottle(time.Millisecond).SetTimeout(50 * time.Millisecond).WaitWithContext(context.Background())
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("handler error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErrMsg != "" && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Fatalf("handler error = %q, want it to contain %q", err, tt.wantErrMsg)
+			}
+			if diff := cmp.Diff(tt.wantResp, response); diff != "" {
+				t.Fatalf("unexpected response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDomainErrorsSummary(t *testing.T) {
+	domains := &[]cdn.Domain{
+		{
+			Name:   cdn.PtrString("test.stackit-cdn.com"),
+			Status: cdn.DOMAINSTATUS_ACTIVE.Ptr(),
+		},
+		{
+			Name:   cdn.PtrString("mycoolapp.info"),
+			Status: cdn.DOMAINSTATUS_ERROR.Ptr(),
+			Errors: &[]cdn.StatusError{
+				{En: cdn.PtrString("CNAME record missing")},
+				{En: cdn.PtrString("domain already in use")},
+			},
+		},
+		{
+			Name:   cdn.PtrString("example.com"),
+			Status: cdn.DOMAINSTATUS_ERROR.Ptr(),
+		},
+	}
+	expected := "mycoolapp.info: CNAME record missing; domain already in use, example.com: no error details available"
+	if output := domainErrorsSummary(domains); output != expected {
+		t.Fatalf("expected %q, got %q", expected, output)
+	}
+}
@@ -31,17 +31,17 @@ var (
 
 var testConfigVarsMin = config.Variables{
 	"project_id":     config.StringVariable(testutil.ProjectId),
-	"name":           config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
-	"dns_name":       config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha) + ".example.home"),
-	"record_name":    config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"name":           config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"dns_name":       config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha) + ".example.home"),
+	"record_name":    config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
 	"record_record1": config.StringVariable("1.2.3.4"),
 	"record_type":    config.StringVariable("A"),
 }
 
 var testConfigVarsMax = config.Variables{
 	"project_id":      config.StringVariable(testutil.ProjectId),
-	"name":            config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
-	"dns_name":        config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha) + ".example.home"),
+	"name":            config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"dns_name":        config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha) + ".example.home"),
 	"acl":             config.StringVariable("0.0.0.0/0"),
 	"active":          config.BoolVariable(true),
 	"contact_email":   config.StringVariable("contact@example.com"),
@@ -55,7 +55,7 @@ var testConfigVarsMax = config.Variables{
 	"retry_time":   config.IntegerVariable(600),
 	"type":         config.StringVariable("primary"),
 
-	"record_name":    config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"record_name":    config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
 	"record_record1": config.StringVariable("1.2.3.4"),
 	"record_active":  config.BoolVariable(true),
 	"record_comment": config.StringVariable("a test comment"),
@@ -124,6 +124,7 @@ func (d *recordSetDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				Description: "Record set state.",
 				Computed:    true,
 			},
+			"auth_profile": utils.AuthProfileDataSourceAttribute(),
 		},
 	}
 }
@@ -138,6 +139,7 @@ func (d *recordSetDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	ctx = core.InitProviderContext(ctx)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
 
 	projectId := model.ProjectId.ValueString()
 	zoneId := model.ZoneId.ValueString()
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -27,11 +29,16 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &recordSetResource{}
-	_ resource.ResourceWithConfigure   = &recordSetResource{}
-	_ resource.ResourceWithImportState = &recordSetResource{}
+	_ resource.Resource                   = &recordSetResource{}
+	_ resource.ResourceWithConfigure      = &recordSetResource{}
+	_ resource.ResourceWithImportState    = &recordSetResource{}
+	_ resource.ResourceWithIdentity       = &recordSetResource{}
+	_ resource.ResourceWithValidateConfig = &recordSetResource{}
+	_ resource.ResourceWithModifyPlan     = &recordSetResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "zone_id", "record_set_id"}
+
 type Model struct {
 	Id          types.String `tfsdk:"id"` // needed by TF
 	RecordSetId types.String `tfsdk:"record_set_id"`
@@ -46,6 +53,13 @@ type Model struct {
 	Error       types.String `tfsdk:"error"`
 	State       types.String `tfsdk:"state"`
 	FQDN        types.String `tfsdk:"fqdn"`
+	AuthProfile types.String `tfsdk:"auth_profile"`
+}
+
+// resourceModel extends the model shared with the data source by the attributes which only apply to the resource
+type resourceModel struct {
+	Model
+	AliasTarget types.String `tfsdk:"alias_target"`
 }
 
 // NewRecordSetResource is a helper function to simplify the provider implementation.
@@ -55,7 +69,8 @@ func NewRecordSetResource() resource.Resource {
 
 // recordSetResource is the resource implementation.
 type recordSetResource struct {
-	client *dns.APIClient
+	client       *dns.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -63,6 +78,11 @@ func (r *recordSetResource) Metadata(_ context.Context, req resource.MetadataReq
 	resp.TypeName = req.ProviderTypeName + "_dns_record_set"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *recordSetResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *recordSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
@@ -75,6 +95,7 @@ func (r *recordSetResource) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 	r.client = apiClient
+	r.providerData = providerData
 	tflog.Info(ctx, "DNS record set client configured")
 }
 
@@ -124,7 +145,7 @@ func (r *recordSetResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of the record which should be a valid domain according to rfc1035 Section 2.3.4. E.g. `example.com`",
+				Description: "Name of the record which should be a valid domain according to rfc1035 Section 2.3.4. E.g. `example.com`. Names outside of the zone are reported during plan, if the zone already exists.",
 				Required:    true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
@@ -135,15 +156,23 @@ func (r *recordSetResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 			},
 			"records": schema.ListAttribute{
-				Description: "Records.",
+				Description: "Records. TXT records with character strings longer than 255 characters, e.g. DKIM keys, are split into quoted chunks of at most 255 characters, the way the API stores them. Either `records` or `alias_target` must be set.",
 				ElementType: types.StringType,
-				Required:    true,
+				Optional:    true,
 				Validators: []validator.List{
 					listvalidator.SizeAtLeast(1),
 					listvalidator.UniqueValues(),
 					listvalidator.ValueStringsAre(validate.RecordSet()),
 				},
 			},
+			"alias_target": schema.StringAttribute{
+				Description: "Target of an `ALIAS` record, e.g. the domain name of a load balancer or CDN distribution. It allows pointing the apex of a zone to another domain name, which is not possible with a `CNAME` record. Requires `type` to be `ALIAS`. Either `records` or `alias_target` must be set.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("records")),
+				},
+			},
 			"ttl": schema.Int64Attribute{
 				Description: "Time to live. E.g. 3600",
 				Optional:    true,
@@ -186,14 +215,84 @@ func (r *recordSetResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "Record set state.",
 				Computed:    true,
 			},
+			"auth_profile": utils.AuthProfileAttribute(),
 		},
 	}
 }
 
+// ValidateConfig checks that an alias target is only used for ALIAS record sets.
+func (r *recordSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model resourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if utils.IsUndefined(model.AliasTarget) || model.Type.IsUnknown() {
+		return
+	}
+	if model.Type.ValueString() != string(dns.RECORDSETTYPE_ALIAS) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("alias_target"),
+			"Invalid alias target configuration",
+			fmt.Sprintf("An alias target can only be set for record sets of type %q, got %q.", dns.RECORDSETTYPE_ALIAS, model.Type.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan checks that the record name is within the DNS name of the zone, which catches records for the wrong domain before apply.
+// The check is only done if the zone is already known and can be read, otherwise errors are reported by the API on apply.
+func (r *recordSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// skip destroy and unconfigured clients, e.g. if the provider configuration is unknown
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var planModel resourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if utils.IsUndefined(planModel.ProjectId) || utils.IsUndefined(planModel.ZoneId) || utils.IsUndefined(planModel.Name) {
+		return
+	}
+
+	// only check new names to avoid a zone request on every plan
+	if !req.State.Raw.IsNull() {
+		var stateModel resourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if stateModel.Name.Equal(planModel.Name) && stateModel.ZoneId.Equal(planModel.ZoneId) {
+			return
+		}
+	}
+
+	ctx = core.InitProviderContext(ctx)
+	ctx = core.ContextWithAuthProfile(ctx, planModel.AuthProfile)
+	projectId := planModel.ProjectId.ValueString()
+	zoneId := planModel.ZoneId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "zone_id", zoneId)
+
+	zoneResp, err := r.client.GetZone(ctx, projectId, zoneId).Execute()
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Skipping check of the record name, reading zone: %v", err))
+		return
+	}
+	if zoneResp.Zone == nil || zoneResp.Zone.DnsName == nil {
+		return
+	}
+	resp.Diagnostics.Append(checkRecordNameInZone(planModel.Name.ValueString(), *zoneResp.Zone.DnsName)...)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *recordSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
-	var model Model
+	var model resourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -201,6 +300,7 @@ func (r *recordSetResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	ctx = core.InitProviderContext(ctx)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
 
 	projectId := model.ProjectId.ValueString()
 	zoneId := model.ZoneId.ValueString()
@@ -232,14 +332,14 @@ func (r *recordSetResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	waitResp, err := wait.CreateRecordSetWaitHandler(ctx, r.client, projectId, zoneId, *recordSetResp.Rrset.Id).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandler(wait.CreateRecordSetWaitHandler(ctx, r.client, projectId, zoneId, *recordSetResp.Rrset.Id), r.providerData.Wait, core.WaitCreate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating record set", fmt.Sprintf("Instance creation waiting: %v", err))
 		return
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, waitResp, &model)
+	err = mapResourceFields(ctx, waitResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating record set", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -255,7 +355,9 @@ func (r *recordSetResource) Create(ctx context.Context, req resource.CreateReque
 
 // Read refreshes the Terraform state with the latest data.
 func (r *recordSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var model resourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -263,6 +365,7 @@ func (r *recordSetResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	ctx = core.InitProviderContext(ctx)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
 
 	projectId := model.ProjectId.ValueString()
 	zoneId := model.ZoneId.ValueString()
@@ -277,14 +380,14 @@ func (r *recordSetResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 	if recordSetResp != nil && recordSetResp.Rrset.State != nil && *recordSetResp.Rrset.State == dns.RECORDSETSTATE_DELETE_SUCCEEDED {
-		resp.State.RemoveResource(ctx)
+		core.RemoveFromStateWithWarning(ctx, resp, "stackit_dns_record_set", model.Id.ValueString())
 		return
 	}
 
 	ctx = core.LogResponse(ctx)
 
 	// Map response body to schema
-	err = mapFields(ctx, recordSetResp, &model)
+	err = mapResourceFields(ctx, recordSetResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading record set", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -301,8 +404,10 @@ func (r *recordSetResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *recordSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	// Retrieve values from plan
-	var model Model
+	var model resourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -310,6 +415,7 @@ func (r *recordSetResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	ctx = core.InitProviderContext(ctx)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
 
 	projectId := model.ProjectId.ValueString()
 	zoneId := model.ZoneId.ValueString()
@@ -333,13 +439,13 @@ func (r *recordSetResource) Update(ctx context.Context, req resource.UpdateReque
 
 	ctx = core.LogResponse(ctx)
 
-	waitResp, err := wait.PartialUpdateRecordSetWaitHandler(ctx, r.client, projectId, zoneId, recordSetId).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandler(wait.PartialUpdateRecordSetWaitHandler(ctx, r.client, projectId, zoneId, recordSetId), r.providerData.Wait, core.WaitUpdate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating record set", fmt.Sprintf("Instance update waiting: %v", err))
 		return
 	}
 
-	err = mapFields(ctx, waitResp, &model)
+	err = mapResourceFields(ctx, waitResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating record set", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -363,6 +469,7 @@ func (r *recordSetResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 
 	ctx = core.InitProviderContext(ctx)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
 
 	projectId := model.ProjectId.ValueString()
 	zoneId := model.ZoneId.ValueString()
@@ -379,7 +486,7 @@ func (r *recordSetResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.DeleteRecordSetWaitHandler(ctx, r.client, projectId, zoneId, recordSetId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.DeleteRecordSetWaitHandler(ctx, r.client, projectId, zoneId, recordSetId), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting record set", fmt.Sprintf("Instance deletion waiting: %v", err))
 		return
@@ -390,11 +497,12 @@ func (r *recordSetResource) Delete(ctx context.Context, req resource.DeleteReque
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,zone_id,record_set_id
 func (r *recordSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing record set",
-			fmt.Sprintf("Expected import identifier with format [project_id],[zone_id],[record_set_id], got %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format [project_id],[zone_id],[record_set_id], got %q", importId),
 		)
 		return
 	}
@@ -407,6 +515,39 @@ func (r *recordSetResource) ImportState(ctx context.Context, req resource.Import
 	tflog.Info(ctx, "DNS record set state imported")
 }
 
+// checkRecordNameInZone checks that the record name is within the DNS name of the zone.
+// Absolute names, i.e. with a trailing dot, outside of the zone are rejected. Relative names are completed with the DNS name
+// of the zone, so a warning is shown for relative names which look like a domain of another zone, e.g. due to a typo.
+func checkRecordNameInZone(name, zoneDnsName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	zone := dnsUtils.NormalizeName(zoneDnsName)
+	record := dnsUtils.NormalizeName(name)
+	if zone == "" || record == zone || strings.HasSuffix(record, "."+zone) {
+		return diags
+	}
+
+	if strings.HasSuffix(name, ".") {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Record name not within zone",
+			fmt.Sprintf("The record name %q isn't within the zone %q. Use a name ending with %q or a name relative to the zone.", name, zoneDnsName, zone+"."),
+		)
+		return diags
+	}
+
+	zoneLabels := strings.Split(zone, ".")
+	recordLabels := strings.Split(record, ".")
+	if len(recordLabels) > 1 && recordLabels[len(recordLabels)-1] == zoneLabels[len(zoneLabels)-1] {
+		diags.AddAttributeWarning(
+			path.Root("name"),
+			"Record name not within zone",
+			fmt.Sprintf("The record name %q isn't within the zone %q, so it is treated as relative to the zone, which results in the record %q. "+
+				"If the record was meant for another domain, check the name and the zone of the record set.", name, zoneDnsName, record+"."+zone+"."),
+		)
+	}
+	return diags
+}
+
 func mapFields(ctx context.Context, recordSetResp *dns.RecordSetResponse, model *Model) error {
 	if recordSetResp == nil || recordSetResp.Rrset == nil {
 		return fmt.Errorf("response input is nil")
@@ -439,6 +580,10 @@ func mapFields(ctx context.Context, recordSetResp *dns.RecordSetResponse, model
 			return err
 		}
 
+		if recordSet.GetType() == dns.RECORDSETTYPE_TXT {
+			respRecords = dnsUtils.ReconcileTXTRecords(modelRecords, respRecords)
+		}
+
 		reconciledRecords := utils.ReconcileStringSlices(modelRecords, respRecords)
 
 		recordsTF, diags := types.ListValueFrom(ctx, types.StringType, reconciledRecords)
@@ -465,20 +610,40 @@ func mapFields(ctx context.Context, recordSetResp *dns.RecordSetResponse, model
 	return nil
 }
 
-func toCreatePayload(model *Model) (*dns.CreateRecordSetPayload, error) {
+// mapResourceFields maps the record set to the resource model. The record of an ALIAS record set is mapped to the alias target, if it is used.
+func mapResourceFields(ctx context.Context, recordSetResp *dns.RecordSetResponse, model *resourceModel) error {
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	err := mapFields(ctx, recordSetResp, &model.Model)
+	if err != nil {
+		return err
+	}
+
+	if model.AliasTarget.IsNull() {
+		return nil
+	}
+	records := model.Records.Elements()
+	if len(records) != 1 {
+		return fmt.Errorf("expected one record for the alias target, got %d", len(records))
+	}
+	aliasTarget, ok := records[0].(types.String)
+	if !ok {
+		return fmt.Errorf("expected record to be of type %T, got %T", types.String{}, records[0])
+	}
+	model.AliasTarget = aliasTarget
+	model.Records = types.ListNull(types.StringType)
+	return nil
+}
+
+func toCreatePayload(model *resourceModel) (*dns.CreateRecordSetPayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
 	}
 
-	records := []dns.RecordPayload{}
-	for i, record := range model.Records.Elements() {
-		recordString, ok := record.(types.String)
-		if !ok {
-			return nil, fmt.Errorf("expected record at index %d to be of type %T, got %T", i, types.String{}, record)
-		}
-		records = append(records, dns.RecordPayload{
-			Content: conversion.StringValueToPointer(recordString),
-		})
+	records, err := toRecordsPayload(model)
+	if err != nil {
+		return nil, err
 	}
 
 	return &dns.CreateRecordSetPayload{
@@ -490,11 +655,32 @@ func toCreatePayload(model *Model) (*dns.CreateRecordSetPayload, error) {
 	}, nil
 }
 
-func toUpdatePayload(model *Model) (*dns.PartialUpdateRecordSetPayload, error) {
+func toUpdatePayload(model *resourceModel) (*dns.PartialUpdateRecordSetPayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
 	}
 
+	records, err := toRecordsPayload(model)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dns.PartialUpdateRecordSetPayload{
+		Comment: conversion.StringValueToPointer(model.Comment),
+		Name:    conversion.StringValueToPointer(model.Name),
+		Records: &records,
+		Ttl:     conversion.Int64ValueToPointer(model.TTL),
+	}, nil
+}
+
+// toRecordsPayload converts the records to the API payload. If an alias target is set, it is the only record.
+func toRecordsPayload(model *resourceModel) ([]dns.RecordPayload, error) {
+	if !model.AliasTarget.IsNull() {
+		return []dns.RecordPayload{
+			{Content: conversion.StringValueToPointer(model.AliasTarget)},
+		}, nil
+	}
+
 	records := []dns.RecordPayload{}
 	for i, record := range model.Records.Elements() {
 		recordString, ok := record.(types.String)
@@ -502,14 +688,19 @@ func toUpdatePayload(model *Model) (*dns.PartialUpdateRecordSetPayload, error) {
 			return nil, fmt.Errorf("expected record at index %d to be of type %T, got %T", i, types.String{}, record)
 		}
 		records = append(records, dns.RecordPayload{
-			Content: conversion.StringValueToPointer(recordString),
+			Content: toRecordContent(&model.Model, recordString),
 		})
 	}
+	return records, nil
+}
 
-	return &dns.PartialUpdateRecordSetPayload{
-		Comment: conversion.StringValueToPointer(model.Comment),
-		Name:    conversion.StringValueToPointer(model.Name),
-		Records: &records,
-		Ttl:     conversion.Int64ValueToPointer(model.TTL),
-	}, nil
+// toRecordContent converts a record to its content in the API payload.
+// Long TXT records are split into chunks the way the API stores them.
+func toRecordContent(model *Model, record types.String) *string {
+	content := conversion.StringValueToPointer(record)
+	if content == nil || model.Type.ValueString() != string(dns.RECORDSETTYPE_TXT) {
+		return content
+	}
+	chunked := dnsUtils.ChunkTXTRecord(*content)
+	return &chunked
 }
@@ -2,6 +2,7 @@ package dns
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -11,6 +12,14 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/services/dns"
 )
 
+var (
+	testDKIMKey         = "v=DKIM1; k=rsa; p=" + strings.Repeat("A", 300)
+	testDKIMKeyChunked  = `"` + testDKIMKey[:255] + `" "` + testDKIMKey[255:] + `"`
+	testDKIMKeyQuoted   = `"v=DKIM1; k=rsa; " "p=` + strings.Repeat("A", 300) + `"`
+	testShortTXTRecord  = "v=spf1 -all"
+	testQuotedTXTRecord = `"v=spf1 -all"`
+)
+
 func TestMapFields(t *testing.T) {
 	tests := []struct {
 		description string
@@ -135,6 +144,48 @@ func TestMapFields(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"txt_records",
+			Model{
+				ProjectId: types.StringValue("pid"),
+				ZoneId:    types.StringValue("zid"),
+				Records: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue(testDKIMKey),
+					types.StringValue(testShortTXTRecord),
+				}),
+			},
+			&dns.RecordSetResponse{
+				Rrset: &dns.RecordSet{
+					Id:   utils.Ptr("rid"),
+					Name: utils.Ptr("name"),
+					Records: &[]dns.Record{
+						{Content: utils.Ptr(testDKIMKeyChunked)},
+						{Content: utils.Ptr(testQuotedTXTRecord)},
+					},
+					State: dns.RECORDSETSTATE_CREATING.Ptr(),
+					Type:  dns.RECORDSETTYPE_TXT.Ptr(),
+				},
+			},
+			Model{
+				Id:          types.StringValue("pid,zid,rid"),
+				RecordSetId: types.StringValue("rid"),
+				ZoneId:      types.StringValue("zid"),
+				ProjectId:   types.StringValue("pid"),
+				Active:      types.BoolNull(),
+				Comment:     types.StringNull(),
+				Error:       types.StringNull(),
+				Name:        types.StringValue("name"),
+				FQDN:        types.StringValue("name"),
+				Records: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue(testDKIMKey),
+					types.StringValue(testShortTXTRecord),
+				}),
+				State: types.StringValue(string(dns.RECORDSETSTATE_CREATING)),
+				TTL:   types.Int64Null(),
+				Type:  types.StringValue(string(dns.RECORDSETTYPE_TXT)),
+			},
+			true,
+		},
 		{
 			"null_fields_and_int_conversions",
 			Model{
@@ -212,16 +263,151 @@ func TestMapFields(t *testing.T) {
 	}
 }
 
+func TestMapResourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       resourceModel
+		input       *dns.RecordSetResponse
+		expected    resourceModel
+		isValid     bool
+	}{
+		{
+			"records",
+			resourceModel{
+				Model: Model{
+					ProjectId: types.StringValue("pid"),
+					ZoneId:    types.StringValue("zid"),
+				},
+			},
+			&dns.RecordSetResponse{
+				Rrset: &dns.RecordSet{
+					Id:   utils.Ptr("rid"),
+					Name: utils.Ptr("name"),
+					Records: &[]dns.Record{
+						{Content: utils.Ptr("1.2.3.4")},
+					},
+					Type: dns.RECORDSETTYPE_A.Ptr(),
+				},
+			},
+			resourceModel{
+				Model: Model{
+					Id:          types.StringValue("pid,zid,rid"),
+					RecordSetId: types.StringValue("rid"),
+					ZoneId:      types.StringValue("zid"),
+					ProjectId:   types.StringValue("pid"),
+					Active:      types.BoolNull(),
+					Comment:     types.StringNull(),
+					Error:       types.StringNull(),
+					Name:        types.StringValue("name"),
+					FQDN:        types.StringValue("name"),
+					Records: types.ListValueMust(types.StringType, []attr.Value{
+						types.StringValue("1.2.3.4"),
+					}),
+					State: types.StringValue(""),
+					TTL:   types.Int64Null(),
+					Type:  types.StringValue(string(dns.RECORDSETTYPE_A)),
+				},
+				AliasTarget: types.StringNull(),
+			},
+			true,
+		},
+		{
+			"alias_target",
+			resourceModel{
+				Model: Model{
+					ProjectId: types.StringValue("pid"),
+					ZoneId:    types.StringValue("zid"),
+					Records:   types.ListNull(types.StringType),
+				},
+				AliasTarget: types.StringValue("lb.example.com."),
+			},
+			&dns.RecordSetResponse{
+				Rrset: &dns.RecordSet{
+					Id:   utils.Ptr("rid"),
+					Name: utils.Ptr("example.com."),
+					Records: &[]dns.Record{
+						{Content: utils.Ptr("lb.example.com.")},
+					},
+					Type: dns.RECORDSETTYPE_ALIAS.Ptr(),
+				},
+			},
+			resourceModel{
+				Model: Model{
+					Id:          types.StringValue("pid,zid,rid"),
+					RecordSetId: types.StringValue("rid"),
+					ZoneId:      types.StringValue("zid"),
+					ProjectId:   types.StringValue("pid"),
+					Active:      types.BoolNull(),
+					Comment:     types.StringNull(),
+					Error:       types.StringNull(),
+					Name:        types.StringValue("example.com."),
+					FQDN:        types.StringValue("example.com."),
+					Records:     types.ListNull(types.StringType),
+					State:       types.StringValue(""),
+					TTL:         types.Int64Null(),
+					Type:        types.StringValue(string(dns.RECORDSETTYPE_ALIAS)),
+				},
+				AliasTarget: types.StringValue("lb.example.com."),
+			},
+			true,
+		},
+		{
+			"alias_target_without_record",
+			resourceModel{
+				Model: Model{
+					ProjectId: types.StringValue("pid"),
+					ZoneId:    types.StringValue("zid"),
+					Records:   types.ListNull(types.StringType),
+				},
+				AliasTarget: types.StringValue("lb.example.com."),
+			},
+			&dns.RecordSetResponse{
+				Rrset: &dns.RecordSet{
+					Id:      utils.Ptr("rid"),
+					Records: &[]dns.Record{},
+					Type:    dns.RECORDSETTYPE_ALIAS.Ptr(),
+				},
+			},
+			resourceModel{},
+			false,
+		},
+		{
+			"nil_response",
+			resourceModel{},
+			nil,
+			resourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapResourceFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
 func TestToCreatePayload(t *testing.T) {
 	tests := []struct {
 		description string
-		input       *Model
+		input       *resourceModel
 		expected    *dns.CreateRecordSetPayload
 		isValid     bool
 	}{
 		{
 			"default values",
-			&Model{},
+			&resourceModel{},
 			&dns.CreateRecordSetPayload{
 				Records: &[]dns.RecordPayload{},
 			},
@@ -229,15 +415,17 @@ func TestToCreatePayload(t *testing.T) {
 		},
 		{
 			"simple_values",
-			&Model{
-				Comment: types.StringValue("comment"),
-				Name:    types.StringValue("name"),
-				Records: types.ListValueMust(types.StringType, []attr.Value{
-					types.StringValue("record_1"),
-					types.StringValue("record_2"),
-				}),
-				TTL:  types.Int64Value(1),
-				Type: types.StringValue(string(dns.RECORDSETTYPE_A)),
+			&resourceModel{
+				Model: Model{
+					Comment: types.StringValue("comment"),
+					Name:    types.StringValue("name"),
+					Records: types.ListValueMust(types.StringType, []attr.Value{
+						types.StringValue("record_1"),
+						types.StringValue("record_2"),
+					}),
+					TTL:  types.Int64Value(1),
+					Type: types.StringValue(string(dns.RECORDSETTYPE_A)),
+				},
 			},
 			&dns.CreateRecordSetPayload{
 				Comment: utils.Ptr("comment"),
@@ -253,12 +441,14 @@ func TestToCreatePayload(t *testing.T) {
 		},
 		{
 			"null_fields_and_int_conversions",
-			&Model{
-				Comment: types.StringNull(),
-				Name:    types.StringValue(""),
-				Records: types.ListValueMust(types.StringType, nil),
-				TTL:     types.Int64Value(2123456789),
-				Type:    types.StringValue(string(dns.RECORDSETTYPE_A)),
+			&resourceModel{
+				Model: Model{
+					Comment: types.StringNull(),
+					Name:    types.StringValue(""),
+					Records: types.ListValueMust(types.StringType, nil),
+					TTL:     types.Int64Value(2123456789),
+					Type:    types.StringValue(string(dns.RECORDSETTYPE_A)),
+				},
 			},
 			&dns.CreateRecordSetPayload{
 				Comment: nil,
@@ -269,6 +459,49 @@ func TestToCreatePayload(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"txt_records",
+			&resourceModel{
+				Model: Model{
+					Name: types.StringValue("name"),
+					Records: types.ListValueMust(types.StringType, []attr.Value{
+						types.StringValue(testDKIMKey),
+						types.StringValue(testDKIMKeyQuoted),
+						types.StringValue(testShortTXTRecord),
+					}),
+					Type: types.StringValue(string(dns.RECORDSETTYPE_TXT)),
+				},
+			},
+			&dns.CreateRecordSetPayload{
+				Name: utils.Ptr("name"),
+				Records: &[]dns.RecordPayload{
+					{Content: utils.Ptr(testDKIMKeyChunked)},
+					{Content: utils.Ptr(testDKIMKeyChunked)},
+					{Content: utils.Ptr(testShortTXTRecord)},
+				},
+				Type: dns.CREATERECORDSETPAYLOADTYPE_TXT.Ptr(),
+			},
+			true,
+		},
+		{
+			"alias_target",
+			&resourceModel{
+				Model: Model{
+					Name:    types.StringValue("example.com."),
+					Records: types.ListNull(types.StringType),
+					Type:    types.StringValue(string(dns.RECORDSETTYPE_ALIAS)),
+				},
+				AliasTarget: types.StringValue("lb.example.com."),
+			},
+			&dns.CreateRecordSetPayload{
+				Name: utils.Ptr("example.com."),
+				Records: &[]dns.RecordPayload{
+					{Content: utils.Ptr("lb.example.com.")},
+				},
+				Type: dns.CREATERECORDSETPAYLOADTYPE_ALIAS.Ptr(),
+			},
+			true,
+		},
 		{
 			"nil_model",
 			nil,
@@ -298,13 +531,13 @@ func TestToCreatePayload(t *testing.T) {
 func TestToUpdatePayload(t *testing.T) {
 	tests := []struct {
 		description string
-		input       *Model
+		input       *resourceModel
 		expected    *dns.PartialUpdateRecordSetPayload
 		isValid     bool
 	}{
 		{
 			"default_values",
-			&Model{},
+			&resourceModel{},
 			&dns.PartialUpdateRecordSetPayload{
 				Records: &[]dns.RecordPayload{},
 			},
@@ -312,14 +545,16 @@ func TestToUpdatePayload(t *testing.T) {
 		},
 		{
 			"simple_values",
-			&Model{
-				Comment: types.StringValue("comment"),
-				Name:    types.StringValue("name"),
-				Records: types.ListValueMust(types.StringType, []attr.Value{
-					types.StringValue("record_1"),
-					types.StringValue("record_2"),
-				}),
-				TTL: types.Int64Value(1),
+			&resourceModel{
+				Model: Model{
+					Comment: types.StringValue("comment"),
+					Name:    types.StringValue("name"),
+					Records: types.ListValueMust(types.StringType, []attr.Value{
+						types.StringValue("record_1"),
+						types.StringValue("record_2"),
+					}),
+					TTL: types.Int64Value(1),
+				},
 			},
 			&dns.PartialUpdateRecordSetPayload{
 				Comment: utils.Ptr("comment"),
@@ -334,11 +569,13 @@ func TestToUpdatePayload(t *testing.T) {
 		},
 		{
 			"null_fields_and_int_conversions",
-			&Model{
-				Comment: types.StringNull(),
-				Name:    types.StringValue(""),
-				Records: types.ListValueMust(types.StringType, nil),
-				TTL:     types.Int64Value(2123456789),
+			&resourceModel{
+				Model: Model{
+					Comment: types.StringNull(),
+					Name:    types.StringValue(""),
+					Records: types.ListValueMust(types.StringType, nil),
+					TTL:     types.Int64Value(2123456789),
+				},
 			},
 			&dns.PartialUpdateRecordSetPayload{
 				Comment: nil,
@@ -348,6 +585,23 @@ func TestToUpdatePayload(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"alias_target",
+			&resourceModel{
+				Model: Model{
+					Name:    types.StringValue("example.com."),
+					Records: types.ListNull(types.StringType),
+				},
+				AliasTarget: types.StringValue("lb.example.com."),
+			},
+			&dns.PartialUpdateRecordSetPayload{
+				Name: utils.Ptr("example.com."),
+				Records: &[]dns.RecordPayload{
+					{Content: utils.Ptr("lb.example.com.")},
+				},
+			},
+			true,
+		},
 		{
 			"nil_model",
 			nil,
@@ -373,3 +627,81 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckRecordNameInZone(t *testing.T) {
+	tests := []struct {
+		description   string
+		name          string
+		zoneDnsName   string
+		expectError   bool
+		expectWarning bool
+	}{
+		{
+			"relative_name",
+			"www",
+			"example.com",
+			false,
+			false,
+		},
+		{
+			"relative_name_with_subdomain",
+			"_acme-challenge.www",
+			"example.com",
+			false,
+			false,
+		},
+		{
+			"name_within_zone",
+			"www.example.com",
+			"example.com",
+			false,
+			false,
+		},
+		{
+			"absolute_name_within_zone",
+			"www.Example.com.",
+			"example.com.",
+			false,
+			false,
+		},
+		{
+			"zone_apex",
+			"example.com.",
+			"example.com",
+			false,
+			false,
+		},
+		{
+			"absolute_name_outside_zone",
+			"www.example.org.",
+			"example.com",
+			true,
+			false,
+		},
+		{
+			"absolute_name_with_zone_as_label",
+			"www.notexample.com.",
+			"example.com",
+			true,
+			false,
+		},
+		{
+			"relative_name_of_other_domain",
+			"www.exmaple.com",
+			"example.com",
+			false,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := checkRecordNameInZone(tt.name, tt.zoneDnsName)
+			if diags.HasError() != tt.expectError {
+				t.Fatalf("Expected error: %t, got diagnostics: %v", tt.expectError, diags)
+			}
+			if hasWarning := diags.WarningsCount() > 0; hasWarning != tt.expectWarning {
+				t.Fatalf("Expected warning: %t, got diagnostics: %v", tt.expectWarning, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package reversename
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	dnsUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dns/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &reverseZoneNameFunction{}
+	_ function.Function = &reverseRecordNameFunction{}
+)
+
+// NewReverseZoneNameFunction is a helper function to simplify the provider implementation.
+func NewReverseZoneNameFunction() function.Function {
+	return &reverseZoneNameFunction{}
+}
+
+// reverseZoneNameFunction computes the name of the reverse zone of a CIDR prefix, e.g. for the dns_name of a stackit_dns_zone.
+type reverseZoneNameFunction struct{}
+
+// Metadata returns the function name.
+func (f *reverseZoneNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "reverse_zone_name"
+}
+
+// Definition defines the parameters and return value of the function.
+func (f *reverseZoneNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Computes the name of the reverse DNS zone of a CIDR prefix.",
+		Description: "Computes the name of the reverse DNS zone of a CIDR prefix, e.g. `192.0.2.0/24` becomes `2.0.192.in-addr.arpa` and `2001:db8::/32` becomes `8.b.d.0.1.0.0.2.ip6.arpa`. The prefix length must be a multiple of 8 for IPv4 and a multiple of 4 for IPv6.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "prefix",
+				Description: "CIDR prefix of the reverse zone.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run computes the name of the reverse zone.
+func (f *reverseZoneNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var prefix string
+	resp.Error = req.Arguments.Get(ctx, &prefix)
+	if resp.Error != nil {
+		return
+	}
+
+	zoneName, err := dnsUtils.ReverseZoneName(prefix)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, zoneName)
+}
+
+// NewReverseRecordNameFunction is a helper function to simplify the provider implementation.
+func NewReverseRecordNameFunction() function.Function {
+	return &reverseRecordNameFunction{}
+}
+
+// reverseRecordNameFunction computes the name of the PTR record of an IP address, e.g. for the name of a stackit_dns_record_set.
+type reverseRecordNameFunction struct{}
+
+// Metadata returns the function name.
+func (f *reverseRecordNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "reverse_record_name"
+}
+
+// Definition defines the parameters and return value of the function.
+func (f *reverseRecordNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Computes the fully qualified name of the PTR record of an IP address.",
+		Description: "Computes the fully qualified name of the PTR record of an IP address, e.g. `192.0.2.10` becomes `10.2.0.192.in-addr.arpa.`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "ip_address",
+				Description: "IPv4 or IPv6 address of the PTR record.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run computes the name of the PTR record.
+func (f *reverseRecordNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ipAddress string
+	resp.Error = req.Arguments.Get(ctx, &ipAddress)
+	if resp.Error != nil {
+		return
+	}
+
+	recordName, err := dnsUtils.ReverseRecordName(ipAddress)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, recordName)
+}
@@ -0,0 +1,80 @@
+package reversename
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		description string
+		function    function.Function
+		argument    string
+		expected    string
+		isValid     bool
+	}{
+		{
+			"reverse_zone_name_ipv4",
+			NewReverseZoneNameFunction(),
+			"192.0.2.0/24",
+			"2.0.192.in-addr.arpa",
+			true,
+		},
+		{
+			"reverse_zone_name_ipv6",
+			NewReverseZoneNameFunction(),
+			"2001:db8::/32",
+			"8.b.d.0.1.0.0.2.ip6.arpa",
+			true,
+		},
+		{
+			"reverse_zone_name_invalid_prefix_length",
+			NewReverseZoneNameFunction(),
+			"192.0.2.0/20",
+			"",
+			false,
+		},
+		{
+			"reverse_record_name_ipv4",
+			NewReverseRecordNameFunction(),
+			"192.0.2.10",
+			"10.2.0.192.in-addr.arpa.",
+			true,
+		},
+		{
+			"reverse_record_name_invalid_ip",
+			NewReverseRecordNameFunction(),
+			"not-an-ip",
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.argument)}),
+			}
+			resp := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+			tt.function.Run(context.Background(), req, &resp)
+			if !tt.isValid && resp.Error == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && resp.Error != nil {
+				t.Fatalf("Should not have failed: %v", resp.Error)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(resp.Result.Value(), types.StringValue(tt.expected))
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
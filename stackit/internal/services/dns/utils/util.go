@@ -3,8 +3,13 @@ package utils
 import (
 	"context"
 	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/services/dns"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
@@ -19,7 +24,9 @@ func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags
 	if providerData.DnsCustomEndpoint != "" {
 		apiClientConfigOptions = append(apiClientConfigOptions, config.WithEndpoint(providerData.DnsCustomEndpoint))
 	}
-	apiClient, err := dns.NewAPIClient(apiClientConfigOptions...)
+	apiClient, err := core.CachedClient(providerData.Clients, "dns", "", providerData.DnsCustomEndpoint, func() (*dns.APIClient, error) {
+		return dns.NewAPIClient(apiClientConfigOptions...)
+	})
 	if err != nil {
 		core.LogAndAddError(ctx, diags, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the resource configuration", err))
 		return nil
@@ -27,3 +34,196 @@ func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags
 
 	return apiClient
 }
+
+const (
+	ipv4ReverseZoneSuffix = "in-addr.arpa"
+	ipv6ReverseZoneSuffix = "ip6.arpa"
+)
+
+// NormalizeName returns the canonical form of a DNS name, which is used to compare names.
+// DNS names are case-insensitive and may be written with or without the trailing dot of the root zone,
+// e.g. "WWW.Example.COM." and "www.example.com" are both normalized to "www.example.com".
+func NormalizeName(dnsName string) string {
+	return strings.ToLower(strings.TrimSuffix(dnsName, "."))
+}
+
+// ReconcileName converts a DNS name returned by the API to a types.String.
+// If the current value only differs from the API value in case or in the trailing dot, the current value is kept,
+// so names written differently than the API returns them don't cause diffs.
+func ReconcileName(current types.String, value *string) types.String {
+	if value != nil && !current.IsNull() && !current.IsUnknown() && NormalizeName(current.ValueString()) == NormalizeName(*value) {
+		return current
+	}
+	return types.StringPointerValue(value)
+}
+
+// IsReverseZoneName checks if the given DNS name belongs to one of the reverse lookup domains
+// (in-addr.arpa for IPv4, ip6.arpa for IPv6). A trailing dot is ignored.
+func IsReverseZoneName(dnsName string) bool {
+	name := NormalizeName(dnsName)
+	for _, suffix := range []string{ipv4ReverseZoneSuffix, ipv6ReverseZoneSuffix} {
+		if name == suffix || strings.HasSuffix(name, "."+suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReverseZoneName computes the name of the reverse zone for the given CIDR prefix,
+// e.g. "192.0.2.0/24" becomes "2.0.192.in-addr.arpa" and "2001:db8::/32" becomes "8.b.d.0.1.0.0.2.ip6.arpa".
+// Reverse zones can only be delegated on label boundaries, so the prefix length must be a multiple
+// of 8 for IPv4 and a multiple of 4 for IPv6.
+func ReverseZoneName(prefix string) (string, error) {
+	_, ipNet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return "", fmt.Errorf("parse prefix: %w", err)
+	}
+	ones, _ := ipNet.Mask.Size()
+
+	// net.ParseCIDR returns a 4-byte network address for IPv4 prefixes
+	if ip4 := ipNet.IP; len(ip4) == net.IPv4len {
+		if ones%8 != 0 {
+			return "", fmt.Errorf("prefix length of IPv4 prefix %q must be a multiple of 8, got %d", prefix, ones)
+		}
+		labels := make([]string, 0, ones/8+1)
+		for i := ones/8 - 1; i >= 0; i-- {
+			labels = append(labels, strconv.Itoa(int(ip4[i])))
+		}
+		return strings.Join(append(labels, ipv4ReverseZoneSuffix), "."), nil
+	}
+
+	if ones%4 != 0 {
+		return "", fmt.Errorf("prefix length of IPv6 prefix %q must be a multiple of 4, got %d", prefix, ones)
+	}
+	nibbles := ipv6Nibbles(ipNet.IP.To16())
+	labels := make([]string, 0, ones/4+1)
+	for i := ones/4 - 1; i >= 0; i-- {
+		labels = append(labels, nibbles[i])
+	}
+	return strings.Join(append(labels, ipv6ReverseZoneSuffix), "."), nil
+}
+
+// ReverseRecordName computes the fully qualified PTR record name for the given IP address,
+// e.g. "192.0.2.10" becomes "10.2.0.192.in-addr.arpa."
+func ReverseRecordName(ipAddress string) (string, error) {
+	ip := net.ParseIP(ipAddress)
+	if ip == nil {
+		return "", fmt.Errorf("%q is not a valid IP address", ipAddress)
+	}
+	prefixLength := 128
+	if ip.To4() != nil {
+		prefixLength = 32
+	}
+	zoneName, err := ReverseZoneName(fmt.Sprintf("%s/%d", ipAddress, prefixLength))
+	if err != nil {
+		return "", err
+	}
+	return zoneName + ".", nil
+}
+
+// maxTXTStringLength is the maximum length of a single character string of a TXT record (RFC 1035, section 3.3).
+const maxTXTStringLength = 255
+
+// txtStrings splits the content of a TXT record into its character strings.
+// Content in the quoted form, e.g. `"v=DKIM1; " "p=..."`, is unquoted. Any other content is a single character string.
+func txtStrings(content string) []string {
+	trimmed := strings.TrimSpace(content)
+	if !strings.HasPrefix(trimmed, `"`) {
+		return []string{content}
+	}
+
+	result := []string{}
+	var current strings.Builder
+	inQuotes, escaped := false, false
+	for _, r := range trimmed {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case inQuotes && r == '\\':
+			escaped = true
+		case r == '"':
+			if inQuotes {
+				result = append(result, current.String())
+				current.Reset()
+			}
+			inQuotes = !inQuotes
+		case inQuotes:
+			current.WriteRune(r)
+		case r != ' ' && r != '\t':
+			// Text outside of quotes, the content isn't in the quoted form
+			return []string{content}
+		}
+	}
+	if inQuotes || escaped {
+		return []string{content}
+	}
+	return result
+}
+
+// NormalizeTXTRecord returns the canonical form of the content of a TXT record, which is used to compare records.
+// The character strings of the record are concatenated, so `"v=DKIM1; " "p=abc"` and `v=DKIM1; p=abc` are equal.
+func NormalizeTXTRecord(content string) string {
+	return strings.Join(txtStrings(content), "")
+}
+
+// ChunkTXTRecord converts the content of a TXT record to the form the API stores it in.
+// Content with character strings longer than 255 characters, e.g. DKIM keys, is split into quoted chunks of at most 255 characters.
+// Any other content is returned unchanged.
+func ChunkTXTRecord(content string) string {
+	chunksNeeded := false
+	for _, s := range txtStrings(content) {
+		if len(s) > maxTXTStringLength {
+			chunksNeeded = true
+			break
+		}
+	}
+	if !chunksNeeded {
+		return content
+	}
+
+	value := NormalizeTXTRecord(content)
+	chunks := []string{}
+	for len(value) > 0 {
+		end := min(maxTXTStringLength, len(value))
+		// The limit is in bytes, so the chunk must not end in the middle of a multi-byte character
+		for end < len(value) && end > 0 && !utf8.RuneStart(value[end]) {
+			end--
+		}
+		if end == 0 {
+			end = min(maxTXTStringLength, len(value))
+		}
+		chunk := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value[:end])
+		chunks = append(chunks, `"`+chunk+`"`)
+		value = value[end:]
+	}
+	return strings.Join(chunks, " ")
+}
+
+// ReconcileTXTRecords replaces the TXT records returned by the API with the current records they are equal to,
+// so records written differently than the API returns them, e.g. unquoted or split differently, don't cause diffs.
+func ReconcileTXTRecords(current, records []string) []string {
+	currentByValue := map[string]string{}
+	for _, record := range current {
+		currentByValue[NormalizeTXTRecord(record)] = record
+	}
+
+	result := make([]string, 0, len(records))
+	for _, record := range records {
+		if currentRecord, ok := currentByValue[NormalizeTXTRecord(record)]; ok {
+			record = currentRecord
+		}
+		result = append(result, record)
+	}
+	return result
+}
+
+// ipv6Nibbles returns the hexadecimal nibbles of an IPv6 address, most significant first
+func ipv6Nibbles(ip net.IP) []string {
+	const hexDigits = "0123456789abcdef"
+	nibbles := make([]string, 0, 2*len(ip))
+	for _, b := range ip {
+		nibbles = append(nibbles, string(hexDigits[b>>4]), string(hexDigits[b&0x0f]))
+	}
+	return nibbles
+}
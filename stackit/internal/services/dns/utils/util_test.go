@@ -4,9 +4,11 @@ import (
 	"context"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdkClients "github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/services/dns"
@@ -91,3 +93,198 @@ func TestConfigureClient(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		dnsName  string
+		expected string
+	}{
+		{"already normalized", "www.example.com", "www.example.com"},
+		{"trailing dot", "www.example.com.", "www.example.com"},
+		{"upper case", "WWW.Example.COM.", "www.example.com"},
+		{"relative name", "WWW", "www"},
+		{"root zone", ".", ""},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := NormalizeName(tt.dnsName); actual != tt.expected {
+				t.Errorf("NormalizeName(%q) = %q, want %q", tt.dnsName, actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestReconcileName(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  types.String
+		value    *string
+		expected types.String
+	}{
+		{"equal", types.StringValue("example.com"), dns.PtrString("example.com"), types.StringValue("example.com")},
+		{"different case and trailing dot", types.StringValue("Example.COM."), dns.PtrString("example.com"), types.StringValue("Example.COM.")},
+		{"different name", types.StringValue("example.com"), dns.PtrString("example.org"), types.StringValue("example.org")},
+		{"current null", types.StringNull(), dns.PtrString("example.com"), types.StringValue("example.com")},
+		{"current unknown", types.StringUnknown(), dns.PtrString("example.com"), types.StringValue("example.com")},
+		{"value nil", types.StringValue("example.com"), nil, types.StringNull()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := ReconcileName(tt.current, tt.value); !actual.Equal(tt.expected) {
+				t.Errorf("ReconcileName(%v, %v) = %v, want %v", tt.current, tt.value, actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsReverseZoneName(t *testing.T) {
+	tests := []struct {
+		name     string
+		dnsName  string
+		expected bool
+	}{
+		{"ipv4 reverse zone", "2.0.192.in-addr.arpa", true},
+		{"ipv6 reverse zone", "8.b.d.0.1.0.0.2.ip6.arpa", true},
+		{"trailing dot", "2.0.192.in-addr.arpa.", true},
+		{"upper case", "2.0.192.IN-ADDR.ARPA", true},
+		{"forward zone", "example.com", false},
+		{"suffix without label separator", "example-in-addr.arpa", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := IsReverseZoneName(tt.dnsName); actual != tt.expected {
+				t.Errorf("IsReverseZoneName(%q) = %v, want %v", tt.dnsName, actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestReverseZoneName(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   string
+		expected string
+		wantErr  bool
+	}{
+		{"ipv4 /24", "192.0.2.0/24", "2.0.192.in-addr.arpa", false},
+		{"ipv4 /16", "10.20.0.0/16", "20.10.in-addr.arpa", false},
+		{"ipv4 /8 with host bits", "10.20.30.40/8", "10.in-addr.arpa", false},
+		{"ipv4 /32", "192.0.2.10/32", "10.2.0.192.in-addr.arpa", false},
+		{"ipv4 not on octet boundary", "192.0.2.0/25", "", true},
+		{"ipv6 /32", "2001:db8::/32", "8.b.d.0.1.0.0.2.ip6.arpa", false},
+		{"ipv6 /48", "2001:db8:abcd::/48", "d.c.b.a.8.b.d.0.1.0.0.2.ip6.arpa", false},
+		{"ipv6 not on nibble boundary", "2001:db8::/33", "", true},
+		{"no prefix", "192.0.2.0", "", true},
+		{"invalid", "not-a-prefix", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ReverseZoneName(tt.prefix)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReverseZoneName(%q) error = %v, wantErr %v", tt.prefix, err, tt.wantErr)
+			}
+			if actual != tt.expected {
+				t.Errorf("ReverseZoneName(%q) = %q, want %q", tt.prefix, actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestReverseRecordName(t *testing.T) {
+	tests := []struct {
+		name      string
+		ipAddress string
+		expected  string
+		wantErr   bool
+	}{
+		{"ipv4", "192.0.2.10", "10.2.0.192.in-addr.arpa.", false},
+		{"ipv6", "2001:db8::1", "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.", false},
+		{"invalid", "not-an-ip", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ReverseRecordName(tt.ipAddress)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReverseRecordName(%q) error = %v, wantErr %v", tt.ipAddress, err, tt.wantErr)
+			}
+			if actual != tt.expected {
+				t.Errorf("ReverseRecordName(%q) = %q, want %q", tt.ipAddress, actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNormalizeTXTRecord(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"unquoted", "v=spf1 -all", "v=spf1 -all"},
+		{"quoted", `"v=spf1 -all"`, "v=spf1 -all"},
+		{"multiple strings", `"v=DKIM1; " "p=abc"`, "v=DKIM1; p=abc"},
+		{"escaped quote", `"say \"hello\""`, `say "hello"`},
+		{"text outside of quotes", `"a" b`, `"a" b`},
+		{"unbalanced quotes", `"abc`, `"abc`},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := NormalizeTXTRecord(tt.content); actual != tt.expected {
+				t.Errorf("NormalizeTXTRecord(%q) = %q, want %q", tt.content, actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestChunkTXTRecord(t *testing.T) {
+	longValue := strings.Repeat("a", 300)
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"short", "v=spf1 -all", "v=spf1 -all"},
+		{"short quoted", `"v=spf1 -all"`, `"v=spf1 -all"`},
+		{"exactly 255 characters", strings.Repeat("a", 255), strings.Repeat("a", 255)},
+		{"long", longValue, `"` + strings.Repeat("a", 255) + `" "` + strings.Repeat("a", 45) + `"`},
+		{"long quoted", `"` + longValue + `"`, `"` + strings.Repeat("a", 255) + `" "` + strings.Repeat("a", 45) + `"`},
+		{"already chunked", `"` + strings.Repeat("a", 255) + `" "b"`, `"` + strings.Repeat("a", 255) + `" "b"`},
+		{"escaped characters", strings.Repeat("a", 255) + `"\`, `"` + strings.Repeat("a", 255) + `" "\"\\"`},
+		{"multi-byte characters", strings.Repeat("a", 254) + "ää", `"` + strings.Repeat("a", 254) + `" "ää"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := ChunkTXTRecord(tt.content); actual != tt.expected {
+				t.Errorf("ChunkTXTRecord(%q) = %q, want %q", tt.content, actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestReconcileTXTRecords(t *testing.T) {
+	longValue := strings.Repeat("a", 300)
+	chunkedValue := ChunkTXTRecord(longValue)
+	tests := []struct {
+		name     string
+		current  []string
+		records  []string
+		expected []string
+	}{
+		{"equal", []string{"v=spf1 -all"}, []string{"v=spf1 -all"}, []string{"v=spf1 -all"}},
+		{"chunked", []string{longValue}, []string{chunkedValue}, []string{longValue}},
+		{"quoted", []string{"v=spf1 -all"}, []string{`"v=spf1 -all"`}, []string{"v=spf1 -all"}},
+		{"different", []string{"v=spf1 -all"}, []string{"v=spf1 ~all"}, []string{"v=spf1 ~all"}},
+		{"no current records", nil, []string{chunkedValue}, []string{chunkedValue}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := ReconcileTXTRecords(tt.current, tt.records); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("ReconcileTXTRecords(%v, %v) = %v, want %v", tt.current, tt.records, actual, tt.expected)
+			}
+		})
+	}
+}
@@ -139,6 +139,11 @@ func (d *zoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"primary_status": schema.StringAttribute{
+				Description: "Status of the zone transfer from the primaries of a secondary zone. `OK`, or the error reported by the API. " +
+					"The API reports a single status for the zone, not one per primary. Not set for primary zones.",
+				Computed: true,
+			},
 			"record_count": schema.Int64Attribute{
 				Description: "Record count how many records are in the zone.",
 				Computed:    true,
@@ -167,6 +172,7 @@ func (d *zoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Description: "Zone state.",
 				Computed:    true,
 			},
+			"auth_profile": utils.AuthProfileDataSourceAttribute(),
 		},
 	}
 }
@@ -181,6 +187,7 @@ func (d *zoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 
 	ctx = core.InitProviderContext(ctx)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
 
 	projectId := model.ProjectId.ValueString()
 	zoneId := model.ZoneId.ValueString()
@@ -212,7 +219,7 @@ func (d *zoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		ctx = core.LogResponse(ctx)
 	} else {
 		listZoneResp, err := d.client.ListZones(ctx, projectId).
-			DnsNameEq(dnsName).
+			DnsNameEq(dnsUtils.NormalizeName(dnsName)).
 			ActiveEq(true).
 			Execute()
 		if err != nil {
@@ -6,11 +6,13 @@ import (
 	"math"
 	"strings"
 
+	"github.com/google/uuid"
 	dnsUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dns/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -31,11 +33,18 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &zoneResource{}
-	_ resource.ResourceWithConfigure   = &zoneResource{}
-	_ resource.ResourceWithImportState = &zoneResource{}
+	_ resource.Resource                   = &zoneResource{}
+	_ resource.ResourceWithConfigure      = &zoneResource{}
+	_ resource.ResourceWithImportState    = &zoneResource{}
+	_ resource.ResourceWithIdentity       = &zoneResource{}
+	_ resource.ResourceWithValidateConfig = &zoneResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "zone_id"}
+
+// primaryStatusOK is the primary status of a secondary zone without errors
+const primaryStatusOK = "OK"
+
 type Model struct {
 	Id                types.String `tfsdk:"id"` // needed by TF
 	ZoneId            types.String `tfsdk:"zone_id"`
@@ -52,6 +61,7 @@ type Model struct {
 	NegativeCache     types.Int64  `tfsdk:"negative_cache"`
 	PrimaryNameServer types.String `tfsdk:"primary_name_server"`
 	Primaries         types.List   `tfsdk:"primaries"`
+	PrimaryStatus     types.String `tfsdk:"primary_status"`
 	RecordCount       types.Int64  `tfsdk:"record_count"`
 	RefreshTime       types.Int64  `tfsdk:"refresh_time"`
 	RetryTime         types.Int64  `tfsdk:"retry_time"`
@@ -59,6 +69,13 @@ type Model struct {
 	Type              types.String `tfsdk:"type"`
 	Visibility        types.String `tfsdk:"visibility"`
 	State             types.String `tfsdk:"state"`
+	AuthProfile       types.String `tfsdk:"auth_profile"`
+}
+
+// resourceModel extends the model shared with the data source by the attributes which only apply to the resource
+type resourceModel struct {
+	Model
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
 }
 
 // NewZoneResource is a helper function to simplify the provider implementation.
@@ -68,7 +85,8 @@ func NewZoneResource() resource.Resource {
 
 // zoneResource is the resource implementation.
 type zoneResource struct {
-	client *dns.APIClient
+	client       *dns.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -76,6 +94,11 @@ func (r *zoneResource) Metadata(_ context.Context, req resource.MetadataRequest,
 	resp.TypeName = req.ProviderTypeName + "_dns_zone"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *zoneResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *zoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
@@ -88,9 +111,48 @@ func (r *zoneResource) Configure(ctx context.Context, req resource.ConfigureRequ
 		return
 	}
 	r.client = apiClient
+	r.providerData = providerData
 	tflog.Info(ctx, "DNS zone client configured")
 }
 
+// ValidateConfig checks that secondary zones have primaries and that reverse zones use a reverse lookup domain as their DNS name.
+func (r *zoneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model resourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.Type.ValueString() == string(dns.ZONETYPE_SECONDARY) && !model.Primaries.IsUnknown() && len(model.Primaries.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("primaries"),
+			"Invalid secondary zone configuration",
+			"A secondary zone transfers its records from its primaries, so `primaries` must contain at least one primary name server.",
+		)
+	}
+
+	if utils.IsUndefined(model.DnsName) || model.IsReverseZone.IsUnknown() {
+		return
+	}
+
+	isReverseZoneName := dnsUtils.IsReverseZoneName(model.DnsName.ValueString())
+	if model.IsReverseZone.ValueBool() && !isReverseZoneName {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dns_name"),
+			"Invalid reverse zone configuration",
+			fmt.Sprintf("The DNS name of a reverse zone must end with `in-addr.arpa` or `ip6.arpa`, got %q.", model.DnsName.ValueString()),
+		)
+		return
+	}
+	if !model.IsReverseZone.ValueBool() && isReverseZoneName {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("is_reverse_zone"),
+			"Reverse lookup domain used for forward zone",
+			fmt.Sprintf("The DNS name %q belongs to a reverse lookup domain. Set `is_reverse_zone` to `true` to create a reverse zone for PTR records.", model.DnsName.ValueString()),
+		)
+	}
+}
+
 // Schema defines the schema for the resource.
 func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	primaryOptions := []string{"primary", "secondary"}
@@ -192,7 +254,7 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"is_reverse_zone": schema.BoolAttribute{
-				Description: "Specifies, if the zone is a reverse zone or not. Defaults to `false`",
+				Description: "Specifies, if the zone is a reverse zone or not. Reverse zones must have a `dns_name` ending with `in-addr.arpa` or `ip6.arpa`. Defaults to `false`",
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
@@ -206,7 +268,7 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"primaries": schema.ListAttribute{
-				Description: `Primary name server for secondary zone. E.g. ["1.2.3.4"]`,
+				Description: `Primary name server for secondary zone. Required for secondary zones. E.g. ["1.2.3.4"]`,
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
@@ -243,6 +305,11 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringvalidator.OneOf(primaryOptions...),
 				},
 			},
+			"primary_status": schema.StringAttribute{
+				Description: "Status of the zone transfer from the primaries of a secondary zone. `OK`, or the error reported by the API. " +
+					"The API reports a single status for the zone, not one per primary. Not set for primary zones.",
+				Computed: true,
+			},
 			"primary_name_server": schema.StringAttribute{
 				Description: "Primary name server. FQDN.",
 				Computed:    true,
@@ -271,26 +338,31 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "Zone state. E.g. `CREATE_SUCCEEDED`.",
 				Computed:    true,
 			},
+			"deletion_protection": utils.DeletionProtectionAttribute("DNS zone"),
+			"auth_profile":        utils.AuthProfileAttribute(),
 		},
 	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
-	var model Model
+	var model resourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	ctx = core.InitProviderContext(ctx)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
 
 	projectId := model.ProjectId.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 
 	// Generate API request body from model
-	payload, err := toCreatePayload(&model)
+	payload, err := toCreatePayload(&model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -314,14 +386,14 @@ func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	waitResp, err := wait.CreateZoneWaitHandler(ctx, r.client, projectId, zoneId).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandler(wait.CreateZoneWaitHandler(ctx, r.client, projectId, zoneId), r.providerData.Wait, core.WaitCreate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Zone creation waiting: %v", err))
 		return
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, waitResp, &model)
+	err = mapFields(ctx, waitResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -336,7 +408,9 @@ func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 // Read refreshes the Terraform state with the latest data.
 func (r *zoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var model resourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -344,6 +418,7 @@ func (r *zoneResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	ctx = core.InitProviderContext(ctx)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
 
 	projectId := model.ProjectId.ValueString()
 	zoneId := model.ZoneId.ValueString()
@@ -359,16 +434,20 @@ func (r *zoneResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	ctx = core.LogResponse(ctx)
 
 	if zoneResp != nil && zoneResp.Zone.State != nil && *zoneResp.Zone.State == dns.ZONESTATE_DELETE_SUCCEEDED {
-		resp.State.RemoveResource(ctx)
+		core.RemoveFromStateWithWarning(ctx, resp, "stackit_dns_zone", model.Id.ValueString())
 		return
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, zoneResp, &model)
+	err = mapFields(ctx, zoneResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading zone", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	if !model.PrimaryStatus.IsNull() && model.PrimaryStatus.ValueString() != primaryStatusOK {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, "Zone transfer from primaries failed",
+			fmt.Sprintf("The secondary zone %q can't be transferred from its primaries: %s", model.DnsName.ValueString(), model.PrimaryStatus.ValueString()))
+	}
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -380,8 +459,10 @@ func (r *zoneResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	// Retrieve values from plan
-	var model Model
+	var model resourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -389,6 +470,7 @@ func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	ctx = core.InitProviderContext(ctx)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
 
 	projectId := model.ProjectId.ValueString()
 	zoneId := model.ZoneId.ValueString()
@@ -396,7 +478,7 @@ func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	ctx = tflog.SetField(ctx, "zone_id", zoneId)
 
 	// Generate API request body from model
-	payload, err := toUpdatePayload(&model)
+	payload, err := toUpdatePayload(&model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -410,13 +492,13 @@ func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	ctx = core.LogResponse(ctx)
 
-	waitResp, err := wait.PartialUpdateZoneWaitHandler(ctx, r.client, projectId, zoneId).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandler(wait.PartialUpdateZoneWaitHandler(ctx, r.client, projectId, zoneId), r.providerData.Wait, core.WaitUpdate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone", fmt.Sprintf("Zone update waiting: %v", err))
 		return
 	}
 
-	err = mapFields(ctx, waitResp, &model)
+	err = mapFields(ctx, waitResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -432,7 +514,7 @@ func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *zoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from state
-	var model Model
+	var model resourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -440,12 +522,17 @@ func (r *zoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 
 	ctx = core.InitProviderContext(ctx)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
 
 	projectId := model.ProjectId.ValueString()
 	zoneId := model.ZoneId.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "zone_id", zoneId)
 
+	if utils.BlockDeletionIfProtected(ctx, req.State, "DNS zone", &resp.Diagnostics) {
+		return
+	}
+
 	// Delete existing zone
 	_, err := r.client.DeleteZone(ctx, projectId, zoneId).Execute()
 	if err != nil {
@@ -455,7 +542,7 @@ func (r *zoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.DeleteZoneWaitHandler(ctx, r.client, projectId, zoneId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.DeleteZoneWaitHandler(ctx, r.client, projectId, zoneId), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting zone", fmt.Sprintf("Zone deletion waiting: %v", err))
 		return
@@ -465,21 +552,51 @@ func (r *zoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 // ImportState imports a resource into the Terraform state on success.
-// The expected format of the resource import identifier is: project_id,zone_id
+// The expected format of the resource import identifier is: project_id,zone_id or project_id,dns_name
 func (r *zoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing zone",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[zone_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[zone_id] or [project_id],[dns_name]  Got: %q", importId),
 		)
 		return
 	}
 
+	projectId := idParts[0]
+	zoneId := idParts[1]
+
+	// Zone IDs are UUIDs, everything else is treated as DNS name, whose zone ID must be looked up
+	if _, err := uuid.Parse(zoneId); err != nil {
+		dnsName := zoneId
+		ctx = tflog.SetField(ctx, "project_id", projectId)
+		ctx = tflog.SetField(ctx, "dns_name", dnsName)
+
+		ctx = core.InitProviderContext(ctx)
+
+		listZoneResp, err := r.client.ListZones(ctx, projectId).
+			DnsNameEq(dnsUtils.NormalizeName(dnsName)).
+			ActiveEq(true).
+			Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing zone", fmt.Sprintf("Listing zones: %v", err))
+			return
+		}
+
+		ctx = core.LogResponse(ctx)
+
+		zoneId, err = getZoneIdByDnsName(listZoneResp, dnsName)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing zone", err.Error())
+			return
+		}
+	}
+
 	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]interface{}{
-		"project_id": idParts[0],
-		"zone_id":    idParts[1],
+		"project_id": projectId,
+		"zone_id":    zoneId,
 	})
 
 	tflog.Info(ctx, "DNS zone state imported")
@@ -532,12 +649,12 @@ func mapFields(ctx context.Context, zoneResp *dns.ZoneResponse, model *Model) er
 		model.Primaries = primariesTF
 	}
 	model.ZoneId = types.StringValue(zoneId)
-	model.Description = types.StringPointerValue(z.Description)
+	model.Description = conversion.NormalizedStringPointerValue(model.Description, z.Description)
 	model.Acl = types.StringPointerValue(z.Acl)
 	model.Active = types.BoolPointerValue(z.Active)
 	model.ContactEmail = types.StringPointerValue(z.ContactEmail)
 	model.DefaultTTL = types.Int64PointerValue(z.DefaultTTL)
-	model.DnsName = types.StringPointerValue(z.DnsName)
+	model.DnsName = dnsUtils.ReconcileName(model.DnsName, z.DnsName)
 	model.ExpireTime = types.Int64PointerValue(z.ExpireTime)
 	model.IsReverseZone = types.BoolPointerValue(z.IsReverseZone)
 	model.Name = types.StringPointerValue(z.Name)
@@ -547,12 +664,52 @@ func mapFields(ctx context.Context, zoneResp *dns.ZoneResponse, model *Model) er
 	model.RefreshTime = types.Int64PointerValue(z.RefreshTime)
 	model.RetryTime = types.Int64PointerValue(z.RetryTime)
 	model.SerialNumber = types.Int64PointerValue(z.SerialNumber)
+	model.PrimaryStatus = mapPrimaryStatus(z)
 	model.State = types.StringValue(string(z.GetState()))
 	model.Type = types.StringValue(string(z.GetType()))
 	model.Visibility = types.StringValue(string(z.GetVisibility()))
 	return nil
 }
 
+// mapPrimaryStatus returns the status of the zone transfer of a secondary zone, i.e. the error of the zone or primaryStatusOK.
+// The status is null for primary zones, which don't transfer records from primaries.
+func mapPrimaryStatus(z *dns.Zone) types.String {
+	if z.GetType() != dns.ZONETYPE_SECONDARY {
+		return types.StringNull()
+	}
+	if zoneError := z.GetError(); zoneError != "" {
+		return types.StringValue(zoneError)
+	}
+	if state := z.GetState(); state == dns.ZONESTATE_CREATE_FAILED || state == dns.ZONESTATE_UPDATE_FAILED {
+		return types.StringValue(string(state))
+	}
+	return types.StringValue(primaryStatusOK)
+}
+
+// getZoneIdByDnsName returns the ID of the only zone in the list response, which has the given DNS name.
+func getZoneIdByDnsName(listZoneResp *dns.ListZonesResponse, dnsName string) (string, error) {
+	if listZoneResp == nil || listZoneResp.Zones == nil {
+		return "", fmt.Errorf("zone with DNS name %q not found", dnsName)
+	}
+
+	var zoneIds []string
+	for _, zone := range *listZoneResp.Zones {
+		if zone.Id == nil || zone.DnsName == nil || dnsUtils.NormalizeName(*zone.DnsName) != dnsUtils.NormalizeName(dnsName) {
+			continue
+		}
+		zoneIds = append(zoneIds, *zone.Id)
+	}
+
+	switch len(zoneIds) {
+	case 0:
+		return "", fmt.Errorf("zone with DNS name %q not found", dnsName)
+	case 1:
+		return zoneIds[0], nil
+	default:
+		return "", fmt.Errorf("found %d zones with DNS name %q, import the zone by its ID instead: %s", len(zoneIds), dnsName, strings.Join(zoneIds, ", "))
+	}
+}
+
 func toCreatePayload(model *Model) (*dns.CreateZonePayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
@@ -51,6 +51,75 @@ func TestMapFields(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"empty_description",
+			Model{
+				ProjectId:   types.StringValue("pid"),
+				ZoneId:      types.StringValue("zid"),
+				Description: types.StringNull(),
+			},
+			&dns.ZoneResponse{
+				Zone: &dns.Zone{
+					Id:          utils.Ptr("zid"),
+					Description: utils.Ptr(""),
+				},
+			},
+			Model{
+				Id:                types.StringValue("pid,zid"),
+				ProjectId:         types.StringValue("pid"),
+				ZoneId:            types.StringValue("zid"),
+				Name:              types.StringNull(),
+				DnsName:           types.StringNull(),
+				Acl:               types.StringNull(),
+				DefaultTTL:        types.Int64Null(),
+				ExpireTime:        types.Int64Null(),
+				RefreshTime:       types.Int64Null(),
+				RetryTime:         types.Int64Null(),
+				SerialNumber:      types.Int64Null(),
+				NegativeCache:     types.Int64Null(),
+				Type:              types.StringValue(""),
+				State:             types.StringValue(""),
+				PrimaryNameServer: types.StringNull(),
+				Primaries:         types.ListNull(types.StringType),
+				Visibility:        types.StringValue(""),
+				Description:       types.StringNull(),
+			},
+			true,
+		},
+		{
+			"dns_name_different_case_and_trailing_dot",
+			Model{
+				ProjectId: types.StringValue("pid"),
+				ZoneId:    types.StringValue("zid"),
+				DnsName:   types.StringValue("Example.COM."),
+			},
+			&dns.ZoneResponse{
+				Zone: &dns.Zone{
+					Id:      utils.Ptr("zid"),
+					DnsName: utils.Ptr("example.com"),
+				},
+			},
+			Model{
+				Id:                types.StringValue("pid,zid"),
+				ProjectId:         types.StringValue("pid"),
+				ZoneId:            types.StringValue("zid"),
+				Name:              types.StringNull(),
+				DnsName:           types.StringValue("Example.COM."),
+				Acl:               types.StringNull(),
+				DefaultTTL:        types.Int64Null(),
+				ExpireTime:        types.Int64Null(),
+				RefreshTime:       types.Int64Null(),
+				RetryTime:         types.Int64Null(),
+				SerialNumber:      types.Int64Null(),
+				NegativeCache:     types.Int64Null(),
+				Type:              types.StringValue(""),
+				State:             types.StringValue(""),
+				PrimaryNameServer: types.StringNull(),
+				Primaries:         types.ListNull(types.StringType),
+				Visibility:        types.StringValue(""),
+			},
+			true,
+		},
 		{
 			"values_ok",
 			Model{
@@ -283,6 +352,57 @@ func TestMapFields(t *testing.T) {
 	}
 }
 
+func TestMapPrimaryStatus(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *dns.Zone
+		expected    types.String
+	}{
+		{
+			"primary_zone",
+			&dns.Zone{
+				Type:  dns.ZONETYPE_PRIMARY.Ptr(),
+				Error: utils.Ptr("error"),
+			},
+			types.StringNull(),
+		},
+		{
+			"secondary_zone_ok",
+			&dns.Zone{
+				Type:  dns.ZONETYPE_SECONDARY.Ptr(),
+				State: dns.ZONESTATE_CREATE_SUCCEEDED.Ptr(),
+			},
+			types.StringValue(primaryStatusOK),
+		},
+		{
+			"secondary_zone_error",
+			&dns.Zone{
+				Type:  dns.ZONETYPE_SECONDARY.Ptr(),
+				State: dns.ZONESTATE_UPDATE_FAILED.Ptr(),
+				Error: utils.Ptr("zone transfer from 1.2.3.4 failed"),
+			},
+			types.StringValue("zone transfer from 1.2.3.4 failed"),
+		},
+		{
+			"secondary_zone_failed_without_error",
+			&dns.Zone{
+				Type:  dns.ZONETYPE_SECONDARY.Ptr(),
+				State: dns.ZONESTATE_CREATE_FAILED.Ptr(),
+			},
+			types.StringValue(string(dns.ZONESTATE_CREATE_FAILED)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := mapPrimaryStatus(tt.input)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
 func TestToCreatePayload(t *testing.T) {
 	tests := []struct {
 		description string
@@ -435,3 +555,90 @@ func TestToPayloadUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestGetZoneIdByDnsName(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *dns.ListZonesResponse
+		dnsName     string
+		expected    string
+		isValid     bool
+	}{
+		{
+			"single_zone",
+			&dns.ListZonesResponse{
+				Zones: &[]dns.Zone{
+					{Id: utils.Ptr("zid"), DnsName: utils.Ptr("example.com")},
+				},
+			},
+			"example.com",
+			"zid",
+			true,
+		},
+		{
+			"different_case_and_trailing_dot",
+			&dns.ListZonesResponse{
+				Zones: &[]dns.Zone{
+					{Id: utils.Ptr("zid"), DnsName: utils.Ptr("example.com")},
+				},
+			},
+			"Example.COM.",
+			"zid",
+			true,
+		},
+		{
+			"other_zones_ignored",
+			&dns.ListZonesResponse{
+				Zones: &[]dns.Zone{
+					{Id: utils.Ptr("zid-1"), DnsName: utils.Ptr("sub.example.com")},
+					{Id: utils.Ptr("zid-2"), DnsName: utils.Ptr("example.com")},
+				},
+			},
+			"example.com",
+			"zid-2",
+			true,
+		},
+		{
+			"not_found",
+			&dns.ListZonesResponse{
+				Zones: &[]dns.Zone{},
+			},
+			"example.com",
+			"",
+			false,
+		},
+		{
+			"ambiguous",
+			&dns.ListZonesResponse{
+				Zones: &[]dns.Zone{
+					{Id: utils.Ptr("zid-1"), DnsName: utils.Ptr("example.com")},
+					{Id: utils.Ptr("zid-2"), DnsName: utils.Ptr("example.com")},
+				},
+			},
+			"example.com",
+			"",
+			false,
+		},
+		{
+			"nil_response",
+			nil,
+			"example.com",
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := getZoneIdByDnsName(tt.input, tt.dnsName)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if output != tt.expected {
+				t.Fatalf("Expected zone ID %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	dnsUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dns/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &zoneTransferDataSource{}
+)
+
+// Model is the data source model for the zone transfer information of a DNS zone.
+type Model struct {
+	Id                types.String `tfsdk:"id"` // needed by TF
+	ProjectId         types.String `tfsdk:"project_id"`
+	ZoneId            types.String `tfsdk:"zone_id"`
+	DnsName           types.String `tfsdk:"dns_name"`
+	Type              types.String `tfsdk:"type"`
+	PrimaryNameServer types.String `tfsdk:"primary_name_server"`
+	NameServers       types.List   `tfsdk:"name_servers"`
+	TransferAcl       types.List   `tfsdk:"transfer_acl"`
+	SerialNumber      types.Int64  `tfsdk:"serial_number"`
+}
+
+// NewZoneTransferDataSource is a helper function to simplify the provider implementation.
+func NewZoneTransferDataSource() datasource.DataSource {
+	return &zoneTransferDataSource{}
+}
+
+// zoneTransferDataSource is the data source implementation.
+type zoneTransferDataSource struct {
+	client *dns.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *zoneTransferDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_transfer"
+}
+
+func (d *zoneTransferDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &providerData, &resp.Diagnostics, "stackit_dns_zone_transfer", core.Datasource)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := dnsUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "DNS zone transfer client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *zoneTransferDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "DNS zone transfer data source schema. Exposes the information a secondary DNS provider needs to pull a STACKIT DNS zone via AXFR, e.g. for dual-provider DNS setups. " +
+		"Zone transfers are authorized by the zone's access control list only, TSIG keys are not supported by the STACKIT DNS API."
+	resp.Schema = schema.Schema{
+		Description:         description,
+		MarkdownDescription: features.AddBetaDescription(description, core.Datasource),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source. ID. It is structured as \"`project_id`,`zone_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the dns zone is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"zone_id": schema.StringAttribute{
+				Description: "The zone ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"dns_name": schema.StringAttribute{
+				Description: "The zone name. E.g. `example.com`",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Zone type.",
+				Computed:    true,
+			},
+			"primary_name_server": schema.StringAttribute{
+				Description: "Primary name server of the zone. FQDN. Secondary name servers pull the zone from this server via AXFR.",
+				Computed:    true,
+			},
+			"name_servers": schema.ListAttribute{
+				Description: "Name servers of the NS record set at the zone apex, sorted alphabetically.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"transfer_acl": schema.ListAttribute{
+				Description: "Networks which are allowed to transfer the zone, taken from the zone's access control list. The secondary name servers must be part of one of these networks.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"serial_number": schema.Int64Attribute{
+				Description: "Serial number of the zone. Secondary name servers use it to detect zone changes.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *zoneTransferDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	zoneId := model.ZoneId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "zone_id", zoneId)
+
+	zoneResp, err := d.client.GetZone(ctx, projectId, zoneId).Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading zone",
+			fmt.Sprintf("Zone with ID %q does not exist in project %q.", zoneId, projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	if zoneResp == nil || zoneResp.Zone == nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading zone transfer", "Empty zone in API response")
+		return
+	}
+	if zoneResp.Zone.State != nil && *zoneResp.Zone.State == dns.ZONESTATE_DELETE_SUCCEEDED {
+		resp.State.RemoveResource(ctx)
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading zone transfer", "Zone was deleted successfully")
+		return
+	}
+
+	recordSets, err := utils.ListAllNumberedPages(ctx, func(ctx context.Context, page int32) ([]dns.RecordSet, int64, error) {
+		listResp, err := d.client.ListRecordSets(ctx, projectId, zoneId).
+			TypeEq(string(dns.RECORDSETTYPE_NS)).
+			ActiveEq(true).
+			Page(page).
+			Execute()
+		if err != nil {
+			return nil, 0, err
+		}
+		return listResp.GetRrSets(), listResp.GetTotalPages(), nil
+	})
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading zone transfer", fmt.Sprintf("Listing NS record sets: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapFields(ctx, zoneResp.Zone, recordSets, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading zone transfer", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "DNS zone transfer read")
+}
+
+func mapFields(ctx context.Context, zone *dns.Zone, recordSets []dns.RecordSet, model *Model) error {
+	if zone == nil {
+		return fmt.Errorf("zone input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	var zoneId string
+	if model.ZoneId.ValueString() != "" {
+		zoneId = model.ZoneId.ValueString()
+	} else if zone.Id != nil {
+		zoneId = *zone.Id
+	} else {
+		return fmt.Errorf("zone id not present")
+	}
+
+	// Only the NS record set at the zone apex holds the authoritative name servers of the zone
+	var apex string
+	if zone.DnsName != nil {
+		apex = dnsUtils.NormalizeName(*zone.DnsName)
+	}
+	nameServers := []string{}
+	for _, recordSet := range recordSets {
+		if recordSet.Type == nil || *recordSet.Type != dns.RECORDSETTYPE_NS || recordSet.Name == nil {
+			continue
+		}
+		if dnsUtils.NormalizeName(*recordSet.Name) != apex || recordSet.Records == nil {
+			continue
+		}
+		for _, record := range *recordSet.Records {
+			if record.Content == nil || *record.Content == "" {
+				continue
+			}
+			nameServers = append(nameServers, *record.Content)
+		}
+	}
+	sort.Strings(nameServers)
+	nameServersTF, diags := types.ListValueFrom(ctx, types.StringType, nameServers)
+	if diags.HasError() {
+		return fmt.Errorf("mapping name servers: %w", core.DiagsToError(diags))
+	}
+
+	transferAcl := []string{}
+	if zone.Acl != nil {
+		for _, network := range strings.Split(*zone.Acl, ",") {
+			network = strings.TrimSpace(network)
+			if network != "" {
+				transferAcl = append(transferAcl, network)
+			}
+		}
+	}
+	transferAclTF, diags := types.ListValueFrom(ctx, types.StringType, transferAcl)
+	if diags.HasError() {
+		return fmt.Errorf("mapping transfer ACL: %w", core.DiagsToError(diags))
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), zoneId)
+	model.ZoneId = types.StringValue(zoneId)
+	model.DnsName = types.StringPointerValue(zone.DnsName)
+	model.Type = types.StringNull()
+	if zone.Type != nil {
+		model.Type = types.StringValue(string(*zone.Type))
+	}
+	model.PrimaryNameServer = types.StringPointerValue(zone.PrimaryNameServer)
+	model.NameServers = nameServersTF
+	model.TransferAcl = transferAclTF
+	model.SerialNumber = types.Int64PointerValue(zone.SerialNumber)
+	return nil
+}
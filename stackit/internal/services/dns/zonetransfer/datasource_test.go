@@ -0,0 +1,123 @@
+package dns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		zone        *dns.Zone
+		recordSets  []dns.RecordSet
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_ok",
+			&dns.Zone{
+				Id:                utils.Ptr("zid"),
+				DnsName:           utils.Ptr("example.com"),
+				Type:              dns.ZONETYPE_PRIMARY.Ptr(),
+				PrimaryNameServer: utils.Ptr("ns1.stackit.cloud"),
+				Acl:               utils.Ptr("192.0.2.0/24, 2001:db8::/32"),
+				SerialNumber:      utils.Ptr(int64(2025010101)),
+			},
+			[]dns.RecordSet{
+				{
+					Name: utils.Ptr("example.com."),
+					Type: dns.RECORDSETTYPE_NS.Ptr(),
+					Records: &[]dns.Record{
+						{Content: utils.Ptr("ns2.stackit.cloud.")},
+						{Content: utils.Ptr("ns1.stackit.cloud.")},
+					},
+				},
+				{
+					// delegation of a subdomain, not part of the zone's name servers
+					Name: utils.Ptr("sub.example.com."),
+					Type: dns.RECORDSETTYPE_NS.Ptr(),
+					Records: &[]dns.Record{
+						{Content: utils.Ptr("ns.other-provider.net.")},
+					},
+				},
+			},
+			Model{
+				Id:                types.StringValue("pid,zid"),
+				ProjectId:         types.StringValue("pid"),
+				ZoneId:            types.StringValue("zid"),
+				DnsName:           types.StringValue("example.com"),
+				Type:              types.StringValue("primary"),
+				PrimaryNameServer: types.StringValue("ns1.stackit.cloud"),
+				NameServers: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("ns1.stackit.cloud."),
+					types.StringValue("ns2.stackit.cloud."),
+				}),
+				TransferAcl: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("192.0.2.0/24"),
+					types.StringValue("2001:db8::/32"),
+				}),
+				SerialNumber: types.Int64Value(2025010101),
+			},
+			true,
+		},
+		{
+			"empty_zone",
+			&dns.Zone{
+				Id: utils.Ptr("zid"),
+			},
+			nil,
+			Model{
+				Id:                types.StringValue("pid,zid"),
+				ProjectId:         types.StringValue("pid"),
+				ZoneId:            types.StringValue("zid"),
+				DnsName:           types.StringNull(),
+				Type:              types.StringNull(),
+				PrimaryNameServer: types.StringNull(),
+				NameServers:       types.ListValueMust(types.StringType, []attr.Value{}),
+				TransferAcl:       types.ListValueMust(types.StringType, []attr.Value{}),
+				SerialNumber:      types.Int64Null(),
+			},
+			true,
+		},
+		{
+			"nil_zone",
+			nil,
+			nil,
+			Model{},
+			false,
+		},
+		{
+			"no_zone_id",
+			&dns.Zone{},
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := Model{
+				ProjectId: types.StringValue("pid"),
+			}
+			err := mapFields(context.Background(), tt.zone, tt.recordSets, &model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
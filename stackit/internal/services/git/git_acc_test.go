@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	stackitSdkConfig "github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
@@ -25,10 +26,10 @@ var resourceMin string
 //go:embed testdata/resource-max.tf
 var resourceMax string
 
-var nameMin = fmt.Sprintf("git-min-%s-instance", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))
-var nameMinUpdated = fmt.Sprintf("git-min-%s-instance", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))
-var nameMax = fmt.Sprintf("git-max-%s-instance", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))
-var nameMaxUpdated = fmt.Sprintf("git-max-%s-instance", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))
+var nameMin = fmt.Sprintf("git-min-%s-instance", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))
+var nameMinUpdated = fmt.Sprintf("git-min-%s-instance", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))
+var nameMax = fmt.Sprintf("git-max-%s-instance", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))
+var nameMaxUpdated = fmt.Sprintf("git-max-%s-instance", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))
 var aclUpdated = "192.168.1.0/32"
 
 var testConfigVarsMin = config.Variables{
@@ -63,6 +64,15 @@ func testConfigVarsMaxUpdated() config.Variables {
 	return tempConfig
 }
 
+func testConfigVarsMaxFlavorUpdated() config.Variables {
+	tempConfig := make(config.Variables, len(testConfigVarsMax))
+	maps.Copy(tempConfig, testConfigVarsMax)
+	// update git instance to a new flavor
+	// should resize the instance in-place
+	tempConfig["flavor"] = config.StringVariable("git-10")
+	return tempConfig
+}
+
 func TestAccGitMin(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
@@ -94,9 +104,27 @@ func TestAccGitMin(t *testing.T) {
 						project_id  = stackit_git.git.project_id
 						instance_id = stackit_git.git.instance_id
 					}
+
+					data "stackit_git" "git_by_name" {
+						project_id = stackit_git.git.project_id
+						name       = stackit_git.git.name
+					}
 					`, testutil.GitProviderConfig()+resourceMin,
 				),
 				Check: resource.ComposeAggregateTestCheckFunc(
+					// Instance by name
+					resource.TestCheckResourceAttrPair(
+						"stackit_git.git", "instance_id",
+						"data.stackit_git.git_by_name", "instance_id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"stackit_git.git", "url",
+						"data.stackit_git.git_by_name", "url",
+					),
+					resource.TestCheckResourceAttrPair(
+						"stackit_git.git", "flavor",
+						"data.stackit_git.git_by_name", "flavor",
+					),
 					// Instance
 					resource.TestCheckResourceAttr("data.stackit_git.git", "project_id", testutil.ConvertConfigVariable(testConfigVarsMin["project_id"])),
 					resource.TestCheckResourceAttrPair(
@@ -273,6 +301,22 @@ func TestAccGitMax(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Update flavor in-place
+			{
+				ConfigVariables: testConfigVarsMaxFlavorUpdated(),
+				Config:          testutil.GitProviderConfig() + resourceMax,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("stackit_git.git", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_git.git", "name", testutil.ConvertConfigVariable(testConfigVarsMaxFlavorUpdated()["name"])),
+					resource.TestCheckResourceAttr("stackit_git.git", "flavor", testutil.ConvertConfigVariable(testConfigVarsMaxFlavorUpdated()["flavor"])),
+					resource.TestCheckResourceAttr("stackit_git.git", "acl.0", testutil.ConvertConfigVariable(testConfigVarsMaxFlavorUpdated()["acl"])),
+					resource.TestCheckResourceAttrSet("stackit_git.git", "instance_id"),
+				),
+			},
 			// Update
 			{
 				ConfigVariables: testConfigVarsMaxUpdated(),
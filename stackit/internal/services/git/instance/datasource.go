@@ -9,8 +9,10 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	gitUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -23,7 +25,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource = &gitDataSource{}
+	_ datasource.DataSource                     = &gitDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &gitDataSource{}
 )
 
 // NewGitDataSource creates a new instance of the gitDataSource.
@@ -61,6 +64,16 @@ func (g *gitDataSource) Metadata(_ context.Context, req datasource.MetadataReque
 	resp.TypeName = req.ProviderTypeName + "_git"
 }
 
+// ConfigValidators validates the data source configuration
+func (g *gitDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("instance_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 // Schema defines the schema for the git data source.
 func (g *gitDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -80,8 +93,9 @@ func (g *gitDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, re
 				},
 			},
 			"instance_id": schema.StringAttribute{
-				Description: descriptions["instance_id"],
-				Required:    true,
+				Description: descriptions["instance_id"] + " Either `instance_id` or `name` must be set.",
+				Optional:    true,
+				Computed:    true,
 				Validators: []validator.String{
 					validate.UUID(),
 					validate.NoSeparator(),
@@ -109,7 +123,8 @@ func (g *gitDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, re
 				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: descriptions["name"],
+				Description: descriptions["name"] + " Either `instance_id` or `name` must be set.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"url": schema.StringAttribute{
@@ -134,24 +149,47 @@ func (g *gitDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 
 	ctx = core.InitProviderContext(ctx)
 
-	// Extract the project ID and instance id of the model
+	// Extract the project ID, instance id and name of the model
 	projectId := model.ProjectId.ValueString()
 	instanceId := model.InstanceId.ValueString()
+	instanceName := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "instance_name", instanceName)
+
+	var gitInstanceResp *git.Instance
+	var err error
+	if instanceId != "" {
+		// Read the current git instance via id
+		gitInstanceResp, err = g.client.GetInstance(ctx, projectId, instanceId).Execute()
+		if err != nil {
+			var oapiErr *oapierror.GenericOpenAPIError
+			ok := errors.As(err, &oapiErr)
+			if ok && oapiErr.StatusCode == http.StatusNotFound {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading git instance", fmt.Sprintf("Calling API: %v", err))
+			return
+		}
 
-	// Read the current git instance via id
-	gitInstanceResp, err := g.client.GetInstance(ctx, projectId, instanceId).Execute()
-	if err != nil {
-		var oapiErr *oapierror.GenericOpenAPIError
-		ok := errors.As(err, &oapiErr)
-		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		ctx = core.LogResponse(ctx)
+	} else {
+		// Read the current git instance via name
+		listResp, err := g.client.ListInstances(ctx, projectId).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading git instance", fmt.Sprintf("Calling API: %v", err))
 			return
 		}
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading git instance", fmt.Sprintf("Calling API: %v", err))
-		return
-	}
 
-	ctx = core.LogResponse(ctx)
+		ctx = core.LogResponse(ctx)
+
+		gitInstanceResp, err = findInstanceByName(listResp, instanceName)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading git instance", fmt.Sprintf("Instance with name %q does not exist in project %q: %v", instanceName, projectId, err))
+			return
+		}
+	}
 
 	err = mapFields(ctx, gitInstanceResp, &model)
 	if err != nil {
@@ -162,5 +200,19 @@ func (g *gitDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	// Set the updated state.
 	diags = resp.State.Set(ctx, &model)
 	resp.Diagnostics.Append(diags...)
-	tflog.Info(ctx, fmt.Sprintf("read git instance %s", instanceId))
+	tflog.Info(ctx, fmt.Sprintf("read git instance %s", model.InstanceId.ValueString()))
+}
+
+// findInstanceByName returns the git instance with the given name from the list response.
+func findInstanceByName(listResp *git.ListInstances, name string) (*git.Instance, error) {
+	if listResp == nil || listResp.Instances == nil {
+		return nil, fmt.Errorf("empty list response")
+	}
+	for i := range *listResp.Instances {
+		instance := (*listResp.Instances)[i]
+		if instance.Name != nil && *instance.Name == name {
+			return &instance, nil
+		}
+	}
+	return nil, fmt.Errorf("no instance found")
 }
@@ -0,0 +1,90 @@
+package instance
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/git"
+)
+
+func TestFindInstanceByName(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *git.ListInstances
+		name        string
+		expected    *git.Instance
+		isValid     bool
+	}{
+		{
+			description: "instance_found",
+			input: &git.ListInstances{
+				Instances: &[]git.Instance{
+					{
+						Id:   utils.Ptr("iid-1"),
+						Name: utils.Ptr("git-instance-1"),
+					},
+					{
+						Id:   utils.Ptr("iid-2"),
+						Name: utils.Ptr("git-instance-2"),
+						Url:  utils.Ptr("https://git-instance-2.git.onstackit.cloud"),
+					},
+				},
+			},
+			name: "git-instance-2",
+			expected: &git.Instance{
+				Id:   utils.Ptr("iid-2"),
+				Name: utils.Ptr("git-instance-2"),
+				Url:  utils.Ptr("https://git-instance-2.git.onstackit.cloud"),
+			},
+			isValid: true,
+		},
+		{
+			description: "instance_not_found",
+			input: &git.ListInstances{
+				Instances: &[]git.Instance{
+					{
+						Id:   utils.Ptr("iid-1"),
+						Name: utils.Ptr("git-instance-1"),
+					},
+					{
+						Id: utils.Ptr("iid-2"),
+					},
+				},
+			},
+			name:    "git-instance-2",
+			isValid: false,
+		},
+		{
+			description: "no_instances",
+			input: &git.ListInstances{
+				Instances: &[]git.Instance{},
+			},
+			name:    "git-instance",
+			isValid: false,
+		},
+		{
+			description: "nil_response",
+			input:       nil,
+			name:        "git-instance",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := findInstanceByName(tt.input, tt.name)
+			if tt.isValid && err != nil {
+				t.Fatalf("expected success, got error: %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if tt.isValid {
+				if diff := cmp.Diff(tt.expected, output); diff != "" {
+					t.Errorf("unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	sdkUtils "github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/git"
 	"github.com/stackitcloud/stackit-sdk-go/services/git/wait"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
@@ -34,8 +35,11 @@ var (
 	_ resource.Resource                = &gitResource{}
 	_ resource.ResourceWithConfigure   = &gitResource{}
 	_ resource.ResourceWithImportState = &gitResource{}
+	_ resource.ResourceWithIdentity    = &gitResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "instance_id"}
+
 // Model represents the schema for the git resource.
 type Model struct {
 	Id                    types.String `tfsdk:"id"` // Required by Terraform
@@ -51,6 +55,12 @@ type Model struct {
 	Version               types.String `tfsdk:"version"`
 }
 
+// resourceModel extends the model shared with the data source by the attributes which only apply to the resource
+type resourceModel struct {
+	Model
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+}
+
 // NewGitResource is a helper function to create a new git resource instance.
 func NewGitResource() resource.Resource {
 	return &gitResource{}
@@ -58,7 +68,8 @@ func NewGitResource() resource.Resource {
 
 // gitResource implements the resource interface for git instances.
 type gitResource struct {
-	client *git.APIClient
+	client       *git.APIClient
+	providerData core.ProviderData
 }
 
 // descriptions for the attributes in the Schema
@@ -93,6 +104,7 @@ func (g *gitResource) Configure(ctx context.Context, req resource.ConfigureReque
 		return
 	}
 	g.client = apiClient
+	g.providerData = providerData
 	tflog.Info(ctx, "git client configured")
 }
 
@@ -101,13 +113,18 @@ func (g *gitResource) Metadata(_ context.Context, req resource.MetadataRequest,
 	resp.TypeName = req.ProviderTypeName + "_git"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (g *gitResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (g *gitResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: fmt.Sprintf(
 			"%s %s",
 			features.AddBetaDescription("Git Instance resource schema.", core.Resource),
-			"This resource currently does not support updates. Changing the ACLs, flavor, or name will trigger resource recreation. Update functionality will be added soon. In the meantime, please proceed with caution. To update these attributes, please open a support ticket.",
+			"The flavor can be updated in-place, which resizes the instance while keeping its repository data. Changing the ACLs or name will trigger resource recreation. To update these attributes without recreation, please open a support ticket.",
 		),
 		Description: "Git Instance resource schema.",
 		Attributes: map[string]schema.Attribute{
@@ -155,10 +172,11 @@ func (g *gitResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				Description: descriptions["created"],
 				Computed:    true,
 			},
+			"deletion_protection": utils.DeletionProtectionAttribute("git instance"),
 			"flavor": schema.StringAttribute{
 				Description: descriptions["flavor"],
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 				Optional: true,
 				Computed: true,
@@ -187,8 +205,10 @@ func (g *gitResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 
 // Create creates the resource and sets the initial Terraform state for the git instance.
 func (g *gitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve the planned values for the resource.
-	var model Model
+	var model resourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -203,7 +223,7 @@ func (g *gitResource) Create(ctx context.Context, req resource.CreateRequest, re
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "instance_name", instanceName)
 
-	payload, diags := toCreatePayload(ctx, &model)
+	payload, diags := toCreatePayload(ctx, &model.Model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -221,13 +241,13 @@ func (g *gitResource) Create(ctx context.Context, req resource.CreateRequest, re
 	ctx = core.LogResponse(ctx)
 
 	gitInstanceId := *gitInstanceResp.Id
-	_, err = wait.CreateGitInstanceWaitHandler(ctx, g.client, projectId, gitInstanceId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.CreateGitInstanceWaitHandler(ctx, g.client, projectId, gitInstanceId), g.providerData.Wait, core.WaitCreate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating git instance", fmt.Sprintf("Git instance creation waiting: %v", err))
 		return
 	}
 
-	err = mapFields(ctx, gitInstanceResp, &model)
+	err = mapFields(ctx, gitInstanceResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating git instance", fmt.Sprintf("Mapping fields: %v", err))
 		return
@@ -244,8 +264,10 @@ func (g *gitResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 // Read refreshes the Terraform state with the latest git instance data.
 func (g *gitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	// Retrieve the current state of the resource.
-	var model Model
+	var model resourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -264,7 +286,7 @@ func (g *gitResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		var oapiErr *oapierror.GenericOpenAPIError
 		ok := errors.As(err, &oapiErr)
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_git", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading git instance", fmt.Sprintf("Calling API: %v", err))
@@ -273,7 +295,7 @@ func (g *gitResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 	ctx = core.LogResponse(ctx)
 
-	err = mapFields(ctx, gitInstanceResp, &model)
+	err = mapFields(ctx, gitInstanceResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading git instance", fmt.Sprintf("Processing API response: %v", err))
 		return
@@ -285,21 +307,83 @@ func (g *gitResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	tflog.Info(ctx, fmt.Sprintf("read git instance %s", instanceId))
 }
 
-// Update attempts to update the resource. In this case, git instances cannot be updated.
-// Note: This method is intentionally left without update logic because changes
-// to 'project_id' or 'name' require the resource to be entirely replaced.
-// As a result, the Update function is redundant since any modifications will
-// automatically trigger a resource recreation through Terraform's built-in
-// lifecycle management.
-func (g *gitResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
-	// git instances cannot be updated, so we log an error.
-	core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating git instance", "Git Instance can't be updated")
+// Update updates the flavor of the git instance in-place. Changes to all other attributes trigger a resource recreation.
+func (g *gitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	// Retrieve the planned values for the resource.
+	var model resourceModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve the current state of the resource.
+	var stateModel resourceModel
+	diags = req.State.Get(ctx, &stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := stateModel.ProjectId.ValueString()
+	instanceId := stateModel.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+
+	var gitInstanceResp *git.Instance
+	var err error
+	if model.Flavor.IsNull() || model.Flavor.IsUnknown() || model.Flavor.Equal(stateModel.Flavor) {
+		// Nothing to update, refresh the computed attributes.
+		gitInstanceResp, err = g.client.GetInstance(ctx, projectId, instanceId).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating git instance", fmt.Sprintf("Calling API: %v", err))
+			return
+		}
+
+		ctx = core.LogResponse(ctx)
+	} else {
+		flavor := model.Flavor.ValueString()
+		_, err = g.client.PatchInstance(ctx, projectId, instanceId).
+			PatchOperation(toFlavorPatchOperations(flavor)).
+			Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating git instance", fmt.Sprintf("Calling API: %v", err))
+			return
+		}
+
+		ctx = core.LogResponse(ctx)
+
+		gitInstanceResp, err = core.ConfigureWaitHandler(gitUtils.UpdateFlavorWaitHandler(ctx, g.client, projectId, instanceId, flavor), g.providerData.Wait, core.WaitUpdate).WaitWithContext(ctx)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating git instance", fmt.Sprintf("Git instance update waiting: %v", err))
+			return
+		}
+	}
+
+	model.ProjectId = stateModel.ProjectId
+	err = mapFields(ctx, gitInstanceResp, &model.Model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating git instance", fmt.Sprintf("Processing API response: %v", err))
+		return
+	}
+
+	// Set the updated state.
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Git instance updated")
 }
 
 // Delete deletes the git instance and removes it from the Terraform state on success.
 func (g *gitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve current state of the resource.
-	var model Model
+	var model resourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -313,6 +397,10 @@ func (g *gitResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
 
+	if utils.BlockDeletionIfProtected(ctx, req.State, "git instance", &resp.Diagnostics) {
+		return
+	}
+
 	// Call API to delete the existing git instance.
 	err := g.client.DeleteInstance(ctx, projectId, instanceId).Execute()
 	if err != nil {
@@ -322,7 +410,7 @@ func (g *gitResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.DeleteGitInstanceWaitHandler(ctx, g.client, projectId, instanceId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.DeleteGitInstanceWaitHandler(ctx, g.client, projectId, instanceId), g.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error waiting for instance deletion", fmt.Sprintf("Instance deletion waiting: %v", err))
 		return
@@ -334,14 +422,15 @@ func (g *gitResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,instance_id
 func (g *gitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
 	// Split the import identifier to extract project ID and email.
-	idParts := strings.Split(req.ID, core.Separator)
+	idParts := strings.Split(importId, core.Separator)
 
 	// Ensure the import identifier format is correct.
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing git instance",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[instance_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[instance_id]  Got: %q", importId),
 		)
 		return
 	}
@@ -423,3 +512,15 @@ func toCreatePayload(ctx context.Context, model *Model) (git.CreateInstancePaylo
 
 	return payload, diags
 }
+
+// toFlavorPatchOperations creates the patch operations to resize a git instance.
+// As defined by RFC 6902, adding an existing member replaces its value.
+func toFlavorPatchOperations(flavor string) []git.PatchOperation {
+	return []git.PatchOperation{
+		{
+			Op:    git.PATCHOPERATIONOP_ADD.Ptr(),
+			Path:  sdkUtils.Ptr("/flavor"),
+			Value: sdkUtils.Ptr(flavor),
+		},
+	}
+}
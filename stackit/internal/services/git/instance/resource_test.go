@@ -223,3 +223,17 @@ func TestToCreatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestToFlavorPatchOperations(t *testing.T) {
+	expected := []git.PatchOperation{
+		{
+			Op:    git.PATCHOPERATIONOP_ADD.Ptr(),
+			Path:  utils.Ptr("/flavor"),
+			Value: utils.Ptr("git-100"),
+		},
+	}
+	output := toFlavorPatchOperations("git-100")
+	if diff := cmp.Diff(expected, output); diff != "" {
+		t.Fatalf("unexpected patch operations (-want +got):\n%s", diff)
+	}
+}
@@ -19,7 +19,9 @@ func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags
 	if providerData.GitCustomEndpoint != "" {
 		apiClientConfigOptions = append(apiClientConfigOptions, config.WithEndpoint(providerData.GitCustomEndpoint))
 	}
-	apiClient, err := git.NewAPIClient(apiClientConfigOptions...)
+	apiClient, err := core.CachedClient(providerData.Clients, "git", "", providerData.GitCustomEndpoint, func() (*git.APIClient, error) {
+		return git.NewAPIClient(apiClientConfigOptions...)
+	})
 	if err != nil {
 		core.LogAndAddError(ctx, diags, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the resource configuration", err))
 		return nil
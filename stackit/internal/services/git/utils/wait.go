@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/git"
+	gitWait "github.com/stackitcloud/stackit-sdk-go/services/git/wait"
+)
+
+// UpdateFlavorWaitHandler waits until the git instance is ready again and runs with the given flavor.
+func UpdateFlavorWaitHandler(ctx context.Context, a gitWait.APIClientInterface, projectId, instanceId, flavor string) *wait.AsyncActionHandler[git.Instance] {
+	handler := wait.New(func() (waitFinished bool, response *git.Instance, err error) {
+		instance, err := a.GetInstanceExecute(ctx, projectId, instanceId)
+		if err != nil {
+			return false, nil, err
+		}
+		if instance == nil || instance.Id == nil || instance.State == nil {
+			return false, nil, fmt.Errorf("could not get instance id or state from response for project %s and instance %s", projectId, instanceId)
+		}
+		if *instance.Id != instanceId {
+			return false, nil, nil
+		}
+		switch *instance.State {
+		case git.INSTANCESTATE_ERROR:
+			return true, instance, fmt.Errorf("update failed for instance with id %s", instanceId)
+		case git.INSTANCESTATE_READY:
+			// The resize might not have started yet, so the instance is only done once it reports the new flavor
+			if instance.Flavor != nil && *instance.Flavor == flavor {
+				return true, instance, nil
+			}
+		}
+		return false, nil, nil
+	})
+	handler.SetTimeout(20 * time.Minute)
+	return handler
+}
@@ -0,0 +1,158 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	sdkUtils "github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/git"
+)
+
+var (
+	testInstanceId = uuid.New().String()
+	testProjectId  = uuid.New().String()
+)
+
+type apiClientMocked struct {
+	getFails  bool
+	errorCode int
+	responses []*git.Instance
+	calls     int
+}
+
+// GetInstanceExecute returns the mocked responses in order, the last one is repeated.
+func (a *apiClientMocked) GetInstanceExecute(_ context.Context, _, _ string) (*git.Instance, error) {
+	if a.getFails {
+		return nil, &oapierror.GenericOpenAPIError{
+			StatusCode: a.errorCode,
+		}
+	}
+	idx := min(a.calls, len(a.responses)-1)
+	a.calls++
+	return a.responses[idx], nil
+}
+
+func TestUpdateFlavorWaitHandler(t *testing.T) {
+	instance := func(state git.InstanceState, flavor string) *git.Instance {
+		return &git.Instance{
+			Id:     sdkUtils.Ptr(testInstanceId),
+			Name:   sdkUtils.Ptr("git-instance"),
+			Flavor: sdkUtils.Ptr(flavor),
+			State:  sdkUtils.Ptr(state),
+		}
+	}
+
+	tests := []struct {
+		description   string
+		getFails      bool
+		errorCode     int
+		responses     []*git.Instance
+		cancelContext bool
+		wantErr       bool
+		wantResp      *git.Instance
+	}{
+		{
+			description: "resize succeeded",
+			responses: []*git.Instance{
+				instance(git.INSTANCESTATE_UPDATING, "git-100"),
+				instance(git.INSTANCESTATE_READY, "git-100"),
+			},
+			wantErr:  false,
+			wantResp: instance(git.INSTANCESTATE_READY, "git-100"),
+		},
+		{
+			description: "resize not started yet",
+			responses: []*git.Instance{
+				instance(git.INSTANCESTATE_READY, "git-10"),
+				instance(git.INSTANCESTATE_UPDATING, "git-10"),
+				instance(git.INSTANCESTATE_READY, "git-100"),
+			},
+			wantErr:  false,
+			wantResp: instance(git.INSTANCESTATE_READY, "git-100"),
+		},
+		{
+			description: "other instance ignored",
+			responses: []*git.Instance{
+				{
+					Id:     sdkUtils.Ptr("other-instance"),
+					Flavor: sdkUtils.Ptr("git-100"),
+					State:  sdkUtils.Ptr(git.INSTANCESTATE_READY),
+				},
+				instance(git.INSTANCESTATE_READY, "git-100"),
+			},
+			wantErr:  false,
+			wantResp: instance(git.INSTANCESTATE_READY, "git-100"),
+		},
+		{
+			description: "resize failed",
+			responses: []*git.Instance{
+				instance(git.INSTANCESTATE_UPDATING, "git-100"),
+				instance(git.INSTANCESTATE_ERROR, "git-100"),
+			},
+			wantErr:  true,
+			wantResp: instance(git.INSTANCESTATE_ERROR, "git-100"),
+		},
+		{
+			description: "wait interrupted by timeout",
+			responses: []*git.Instance{
+				instance(git.INSTANCESTATE_UPDATING, "git-100"),
+			},
+			wantErr: true,
+		},
+		{
+			description: "wait interrupted by canceled context",
+			responses: []*git.Instance{
+				instance(git.INSTANCESTATE_UPDATING, "git-100"),
+			},
+			cancelContext: true,
+			wantErr:       true,
+		},
+		{
+			description: "get fails",
+			getFails:    true,
+			errorCode:   http.StatusNotFound,
+			wantErr:     true,
+		},
+		{
+			description: "missing state",
+			responses: []*git.Instance{
+				{
+					Id:     sdkUtils.Ptr(testInstanceId),
+					Flavor: sdkUtils.Ptr("git-100"),
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			apiClient := &apiClientMocked{
+				getFails:  tt.getFails,
+				errorCode: tt.errorCode,
+				responses: tt.responses,
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancelContext {
+				cancel()
+			}
+
+			handler := UpdateFlavorWaitHandler(ctx, apiClient, testProjectId, testInstanceId, "git-100")
+			response, err := handler.SetThrottle(time.Millisecond).SetTimeout(50 * time.Millisecond).WaitWithContext(ctx)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("handler error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.wantResp, response); diff != "" {
+				t.Fatalf("unexpected response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -32,9 +32,12 @@ var (
 	_ resource.Resource                = &affinityGroupResource{}
 	_ resource.ResourceWithConfigure   = &affinityGroupResource{}
 	_ resource.ResourceWithImportState = &affinityGroupResource{}
+	_ resource.ResourceWithIdentity    = &affinityGroupResource{}
 	_ resource.ResourceWithModifyPlan  = &affinityGroupResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "affinity_group_id"}
+
 // Model is the provider's internal model
 type Model struct {
 	Id              types.String `tfsdk:"id"`
@@ -61,6 +64,11 @@ func (r *affinityGroupResource) Metadata(_ context.Context, req resource.Metadat
 	resp.TypeName = req.ProviderTypeName + "_affinity_group"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *affinityGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan.
 // Use the modifier to set the effective region in the current plan.
 func (r *affinityGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
@@ -80,7 +88,7 @@ func (r *affinityGroupResource) ModifyPlan(ctx context.Context, req resource.Mod
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -189,6 +197,8 @@ func (r *affinityGroupResource) Schema(_ context.Context, _ resource.SchemaReque
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *affinityGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var model Model
 	diags := req.Config.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -236,6 +246,8 @@ func (r *affinityGroupResource) Create(ctx context.Context, req resource.CreateR
 
 // Read refreshes the Terraform state with the latest data.
 func (r *affinityGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -256,7 +268,7 @@ func (r *affinityGroupResource) Read(ctx context.Context, req resource.ReadReque
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_affinity_group", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading affinity group", fmt.Sprintf("Call API: %v", err))
@@ -315,12 +327,13 @@ func (r *affinityGroupResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *affinityGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing affinity group",
-			fmt.Sprintf("Expected import indentifier with format: [project_id],[region],[affinity_group_id], got: %q", req.ID),
+			fmt.Sprintf("Expected import indentifier with format: [project_id],[region],[affinity_group_id], got: %q", importId),
 		)
 		return
 	}
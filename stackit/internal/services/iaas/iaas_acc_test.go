@@ -108,8 +108,8 @@ const (
 
 var testConfigServerVarsMin = config.Variables{
 	"project_id":   config.StringVariable(testutil.ProjectId),
-	"name":         config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
-	"network_name": config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"name":         config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"network_name": config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 	"machine_type": config.StringVariable("t1.1"),
 	"image_id":     config.StringVariable("a2c127b2-b1b5-4aee-986f-41cd11b41279"),
 }
@@ -128,8 +128,8 @@ var testConfigServerVarsMinUpdated = func() config.Variables {
 
 var testConfigServerVarsMax = config.Variables{
 	"project_id":           config.StringVariable(testutil.ProjectId),
-	"name":                 config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
-	"name_not_updated":     config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"name":                 config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"name_not_updated":     config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 	"machine_type":         config.StringVariable("t1.1"),
 	"image_id":             config.StringVariable("a2c127b2-b1b5-4aee-986f-41cd11b41279"),
 	"availability_zone":    config.StringVariable("eu01-1"),
@@ -170,7 +170,7 @@ var testConfigServerVarsMaxUpdatedDesiredStatus = func() config.Variables {
 
 var testConfigAffinityGroupVarsMin = config.Variables{
 	"project_id": config.StringVariable(testutil.ProjectId),
-	"name":       config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"name":       config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 	"policy":     config.StringVariable("hard-affinity"),
 }
 
@@ -178,14 +178,14 @@ var testConfigAffinityGroupVarsMin = config.Variables{
 
 var testConfigNetworkInterfaceVarsMin = config.Variables{
 	"project_id": config.StringVariable(testutil.ProjectId),
-	"name":       config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"name":       config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 }
 
 // NETWORK INTERFACE - MAX
 
 var testConfigNetworkInterfaceVarsMax = config.Variables{
 	"project_id":      config.StringVariable(testutil.ProjectId),
-	"name":            config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"name":            config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 	"allowed_address": config.StringVariable("10.2.10.0/24"),
 	"ipv4":            config.StringVariable("10.2.10.20"),
 	"ipv4_prefix":     config.StringVariable("10.2.10.0/24"),
@@ -227,7 +227,7 @@ var testConfigVolumeVarsMinUpdated = func() config.Variables {
 var testConfigVolumeVarsMax = config.Variables{
 	"project_id":        config.StringVariable(testutil.ProjectId),
 	"availability_zone": config.StringVariable("eu01-1"),
-	"name":              config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"name":              config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 	"size":              config.IntegerVariable(16),
 	"description":       config.StringVariable("description"),
 	"performance_class": config.StringVariable("storage_premium_perf0"),
@@ -250,7 +250,7 @@ var testConfigVolumeVarsMaxUpdated = func() config.Variables {
 
 var testConfigNetworkVarsMin = config.Variables{
 	"project_id": config.StringVariable(testutil.ProjectId),
-	"name":       config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"name":       config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 }
 
 var testConfigNetworkVarsMinUpdated = func() config.Variables {
@@ -263,7 +263,7 @@ var testConfigNetworkVarsMinUpdated = func() config.Variables {
 // NETWORK - MAX
 
 var testConfigNetworkVarsMax = config.Variables{
-	"name":                 config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"name":                 config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 	"ipv4_gateway":         config.StringVariable("10.2.2.1"),
 	"ipv4_nameserver_0":    config.StringVariable("10.2.2.2"),
 	"ipv4_nameserver_1":    config.StringVariable("10.2.2.3"),
@@ -289,7 +289,7 @@ var testConfigNetworkVarsMaxUpdated = func() config.Variables {
 
 var testConfigNetworkAreaVarsMin = config.Variables{
 	"organization_id": config.StringVariable(testutil.OrganizationId),
-	"name":            config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))),
+	"name":            config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlpha))),
 }
 
 var testConfigNetworkAreaVarsMinUpdated = func() config.Variables {
@@ -305,7 +305,7 @@ var testConfigNetworkAreaVarsMinUpdated = func() config.Variables {
 
 var testConfigNetworkAreaVarsMax = config.Variables{
 	"organization_id":         config.StringVariable(testutil.OrganizationId),
-	"name":                    config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))),
+	"name":                    config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlpha))),
 	"transfer_network":        config.StringVariable("10.1.2.0/24"),
 	"network_ranges_prefix":   config.StringVariable("10.0.0.0/16"),
 	"default_nameservers":     config.StringVariable("1.1.1.1"),
@@ -343,7 +343,7 @@ var testConfigNetworkAreaVarsMaxUpdated = func() config.Variables {
 
 var testConfigNetworkAreaRegionVarsMin = config.Variables{
 	"organization_id":       config.StringVariable(testutil.OrganizationId),
-	"name":                  config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))),
+	"name":                  config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlpha))),
 	"transfer_network":      config.StringVariable("10.1.2.0/24"),
 	"network_ranges_prefix": config.StringVariable("10.0.0.0/16"),
 }
@@ -361,7 +361,7 @@ var testConfigNetworkAreaRegionVarsMinUpdated = func() config.Variables {
 
 var testConfigNetworkAreaRegionVarsMax = config.Variables{
 	"organization_id":       config.StringVariable(testutil.OrganizationId),
-	"name":                  config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))),
+	"name":                  config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlpha))),
 	"transfer_network":      config.StringVariable("10.1.2.0/24"),
 	"network_ranges_prefix": config.StringVariable("10.0.0.0/16"),
 	"default_nameservers":   config.StringVariable("1.1.1.1"),
@@ -387,7 +387,7 @@ var testConfigNetworkAreaRegionVarsMaxUpdated = func() config.Variables {
 
 var testConfigSecurityGroupsVarsMin = config.Variables{
 	"project_id": config.StringVariable(testutil.ProjectId),
-	"name":       config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))),
+	"name":       config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlpha))),
 	"direction":  config.StringVariable("ingress"),
 }
 
@@ -404,7 +404,7 @@ func testConfigSecurityGroupsVarsMinUpdated() config.Variables {
 
 var testConfigSecurityGroupsVarsMax = config.Variables{
 	"project_id":       config.StringVariable(testutil.ProjectId),
-	"name":             config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))),
+	"name":             config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlpha))),
 	"description":      config.StringVariable("description"),
 	"description_rule": config.StringVariable("description"),
 	"label":            config.StringVariable("label"),
@@ -416,7 +416,7 @@ var testConfigSecurityGroupsVarsMax = config.Variables{
 	"protocol":         config.StringVariable("tcp"),
 	"icmp_code":        config.IntegerVariable(0),
 	"icmp_type":        config.IntegerVariable(8),
-	"name_remote":      config.StringVariable(fmt.Sprintf("tf-acc-remote-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))),
+	"name_remote":      config.StringVariable(fmt.Sprintf("tf-acc-remote-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlpha))),
 }
 
 func testConfigSecurityGroupsVarsMaxUpdated() config.Variables {
@@ -446,7 +446,7 @@ var testConfigImageVarsMin = func() config.Variables {
 	}
 	return config.Variables{
 		"project_id":      config.StringVariable(testutil.ProjectId),
-		"name":            config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))),
+		"name":            config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlpha))),
 		"disk_format":     config.StringVariable("qcow2"),
 		"local_file_path": config.StringVariable(localFilePath),
 	}
@@ -475,7 +475,7 @@ var testConfigImageVarsMax = func() config.Variables {
 	}
 	return config.Variables{
 		"project_id":               config.StringVariable(testutil.ProjectId),
-		"name":                     config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))),
+		"name":                     config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlpha))),
 		"disk_format":              config.StringVariable("qcow2"),
 		"local_file_path":          config.StringVariable(localFilePath),
 		"min_disk_size":            config.IntegerVariable(20),
@@ -525,14 +525,14 @@ var testConfigImageVarsMaxUpdated = func() config.Variables {
 // KEYPAIR - MIN
 
 var testConfigKeyPairMin = config.Variables{
-	"name":       config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))),
+	"name":       config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlpha))),
 	"public_key": config.StringVariable(keypairPublicKey),
 }
 
 // KEYPAIR - MAX
 
 var testConfigKeyPairMax = config.Variables{
-	"name":       config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))),
+	"name":       config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlpha))),
 	"public_key": config.StringVariable(keypairPublicKey),
 	"label":      config.StringVariable("label"),
 }
@@ -586,10 +586,20 @@ func TestAccNetworkMin(t *testing.T) {
 						project_id  = stackit_network.network.project_id
 						network_id  = stackit_network.network.network_id
 					}
+
+					data "stackit_network" "network_by_name" {
+						project_id  = stackit_network.network.project_id
+						name        = stackit_network.network.name
+					}
 					`,
 					testutil.IaaSProviderConfigWithExperiments(), resourceNetworkMinConfig,
 				),
 				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.stackit_network.network_by_name", "network_id",
+						"stackit_network.network", "network_id",
+					),
+					resource.TestCheckResourceAttr("data.stackit_network.network_by_name", "name", testutil.ConvertConfigVariable(testConfigNetworkVarsMin["name"])),
 					resource.TestCheckResourceAttrSet("data.stackit_network.network", "network_id"),
 					resource.TestCheckResourceAttr("data.stackit_network.network", "project_id", testutil.ConvertConfigVariable(testConfigNetworkVarsMin["project_id"])),
 					resource.TestCheckResourceAttr("data.stackit_network.network", "name", testutil.ConvertConfigVariable(testConfigNetworkVarsMin["name"])),
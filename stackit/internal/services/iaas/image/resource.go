@@ -39,9 +39,12 @@ var (
 	_ resource.Resource                = &imageResource{}
 	_ resource.ResourceWithConfigure   = &imageResource{}
 	_ resource.ResourceWithImportState = &imageResource{}
+	_ resource.ResourceWithIdentity    = &imageResource{}
 	_ resource.ResourceWithModifyPlan  = &imageResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "image_id"}
+
 type Model struct {
 	Id            types.String `tfsdk:"id"` // needed by TF
 	ProjectId     types.String `tfsdk:"project_id"`
@@ -121,6 +124,11 @@ func (r *imageResource) Metadata(_ context.Context, req resource.MetadataRequest
 	resp.TypeName = req.ProviderTypeName + "_image"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *imageResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan.
 // Use the modifier to set the effective region in the current plan.
 func (r *imageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
@@ -140,7 +148,7 @@ func (r *imageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -411,6 +419,8 @@ func (r *imageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -418,6 +428,7 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 
 	projectId := model.ProjectId.ValueString()
 	region := r.providerData.GetRegionWithOverride(model.Region)
@@ -432,6 +443,7 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
+	payload.Labels = r.providerData.MergeDefaultLabels(payload.Labels)
 
 	// Create new image
 	imageCreateResp, err := r.client.CreateImage(ctx, projectId, region).CreateImagePayload(*payload).Execute()
@@ -457,6 +469,7 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 
 	// Set state to partially populated data
 	diags = resp.State.Set(ctx, model)
@@ -474,8 +487,8 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Wait for image to become available
 	waiter := wait.UploadImageWaitHandler(ctx, r.client, projectId, region, *imageCreateResp.Id)
-	waiter = waiter.SetTimeout(7 * 24 * time.Hour) // Set timeout to one week, to make the timeout useless
-	waitResp, err := waiter.WaitWithContext(ctx)
+	// Set timeout to one week, to make the timeout useless
+	waitResp, err := core.ConfigureWaitHandlerWithTimeout(waiter, r.providerData.Wait, core.WaitCreate, 7*24*time.Hour).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image", fmt.Sprintf("Waiting for image to become available: %v", err))
 		return
@@ -487,6 +500,7 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, model)
@@ -499,12 +513,15 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 
 // Read refreshes the Terraform state with the latest data.
 func (r *imageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 
 	projectId := model.ProjectId.ValueString()
 	region := r.providerData.GetRegionWithOverride(model.Region)
@@ -520,7 +537,7 @@ func (r *imageResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_image", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading image", fmt.Sprintf("Calling API: %v", err))
@@ -535,6 +552,7 @@ func (r *imageResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading image", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -546,6 +564,8 @@ func (r *imageResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *imageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -553,6 +573,7 @@ func (r *imageResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 
 	projectId := model.ProjectId.ValueString()
 	region := r.providerData.GetRegionWithOverride(model.Region)
@@ -578,6 +599,7 @@ func (r *imageResource) Update(ctx context.Context, req resource.UpdateRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating image", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
+	payload.Labels = r.providerData.MergeDefaultLabels(payload.Labels)
 	// Update existing image
 	updatedImage, err := r.client.UpdateImage(ctx, projectId, region, imageId).UpdateImagePayload(*payload).Execute()
 	if err != nil {
@@ -592,6 +614,7 @@ func (r *imageResource) Update(ctx context.Context, req resource.UpdateRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating image", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -628,7 +651,7 @@ func (r *imageResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.DeleteImageWaitHandler(ctx, r.client, projectId, region, imageId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.DeleteImageWaitHandler(ctx, r.client, projectId, region, imageId), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting image", fmt.Sprintf("image deletion waiting: %v", err))
 		return
@@ -640,12 +663,13 @@ func (r *imageResource) Delete(ctx context.Context, req resource.DeleteRequest,
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,region,image_id
 func (r *imageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing image",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[image_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[image_id]  Got: %q", importId),
 		)
 		return
 	}
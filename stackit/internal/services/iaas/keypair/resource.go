@@ -3,6 +3,7 @@ package keypair
 import (
 	"context"
 	"fmt"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"net/http"
 	"strings"
 
@@ -26,8 +27,11 @@ var (
 	_ resource.Resource                = &keyPairResource{}
 	_ resource.ResourceWithConfigure   = &keyPairResource{}
 	_ resource.ResourceWithImportState = &keyPairResource{}
+	_ resource.ResourceWithIdentity    = &keyPairResource{}
 )
 
+var resourceIdentity = utils.Identity{"name"}
+
 type Model struct {
 	Id          types.String `tfsdk:"id"` // needed by TF
 	Name        types.String `tfsdk:"name"`
@@ -43,7 +47,8 @@ func NewKeyPairResource() resource.Resource {
 
 // keyPairResource is the resource implementation.
 type keyPairResource struct {
-	client *iaas.APIClient
+	client       *iaas.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -51,14 +56,20 @@ func (r *keyPairResource) Metadata(_ context.Context, req resource.MetadataReque
 	resp.TypeName = req.ProviderTypeName + "_key_pair"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *keyPairResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *keyPairResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
 	if !ok {
 		return
 	}
 
-	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	apiClient := iaasUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -137,6 +148,8 @@ func (r *keyPairResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *keyPairResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -144,6 +157,7 @@ func (r *keyPairResource) Create(ctx context.Context, req resource.CreateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 
 	name := model.Name.ValueString()
 
@@ -157,6 +171,7 @@ func (r *keyPairResource) Create(ctx context.Context, req resource.CreateRequest
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating key pair", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
+	payload.Labels = r.providerData.MergeDefaultLabels(payload.Labels)
 
 	// Create new key pair
 
@@ -174,6 +189,7 @@ func (r *keyPairResource) Create(ctx context.Context, req resource.CreateRequest
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating key pair", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -185,12 +201,15 @@ func (r *keyPairResource) Create(ctx context.Context, req resource.CreateRequest
 
 // Read refreshes the Terraform state with the latest data.
 func (r *keyPairResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 	name := model.Name.ValueString()
 
 	ctx = core.InitProviderContext(ctx)
@@ -201,7 +220,7 @@ func (r *keyPairResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_key_pair", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading key pair", fmt.Sprintf("Calling API: %v", err))
@@ -216,6 +235,7 @@ func (r *keyPairResource) Read(ctx context.Context, req resource.ReadRequest, re
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading key pair", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -227,6 +247,8 @@ func (r *keyPairResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *keyPairResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -234,6 +256,7 @@ func (r *keyPairResource) Update(ctx context.Context, req resource.UpdateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 	name := model.Name.ValueString()
 
 	ctx = core.InitProviderContext(ctx)
@@ -254,6 +277,7 @@ func (r *keyPairResource) Update(ctx context.Context, req resource.UpdateRequest
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating key pair", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
+	payload.Labels = r.providerData.MergeDefaultLabels(payload.Labels)
 	// Update existing key pair
 	updatedKeyPair, err := r.client.UpdateKeyPair(ctx, name).UpdateKeyPairPayload(*payload).Execute()
 	if err != nil {
@@ -268,6 +292,7 @@ func (r *keyPairResource) Update(ctx context.Context, req resource.UpdateRequest
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating key pair", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -307,12 +332,13 @@ func (r *keyPairResource) Delete(ctx context.Context, req resource.DeleteRequest
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,key_pair_id
 func (r *keyPairResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 1 || idParts[0] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing key pair",
-			fmt.Sprintf("Expected import identifier with format: [name]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [name]  Got: %q", importId),
 		)
 		return
 	}
@@ -80,7 +80,7 @@ func (d *machineTypeDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 		MarkdownDescription: features.AddBetaDescription("Machine type data source.", core.Datasource),
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`image_id`\".",
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`name`\".",
 				Computed:    true,
 			},
 			"project_id": schema.StringAttribute{
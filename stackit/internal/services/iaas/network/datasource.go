@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -23,7 +26,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource = &networkDataSource{}
+	_ datasource.DataSource                     = &networkDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &networkDataSource{}
 )
 
 type DataSourceModel struct {
@@ -81,13 +85,23 @@ func (d *networkDataSource) Configure(ctx context.Context, req datasource.Config
 	tflog.Info(ctx, "IaaS client configured")
 }
 
+// ConfigValidators validates the data source configuration
+func (d *networkDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("network_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 // Schema defines the schema for the data source.
 func (d *networkDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network resource schema. Must have a `region` specified in the provider configuration.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`network_id`\".",
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`network_id`\".",
 				Computed:    true,
 			},
 			"project_id": schema.StringAttribute{
@@ -99,15 +113,17 @@ func (d *networkDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				},
 			},
 			"network_id": schema.StringAttribute{
-				Description: "The network ID.",
-				Required:    true,
+				Description: "The network ID. Either `network_id` or `name` must be specified.",
+				Optional:    true,
+				Computed:    true,
 				Validators: []validator.String{
 					validate.UUID(),
 					validate.NoSeparator(),
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the network.",
+				Description: "The name of the network. Either `network_id` or `name` must be specified. When looking up a network by name, the name must be unique within the project and region.",
+				Optional:    true,
 				Computed:    true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
@@ -212,24 +228,53 @@ func (d *networkDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 	projectId := model.ProjectId.ValueString()
 	networkId := model.NetworkId.ValueString()
+	networkName := model.Name.ValueString()
 	region := d.providerData.GetRegionWithOverride(model.Region)
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "network_id", networkId)
+	ctx = tflog.SetField(ctx, "network_name", networkName)
+	ctx = tflog.SetField(ctx, "region", region)
 
-	networkResp, err := d.client.GetNetwork(ctx, projectId, region, networkId).Execute()
-	if err != nil {
-		utils.LogError(
-			ctx,
-			&resp.Diagnostics,
-			err,
-			"Reading network",
-			fmt.Sprintf("Network with ID %q does not exist in project %q.", networkId, projectId),
-			map[int]string{
-				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
-			},
-		)
-		resp.State.RemoveResource(ctx)
-		return
+	var networkResp *iaas.Network
+	var err error
+	if networkId != "" {
+		networkResp, err = d.client.GetNetwork(ctx, projectId, region, networkId).Execute()
+		if err != nil {
+			utils.LogError(
+				ctx,
+				&resp.Diagnostics,
+				err,
+				"Reading network",
+				fmt.Sprintf("Network with ID %q does not exist in project %q.", networkId, projectId),
+				map[int]string{
+					http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+				},
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	} else {
+		listResp, err := d.client.ListNetworks(ctx, projectId, region).Execute()
+		if err != nil {
+			utils.LogError(
+				ctx,
+				&resp.Diagnostics,
+				err,
+				"Reading network",
+				fmt.Sprintf("Networks of project %q in region %q could not be listed.", projectId, region),
+				map[int]string{
+					http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+				},
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		networkResp, err = findNetworkByName(listResp, networkName)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network", fmt.Sprintf("Looking up network with name %q in project %q and region %q: %v", networkName, projectId, region, err))
+			return
+		}
 	}
 
 	err = mapDataSourceFields(ctx, networkResp, &model, region)
@@ -400,3 +445,29 @@ func mapDataSourceFields(ctx context.Context, networkResp *iaas.Network, model *
 
 	return nil
 }
+
+// findNetworkByName returns the only network of the list response with the given name.
+// Network names aren't unique, so an error is returned if none or several networks match.
+func findNetworkByName(listResp *iaas.NetworkListResponse, name string) (*iaas.Network, error) {
+	if listResp == nil || listResp.Items == nil {
+		return nil, fmt.Errorf("empty list response")
+	}
+	var matches []iaas.Network
+	for _, network := range *listResp.Items {
+		if network.Name != nil && *network.Name == name {
+			matches = append(matches, network)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no network found")
+	case 1:
+		return &matches[0], nil
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, network := range matches {
+			ids = append(ids, network.GetId())
+		}
+		return nil, fmt.Errorf("found %d networks with this name (IDs: %s), use network_id instead", len(matches), strings.Join(ids, ", "))
+	}
+}
@@ -385,3 +385,101 @@ func TestMapDataSourceFields(t *testing.T) {
 		})
 	}
 }
+
+func TestFindNetworkByName(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaas.NetworkListResponse
+		name        string
+		expected    *iaas.Network
+		isValid     bool
+	}{
+		{
+			description: "network_found",
+			input: &iaas.NetworkListResponse{
+				Items: &[]iaas.Network{
+					{
+						Id:   utils.Ptr("nid-1"),
+						Name: utils.Ptr("network-1"),
+					},
+					{
+						Id:     utils.Ptr("nid-2"),
+						Name:   utils.Ptr("network-2"),
+						Routed: utils.Ptr(true),
+					},
+				},
+			},
+			name: "network-2",
+			expected: &iaas.Network{
+				Id:     utils.Ptr("nid-2"),
+				Name:   utils.Ptr("network-2"),
+				Routed: utils.Ptr(true),
+			},
+			isValid: true,
+		},
+		{
+			description: "network_not_found",
+			input: &iaas.NetworkListResponse{
+				Items: &[]iaas.Network{
+					{
+						Id:   utils.Ptr("nid-1"),
+						Name: utils.Ptr("network-1"),
+					},
+					{
+						Id: utils.Ptr("nid-2"),
+					},
+				},
+			},
+			name:    "network-2",
+			isValid: false,
+		},
+		{
+			description: "multiple_networks_found",
+			input: &iaas.NetworkListResponse{
+				Items: &[]iaas.Network{
+					{
+						Id:   utils.Ptr("nid-1"),
+						Name: utils.Ptr("network"),
+					},
+					{
+						Id:   utils.Ptr("nid-2"),
+						Name: utils.Ptr("network"),
+					},
+				},
+			},
+			name:    "network",
+			isValid: false,
+		},
+		{
+			description: "no_networks",
+			input: &iaas.NetworkListResponse{
+				Items: &[]iaas.Network{},
+			},
+			name:    "network",
+			isValid: false,
+		},
+		{
+			description: "nil_response",
+			input:       nil,
+			name:        "network",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := findNetworkByName(tt.input, tt.name)
+			if tt.isValid && err != nil {
+				t.Fatalf("expected success, got error: %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if tt.isValid {
+				if diff := cmp.Diff(tt.expected, output); diff != "" {
+					t.Errorf("unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
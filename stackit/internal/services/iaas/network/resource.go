@@ -37,9 +37,12 @@ var (
 	_ resource.Resource                = &networkResource{}
 	_ resource.ResourceWithConfigure   = &networkResource{}
 	_ resource.ResourceWithImportState = &networkResource{}
+	_ resource.ResourceWithIdentity    = &networkResource{}
 	_ resource.ResourceWithModifyPlan  = &networkResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "network_id"}
+
 const (
 	ipv4BehaviorChangeTitle       = "Behavior of not configured `ipv4_nameservers` will change from January 2026"
 	ipv4BehaviorChangeDescription = "When `ipv4_nameservers` is not set, it will be set to the network area's `default_nameservers`.\n" +
@@ -71,6 +74,8 @@ type Model struct {
 	NoIPv6Gateway    types.Bool   `tfsdk:"no_ipv6_gateway"`
 	Region           types.String `tfsdk:"region"`
 	RoutingTableID   types.String `tfsdk:"routing_table_id"`
+	LifecyclePaused  types.Bool   `tfsdk:"lifecycle_paused"`
+	AuthProfile      types.String `tfsdk:"auth_profile"`
 }
 
 // NewNetworkResource is a helper function to simplify the provider implementation.
@@ -89,6 +94,11 @@ func (r *networkResource) Metadata(_ context.Context, req resource.MetadataReque
 	resp.TypeName = req.ProviderTypeName + "_network"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *networkResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *networkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	var ok bool
@@ -129,7 +139,7 @@ func (r *networkResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		addIPv4Warning(&resp.Diagnostics)
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -320,13 +330,16 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					validate.CIDR(),
 				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"ipv6_prefix_length": schema.Int64Attribute{
-				Description: "The IPv6 prefix length of the network.",
+				Description: "The IPv6 prefix length of the network. If set, an IPv6 prefix of this length is allocated automatically.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
 			},
 			"ipv6_prefixes": schema.ListAttribute{
 				Description: "The IPv6 prefixes of the network.",
@@ -358,7 +371,7 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"routing_table_id": schema.StringAttribute{
-				Description: "The ID of the routing table associated with the network.",
+				Description: "The ID of the routing table associated with the network. Must not be set when the routing table is managed by the `stackit_network_routing_table_attachment` resource.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
@@ -369,6 +382,8 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					validate.NoSeparator(),
 				},
 			},
+			"lifecycle_paused": utils.LifecyclePausedAttribute(),
+			"auth_profile":     utils.AuthProfileAttribute(),
 			"region": schema.StringAttribute{
 				Optional: true,
 				// must be computed to allow for storing the override value from the provider
@@ -384,6 +399,8 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -391,6 +408,7 @@ func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 
 	// When IPv4Nameserver is not set, print warning that the behavior of ipv4_nameservers will change
 	if utils.IsUndefined(model.IPv4Nameservers) {
@@ -401,6 +419,7 @@ func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest
 	region := r.providerData.GetRegionWithOverride(model.Region)
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "region", region)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
 
 	// Generate API request body from model
 	payload, err := toCreatePayload(ctx, &model)
@@ -408,6 +427,8 @@ func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
+	payload.Labels = r.providerData.MergeDefaultLabels(payload.Labels)
+	payload.Name = r.providerData.AddNamePrefix(payload.Name)
 
 	// Create new network
 
@@ -420,7 +441,7 @@ func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest
 	networkId := *network.Id
 	ctx = tflog.SetField(ctx, "network_id", networkId)
 
-	network, err = wait.CreateNetworkWaitHandler(ctx, r.client, projectId, region, networkId).WaitWithContext(ctx)
+	network, err = core.ConfigureWaitHandler(wait.CreateNetworkWaitHandler(ctx, r.client, projectId, region, networkId), r.providerData.Wait, core.WaitCreate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network", fmt.Sprintf("Network creation waiting: %v", err))
 		return
@@ -432,6 +453,8 @@ func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
+	model.Name = r.providerData.RemoveNamePrefix(model.Name)
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -443,24 +466,32 @@ func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest
 
 // Read refreshes the Terraform state with the latest data.
 func (r *networkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 	projectId := model.ProjectId.ValueString()
 	networkId := model.NetworkId.ValueString()
 	region := r.providerData.GetRegionWithOverride(model.Region)
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "network_id", networkId)
 	ctx = tflog.SetField(ctx, "region", region)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
+
+	if utils.SkipReadIfLifecyclePaused(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
 
 	networkResp, err := r.client.GetNetwork(ctx, projectId, region, networkId).Execute()
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_network", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network", fmt.Sprintf("Calling API: %v", err))
@@ -473,6 +504,8 @@ func (r *networkResource) Read(ctx context.Context, req resource.ReadRequest, re
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
+	model.Name = r.providerData.RemoveNamePrefix(model.Name)
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -484,6 +517,8 @@ func (r *networkResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -491,12 +526,14 @@ func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 	projectId := model.ProjectId.ValueString()
 	networkId := model.NetworkId.ValueString()
 	region := r.providerData.GetRegionWithOverride(model.Region)
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "network_id", networkId)
 	ctx = tflog.SetField(ctx, "region", region)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
 
 	// Retrieve values from state
 	var stateModel Model
@@ -512,13 +549,15 @@ func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
+	payload.Labels = r.providerData.MergeDefaultLabels(payload.Labels)
+	payload.Name = r.providerData.AddNamePrefix(payload.Name)
 	// Update existing network
 	err = r.client.PartialUpdateNetwork(ctx, projectId, region, networkId).PartialUpdateNetworkPayload(*payload).Execute()
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	waitResp, err := wait.UpdateNetworkWaitHandler(ctx, r.client, projectId, region, networkId).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandler(wait.UpdateNetworkWaitHandler(ctx, r.client, projectId, region, networkId), r.providerData.Wait, core.WaitUpdate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network", fmt.Sprintf("Network update waiting: %v", err))
 		return
@@ -529,6 +568,8 @@ func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
+	model.Name = r.providerData.RemoveNamePrefix(model.Name)
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -553,6 +594,7 @@ func (r *networkResource) Delete(ctx context.Context, req resource.DeleteRequest
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "network_id", networkId)
 	ctx = tflog.SetField(ctx, "region", region)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
 
 	// Delete existing network
 	err := r.client.DeleteNetwork(ctx, projectId, region, networkId).Execute()
@@ -560,7 +602,7 @@ func (r *networkResource) Delete(ctx context.Context, req resource.DeleteRequest
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteNetworkWaitHandler(ctx, r.client, projectId, region, networkId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.DeleteNetworkWaitHandler(ctx, r.client, projectId, region, networkId), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network", fmt.Sprintf("Network deletion waiting: %v", err))
 		return
@@ -572,12 +614,13 @@ func (r *networkResource) Delete(ctx context.Context, req resource.DeleteRequest
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,region,network_id
 func (r *networkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing network",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[network_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[network_id]  Got: %q", importId),
 		)
 		return
 	}
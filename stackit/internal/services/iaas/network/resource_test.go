@@ -491,6 +491,69 @@ func TestToCreatePayload(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"ipv6_prefix_length_ok",
+			&Model{
+				Name: types.StringValue("name"),
+				IPv6Nameservers: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("ns1"),
+				}),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				}),
+				Routed:           types.BoolValue(false),
+				IPv6PrefixLength: types.Int64Value(64),
+			},
+			&iaas.CreateNetworkPayload{
+				Name: utils.Ptr("name"),
+				Ipv6: &iaas.CreateNetworkIPv6{
+					CreateNetworkIPv6WithPrefixLength: &iaas.CreateNetworkIPv6WithPrefixLength{
+						Nameservers: utils.Ptr([]string{
+							"ns1",
+						}),
+						PrefixLength: utils.Ptr(int64(64)),
+					},
+				},
+				Labels: &map[string]interface{}{
+					"key": "value",
+				},
+				Routed: utils.Ptr(false),
+			},
+			true,
+		},
+		{
+			"dual_stack_prefix_length_ok",
+			&Model{
+				Name: types.StringValue("name"),
+				IPv4Nameservers: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("1.1.1.1"),
+				}),
+				IPv6Nameservers:  types.ListNull(types.StringType),
+				Labels:           types.MapNull(types.StringType),
+				Routed:           types.BoolValue(true),
+				IPv4PrefixLength: types.Int64Value(24),
+				IPv6PrefixLength: types.Int64Value(56),
+			},
+			&iaas.CreateNetworkPayload{
+				Name: utils.Ptr("name"),
+				Ipv4: &iaas.CreateNetworkIPv4{
+					CreateNetworkIPv4WithPrefixLength: &iaas.CreateNetworkIPv4WithPrefixLength{
+						Nameservers: utils.Ptr([]string{
+							"1.1.1.1",
+						}),
+						PrefixLength: utils.Ptr(int64(24)),
+					},
+				},
+				Ipv6: &iaas.CreateNetworkIPv6{
+					CreateNetworkIPv6WithPrefixLength: &iaas.CreateNetworkIPv6WithPrefixLength{
+						PrefixLength: utils.Ptr(int64(56)),
+					},
+				},
+				Labels: &map[string]interface{}{},
+				Routed: utils.Ptr(true),
+			},
+			true,
+		},
 		{
 			"ipv6_nameserver_null",
 			&Model{
@@ -56,9 +56,12 @@ var (
 	_ resource.Resource                   = &networkAreaResource{}
 	_ resource.ResourceWithConfigure      = &networkAreaResource{}
 	_ resource.ResourceWithImportState    = &networkAreaResource{}
+	_ resource.ResourceWithIdentity       = &networkAreaResource{}
 	_ resource.ResourceWithValidateConfig = &networkAreaResource{}
 )
 
+var resourceIdentity = utils.Identity{"organization_id", "network_area_id"}
+
 type Model struct {
 	Id             types.String `tfsdk:"id"` // needed by TF
 	OrganizationId types.String `tfsdk:"organization_id"`
@@ -107,6 +110,7 @@ func NewNetworkAreaResource() resource.Resource {
 type networkAreaResource struct {
 	client                *iaas.APIClient
 	resourceManagerClient *resourcemanager.APIClient
+	providerData          core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -114,19 +118,25 @@ func (r *networkAreaResource) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_network_area"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *networkAreaResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *networkAreaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
 	if !ok {
 		return
 	}
 
-	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	apiClient := iaasUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	r.client = apiClient
-	resourceManagerClient := resourcemanagerUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	resourceManagerClient := resourcemanagerUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -293,12 +303,15 @@ func (r *networkAreaResource) Schema(_ context.Context, _ resource.SchemaRequest
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *networkAreaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model Model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 
 	organizationId := model.OrganizationId.ValueString()
 
@@ -312,6 +325,7 @@ func (r *networkAreaResource) Create(ctx context.Context, req resource.CreateReq
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network area", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
+	payload.Labels = r.providerData.MergeDefaultLabels(payload.Labels)
 
 	// Create new network area
 	networkArea, err := r.client.CreateNetworkArea(ctx, organizationId).CreateNetworkAreaPayload(*payload).Execute()
@@ -341,6 +355,7 @@ func (r *networkAreaResource) Create(ctx context.Context, req resource.CreateReq
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network area", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 
 	// Deprecated: Will be removed in May 2026. Only introduced to make the IaaS v1 -> v2 API migration non-breaking in the Terraform provider.
 	if model.LegacyMode() {
@@ -368,7 +383,7 @@ func (r *networkAreaResource) Create(ctx context.Context, req resource.CreateReq
 		}
 
 		// Deprecated: Will be removed in May 2026. Only introduced to make the IaaS v1 -> v2 API migration non-breaking in the Terraform provider.
-		networkAreaRegionResp, err := wait.CreateNetworkAreaRegionWaitHandler(ctx, r.client, organizationId, networkAreaId, "eu01").WaitWithContext(ctx)
+		networkAreaRegionResp, err := core.ConfigureWaitHandler(wait.CreateNetworkAreaRegionWaitHandler(ctx, r.client, organizationId, networkAreaId, "eu01"), r.providerData.Wait, core.WaitCreate).WaitWithContext(ctx)
 		if err != nil {
 			core.LogAndAddError(ctx, &resp.Diagnostics, "Error waiting for network area region creation", fmt.Sprintf("Calling API: %v", err))
 			return
@@ -400,11 +415,14 @@ func (r *networkAreaResource) Create(ctx context.Context, req resource.CreateReq
 
 // Read refreshes the Terraform state with the latest data.
 func (r *networkAreaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 
 	organizationId := model.OrganizationId.ValueString()
 	networkAreaId := model.NetworkAreaId.ValueString()
@@ -419,7 +437,7 @@ func (r *networkAreaResource) Read(ctx context.Context, req resource.ReadRequest
 		var oapiErr *oapierror.GenericOpenAPIError
 		ok := errors.As(err, &oapiErr)
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_network_area", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network area", fmt.Sprintf("Calling API: %v", err))
@@ -434,6 +452,7 @@ func (r *networkAreaResource) Read(ctx context.Context, req resource.ReadRequest
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network area", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 
 	// Deprecated: Will be removed in May 2026. Only introduced to make the IaaS v1 -> v2 API migration non-breaking in the Terraform provider.
 	if model.LegacyMode() {
@@ -483,12 +502,15 @@ func (r *networkAreaResource) Read(ctx context.Context, req resource.ReadRequest
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *networkAreaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	// Retrieve values from plan
 	var model Model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 
 	organizationId := model.OrganizationId.ValueString()
 	networkAreaId := model.NetworkAreaId.ValueString()
@@ -519,6 +541,7 @@ func (r *networkAreaResource) Update(ctx context.Context, req resource.UpdateReq
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network area", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
+	payload.Labels = r.providerData.MergeDefaultLabels(payload.Labels)
 	// Update existing network
 	networkAreaUpdateResp, err := r.client.PartialUpdateNetworkArea(ctx, organizationId, networkAreaId).PartialUpdateNetworkAreaPayload(*payload).Execute()
 	if err != nil {
@@ -533,6 +556,7 @@ func (r *networkAreaResource) Update(ctx context.Context, req resource.UpdateReq
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network area", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 
 	// Deprecated: Will be removed in May 2026. Only introduced to make the IaaS v1 -> v2 API migration non-breaking in the Terraform provider.
 	if model.LegacyMode() {
@@ -619,7 +643,7 @@ func (r *networkAreaResource) Delete(ctx context.Context, req resource.DeleteReq
 	ctx = tflog.SetField(ctx, "organization_id", organizationId)
 	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
 
-	_, err := wait.ReadyForNetworkAreaDeletionWaitHandler(ctx, r.client, r.resourceManagerClient, organizationId, networkAreaId).WaitWithContext(ctx)
+	_, err := core.ConfigureWaitHandler(wait.ReadyForNetworkAreaDeletionWaitHandler(ctx, r.client, r.resourceManagerClient, organizationId, networkAreaId), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network area", fmt.Sprintf("Network area ready for deletion waiting: %v", err))
 		return
@@ -640,7 +664,7 @@ func (r *networkAreaResource) Delete(ctx context.Context, req resource.DeleteReq
 			return
 		}
 
-		_, err = wait.DeleteNetworkAreaRegionWaitHandler(ctx, r.client, organizationId, networkAreaId, region).WaitWithContext(ctx)
+		_, err = core.ConfigureWaitHandler(wait.DeleteNetworkAreaRegionWaitHandler(ctx, r.client, organizationId, networkAreaId, region), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 		if err != nil {
 			core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network area region", fmt.Sprintf("Waiting for networea deletion: %v", err))
 			return
@@ -662,12 +686,13 @@ func (r *networkAreaResource) Delete(ctx context.Context, req resource.DeleteReq
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,network_id
 func (r *networkAreaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing network area",
-			fmt.Sprintf("Expected import identifier with format: [organization_id],[network_area_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [organization_id],[network_area_id]  Got: %q", importId),
 		)
 		return
 	}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/netip"
 	"strings"
 
 	"github.com/stackitcloud/stackit-sdk-go/services/resourcemanager"
@@ -22,6 +23,7 @@ import (
 
 	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -36,12 +38,16 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &networkAreaRegionResource{}
-	_ resource.ResourceWithConfigure   = &networkAreaRegionResource{}
-	_ resource.ResourceWithImportState = &networkAreaRegionResource{}
-	_ resource.ResourceWithModifyPlan  = &networkAreaRegionResource{}
+	_ resource.Resource                   = &networkAreaRegionResource{}
+	_ resource.ResourceWithConfigure      = &networkAreaRegionResource{}
+	_ resource.ResourceWithImportState    = &networkAreaRegionResource{}
+	_ resource.ResourceWithIdentity       = &networkAreaRegionResource{}
+	_ resource.ResourceWithModifyPlan     = &networkAreaRegionResource{}
+	_ resource.ResourceWithValidateConfig = &networkAreaRegionResource{}
 )
 
+var resourceIdentity = utils.Identity{"organization_id", "network_area_id", "region"}
+
 type Model struct {
 	Id             types.String `tfsdk:"id"` // needed by TF
 	OrganizationId types.String `tfsdk:"organization_id"`
@@ -83,6 +89,11 @@ func (r *networkAreaRegionResource) Metadata(_ context.Context, req resource.Met
 	resp.TypeName = req.ProviderTypeName + "_network_area_region"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *networkAreaRegionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan.
 // Use the modifier to set the effective region in the current plan.
 func (r *networkAreaRegionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
@@ -102,7 +113,7 @@ func (r *networkAreaRegionResource) ModifyPlan(ctx context.Context, req resource
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -113,6 +124,74 @@ func (r *networkAreaRegionResource) ModifyPlan(ctx context.Context, req resource
 	}
 }
 
+// ValidateConfig checks that the network ranges and the transfer network of the network area don't overlap,
+// so that conflicting prefixes are reported at plan time instead of by the API.
+func (r *networkAreaRegionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var networkRanges types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ipv4").AtName("network_ranges"), &networkRanges)...)
+	var transferNetwork types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ipv4").AtName("transfer_network"), &transferNetwork)...)
+	if resp.Diagnostics.HasError() || networkRanges.IsNull() || networkRanges.IsUnknown() {
+		return
+	}
+
+	var prefixes []namedPrefix
+	for i, networkRange := range networkRanges.Elements() {
+		networkRangeObject, ok := networkRange.(types.Object)
+		if !ok || networkRangeObject.IsNull() || networkRangeObject.IsUnknown() {
+			continue
+		}
+		prefix, ok := networkRangeObject.Attributes()["prefix"].(types.String)
+		if !ok || utils.IsUndefined(prefix) {
+			continue
+		}
+		parsedPrefix, err := netip.ParsePrefix(prefix.ValueString())
+		if err != nil {
+			// reported by the validator of the prefix
+			continue
+		}
+		prefixes = append(prefixes, namedPrefix{
+			name:   fmt.Sprintf("network range %d (%s)", i, prefix.ValueString()),
+			prefix: parsedPrefix,
+		})
+	}
+	if !utils.IsUndefined(transferNetwork) {
+		if parsedPrefix, err := netip.ParsePrefix(transferNetwork.ValueString()); err == nil {
+			prefixes = append(prefixes, namedPrefix{
+				name:   fmt.Sprintf("transfer network (%s)", transferNetwork.ValueString()),
+				prefix: parsedPrefix,
+			})
+		}
+	}
+
+	for _, overlap := range overlappingPrefixes(prefixes) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ipv4"),
+			"Overlapping network area prefixes",
+			fmt.Sprintf("The %s overlaps with the %s. The network ranges and the transfer network of a network area must not overlap.", overlap[0], overlap[1]),
+		)
+	}
+}
+
+// namedPrefix is a prefix of a network area with a name to report it in diagnostics
+type namedPrefix struct {
+	name   string
+	prefix netip.Prefix
+}
+
+// overlappingPrefixes returns the names of the pairs of prefixes which overlap, in the given order.
+func overlappingPrefixes(prefixes []namedPrefix) [][2]string {
+	var overlaps [][2]string
+	for i := range prefixes {
+		for j := i + 1; j < len(prefixes); j++ {
+			if prefixes[i].prefix.Overlaps(prefixes[j].prefix) {
+				overlaps = append(overlaps, [2]string{prefixes[i].name, prefixes[j].name})
+			}
+		}
+	}
+	return overlaps
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *networkAreaRegionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	var ok bool
@@ -205,15 +284,21 @@ func (r *networkAreaRegionResource) Schema(_ context.Context, _ resource.SchemaR
 									},
 								},
 								"prefix": schema.StringAttribute{
-									Description: "Classless Inter-Domain Routing (CIDR).",
+									Description: "Classless Inter-Domain Routing (CIDR). Must not overlap with the other network ranges or the transfer network.",
 									Required:    true,
+									Validators: []validator.String{
+										validate.CIDR(),
+									},
 								},
 							},
 						},
 					},
 					"transfer_network": schema.StringAttribute{
-						Description: "IPv4 Classless Inter-Domain Routing (CIDR).",
+						Description: "IPv4 Classless Inter-Domain Routing (CIDR). Must not overlap with the network ranges.",
 						Required:    true,
+						Validators: []validator.String{
+							validate.CIDR(),
+						},
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
 						},
@@ -256,6 +341,8 @@ func (r *networkAreaRegionResource) Schema(_ context.Context, _ resource.SchemaR
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *networkAreaRegionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model Model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
@@ -296,7 +383,7 @@ func (r *networkAreaRegionResource) Create(ctx context.Context, req resource.Cre
 	})
 
 	// wait for creation of network area region to complete
-	_, err = wait.CreateNetworkAreaRegionWaitHandler(ctx, r.client, organizationId, networkAreaId, region).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.CreateNetworkAreaRegionWaitHandler(ctx, r.client, organizationId, networkAreaId, region), r.providerData.Wait, core.WaitCreate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server", fmt.Sprintf("server creation waiting: %v", err))
 		return
@@ -319,6 +406,8 @@ func (r *networkAreaRegionResource) Create(ctx context.Context, req resource.Cre
 
 // Read refreshes the Terraform state with the latest data.
 func (r *networkAreaRegionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
@@ -339,7 +428,7 @@ func (r *networkAreaRegionResource) Read(ctx context.Context, req resource.ReadR
 		var oapiErr *oapierror.GenericOpenAPIError
 		ok := errors.As(err, &oapiErr)
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_network_area_region", model.Id.ValueString())
 			return
 		}
 	}
@@ -363,6 +452,8 @@ func (r *networkAreaRegionResource) Read(ctx context.Context, req resource.ReadR
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *networkAreaRegionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	// Retrieve values from plan
 	var model Model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
@@ -443,7 +534,7 @@ func (r *networkAreaRegionResource) Delete(ctx context.Context, req resource.Del
 	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
 	ctx = tflog.SetField(ctx, "region", region)
 
-	_, err := wait.ReadyForNetworkAreaDeletionWaitHandler(ctx, r.client, r.resourceManagerClient, organizationId, networkAreaId).WaitWithContext(ctx)
+	_, err := core.ConfigureWaitHandler(wait.ReadyForNetworkAreaDeletionWaitHandler(ctx, r.client, r.resourceManagerClient, organizationId, networkAreaId), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network area region", fmt.Sprintf("Network area ready for deletion waiting: %v", err))
 		return
@@ -460,7 +551,7 @@ func (r *networkAreaRegionResource) Delete(ctx context.Context, req resource.Del
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.DeleteNetworkAreaRegionWaitHandler(ctx, r.client, organizationId, networkAreaId, region).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.DeleteNetworkAreaRegionWaitHandler(ctx, r.client, organizationId, networkAreaId, region), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network area region", fmt.Sprintf("network area deletion waiting: %v", err))
 		return
@@ -472,12 +563,13 @@ func (r *networkAreaRegionResource) Delete(ctx context.Context, req resource.Del
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: organization_id,network_area_id,region
 func (r *networkAreaRegionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing network area region",
-			fmt.Sprintf("Expected import identifier with format: [organization_id],[network_area_id],[region]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [organization_id],[network_area_id],[region]  Got: %q", importId),
 		)
 		return
 	}
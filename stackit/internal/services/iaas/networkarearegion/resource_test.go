@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
 	"reflect"
 	"testing"
 
@@ -1050,3 +1051,51 @@ func Test_toNetworkRangesPayload(t *testing.T) {
 		})
 	}
 }
+
+func Test_overlappingPrefixes(t *testing.T) {
+	named := func(name, prefix string) namedPrefix {
+		return namedPrefix{name: name, prefix: netip.MustParsePrefix(prefix)}
+	}
+	tests := []struct {
+		description string
+		input       []namedPrefix
+		expected    [][2]string
+	}{
+		{
+			description: "no overlap",
+			input: []namedPrefix{
+				named("range", "10.0.0.0/16"),
+				named("other range", "10.1.0.0/16"),
+				named("transfer", "192.168.0.0/24"),
+			},
+			expected: nil,
+		},
+		{
+			description: "network ranges overlap",
+			input: []namedPrefix{
+				named("range", "10.0.0.0/16"),
+				named("subrange", "10.0.128.0/24"),
+				named("transfer", "192.168.0.0/24"),
+			},
+			expected: [][2]string{{"range", "subrange"}},
+		},
+		{
+			description: "transfer network overlaps",
+			input: []namedPrefix{
+				named("range", "10.0.0.0/16"),
+				named("other range", "10.1.0.0/16"),
+				named("transfer", "10.1.0.0/24"),
+			},
+			expected: [][2]string{{"other range", "transfer"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := overlappingPrefixes(tt.input)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
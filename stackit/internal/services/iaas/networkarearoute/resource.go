@@ -31,10 +31,13 @@ var (
 	_ resource.Resource                 = &networkAreaRouteResource{}
 	_ resource.ResourceWithConfigure    = &networkAreaRouteResource{}
 	_ resource.ResourceWithImportState  = &networkAreaRouteResource{}
+	_ resource.ResourceWithIdentity     = &networkAreaRouteResource{}
 	_ resource.ResourceWithModifyPlan   = &networkAreaRouteResource{}
 	_ resource.ResourceWithUpgradeState = &networkAreaRouteResource{}
 )
 
+var resourceIdentity = utils.Identity{"organization_id", "network_area_id", "region", "network_area_route_id"}
+
 // ModelV1 is the currently used model
 type ModelV1 struct {
 	Id                 types.String        `tfsdk:"id"` // needed by TF
@@ -86,6 +89,11 @@ func (r *networkAreaRouteResource) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_network_area_route"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *networkAreaRouteResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan.
 // Use the modifier to set the effective region in the current plan.
 func (r *networkAreaRouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
@@ -105,7 +113,7 @@ func (r *networkAreaRouteResource) ModifyPlan(ctx context.Context, req resource.
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -329,6 +337,8 @@ func (r *networkAreaRouteResource) UpgradeState(_ context.Context) map[int64]res
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *networkAreaRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model ModelV1
 	diags := req.Plan.Get(ctx, &model)
@@ -336,6 +346,7 @@ func (r *networkAreaRouteResource) Create(ctx context.Context, req resource.Crea
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 
 	ctx = core.InitProviderContext(ctx)
 
@@ -352,6 +363,9 @@ func (r *networkAreaRouteResource) Create(ctx context.Context, req resource.Crea
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network area route", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
+	for i := range *payload.Items {
+		(*payload.Items)[i].Labels = r.providerData.MergeDefaultLabels((*payload.Items)[i].Labels)
+	}
 
 	// Create new network area route
 	routes, err := r.client.CreateNetworkAreaRoute(ctx, organizationId, networkAreaId, region).CreateNetworkAreaRoutePayload(*payload).Execute()
@@ -385,6 +399,7 @@ func (r *networkAreaRouteResource) Create(ctx context.Context, req resource.Crea
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network area route.", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -396,12 +411,15 @@ func (r *networkAreaRouteResource) Create(ctx context.Context, req resource.Crea
 
 // Read refreshes the Terraform state with the latest data.
 func (r *networkAreaRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model ModelV1
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 	organizationId := model.OrganizationId.ValueString()
 	networkAreaId := model.NetworkAreaId.ValueString()
 	region := r.providerData.GetRegionWithOverride(model.Region)
@@ -418,7 +436,7 @@ func (r *networkAreaRouteResource) Read(ctx context.Context, req resource.ReadRe
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_network_area_route", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network area route.", fmt.Sprintf("Calling API: %v", err))
@@ -433,6 +451,7 @@ func (r *networkAreaRouteResource) Read(ctx context.Context, req resource.ReadRe
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network area route", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -478,6 +497,8 @@ func (r *networkAreaRouteResource) Delete(ctx context.Context, req resource.Dele
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *networkAreaRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	// Retrieve values from plan
 	var model ModelV1
 	diags := req.Plan.Get(ctx, &model)
@@ -485,6 +506,7 @@ func (r *networkAreaRouteResource) Update(ctx context.Context, req resource.Upda
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 
 	organizationId := model.OrganizationId.ValueString()
 	networkAreaId := model.NetworkAreaId.ValueString()
@@ -512,6 +534,7 @@ func (r *networkAreaRouteResource) Update(ctx context.Context, req resource.Upda
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network area route", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
+	payload.Labels = r.providerData.MergeDefaultLabels(payload.Labels)
 	// Update existing network area route
 	networkAreaRouteResp, err := r.client.UpdateNetworkAreaRoute(ctx, organizationId, networkAreaId, region, networkAreaRouteId).UpdateNetworkAreaRoutePayload(*payload).Execute()
 	if err != nil {
@@ -526,6 +549,7 @@ func (r *networkAreaRouteResource) Update(ctx context.Context, req resource.Upda
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network area route", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -537,12 +561,13 @@ func (r *networkAreaRouteResource) Update(ctx context.Context, req resource.Upda
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: organization_id,network_aread_id,network_area_route_id
 func (r *networkAreaRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing network area route",
-			fmt.Sprintf("Expected import identifier with format: [organization_id],[network_area_id],[region],[network_area_route_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [organization_id],[network_area_id],[region],[network_area_route_id]  Got: %q", importId),
 		)
 		return
 	}
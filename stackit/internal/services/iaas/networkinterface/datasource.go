@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/netip"
+	"strings"
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,7 +25,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource = &networkInterfaceDataSource{}
+	_ datasource.DataSource                     = &networkInterfaceDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &networkInterfaceDataSource{}
 )
 
 // NewNetworkInterfaceDataSource is a helper function to simplify the provider implementation.
@@ -55,6 +60,16 @@ func (d *networkInterfaceDataSource) Configure(ctx context.Context, req datasour
 	tflog.Info(ctx, "IaaS client configured")
 }
 
+// ConfigValidators validates the data source configuration
+func (d *networkInterfaceDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("network_interface_id"),
+			path.MatchRoot("ipv4"),
+		),
+	}
+}
+
 // Schema defines the schema for the data source.
 func (d *networkInterfaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	typeOptions := []string{"server", "metadata", "gateway"}
@@ -90,8 +105,9 @@ func (d *networkInterfaceDataSource) Schema(_ context.Context, _ datasource.Sche
 				},
 			},
 			"network_interface_id": schema.StringAttribute{
-				Description: "The network interface ID.",
-				Required:    true,
+				Description: "The network interface ID. Either `network_interface_id` or `ipv4` must be set.",
+				Optional:    true,
+				Computed:    true,
 				Validators: []validator.String{
 					validate.UUID(),
 					validate.NoSeparator(),
@@ -111,8 +127,13 @@ func (d *networkInterfaceDataSource) Schema(_ context.Context, _ datasource.Sche
 				Computed:    true,
 			},
 			"ipv4": schema.StringAttribute{
-				Description: "The IPv4 address.",
-				Computed:    true,
+				Description: "The IPv4 address. If set instead of `network_interface_id`, the network interface with this IPv4 address in the network is looked up, " +
+					"e.g. to find the server behind an IP address via `device`.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					validate.IP(false),
+				},
 			},
 			"labels": schema.MapAttribute{
 				Description: "Labels are key-value string pairs which can be attached to a network interface.",
@@ -153,6 +174,7 @@ func (d *networkInterfaceDataSource) Read(ctx context.Context, req datasource.Re
 	region := d.providerData.GetRegionWithOverride(model.Region)
 	networkId := model.NetworkId.ValueString()
 	networkInterfaceId := model.NetworkInterfaceId.ValueString()
+	ipv4 := model.IPv4.ValueString()
 
 	ctx = core.InitProviderContext(ctx)
 
@@ -160,21 +182,48 @@ func (d *networkInterfaceDataSource) Read(ctx context.Context, req datasource.Re
 	ctx = tflog.SetField(ctx, "region", region)
 	ctx = tflog.SetField(ctx, "network_id", networkId)
 	ctx = tflog.SetField(ctx, "network_interface_id", networkInterfaceId)
+	ctx = tflog.SetField(ctx, "ipv4", ipv4)
 
-	networkInterfaceResp, err := d.client.GetNic(ctx, projectId, region, networkId, networkInterfaceId).Execute()
-	if err != nil {
-		utils.LogError(
-			ctx,
-			&resp.Diagnostics,
-			err,
-			"Reading network interface",
-			fmt.Sprintf("Network interface with ID %q or network with ID %q does not exist in project %q.", networkInterfaceId, networkId, projectId),
-			map[int]string{
-				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
-			},
-		)
-		resp.State.RemoveResource(ctx)
-		return
+	var networkInterfaceResp *iaas.NIC
+	var err error
+	if networkInterfaceId != "" {
+		networkInterfaceResp, err = d.client.GetNic(ctx, projectId, region, networkId, networkInterfaceId).Execute()
+		if err != nil {
+			utils.LogError(
+				ctx,
+				&resp.Diagnostics,
+				err,
+				"Reading network interface",
+				fmt.Sprintf("Network interface with ID %q or network with ID %q does not exist in project %q.", networkInterfaceId, networkId, projectId),
+				map[int]string{
+					http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+				},
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	} else {
+		listResp, err := d.client.ListNics(ctx, projectId, region, networkId).Execute()
+		if err != nil {
+			utils.LogError(
+				ctx,
+				&resp.Diagnostics,
+				err,
+				"Reading network interface",
+				fmt.Sprintf("Network interfaces of network with ID %q in project %q could not be listed.", networkId, projectId),
+				map[int]string{
+					http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+				},
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		networkInterfaceResp, err = findNetworkInterfaceByIPv4(listResp, ipv4)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network interface", fmt.Sprintf("Looking up network interface with IPv4 address %q in network %q: %v", ipv4, networkId, err))
+			return
+		}
 	}
 
 	ctx = core.LogResponse(ctx)
@@ -191,3 +240,33 @@ func (d *networkInterfaceDataSource) Read(ctx context.Context, req datasource.Re
 	}
 	tflog.Info(ctx, "Network interface read")
 }
+
+// findNetworkInterfaceByIPv4 returns the only network interface in the list response, which has the given IPv4 address.
+func findNetworkInterfaceByIPv4(listResp *iaas.NICListResponse, ipv4 string) (*iaas.NIC, error) {
+	if listResp == nil || listResp.Items == nil {
+		return nil, fmt.Errorf("empty list response")
+	}
+	addr, err := netip.ParseAddr(ipv4)
+	if err != nil {
+		return nil, fmt.Errorf("parsing IPv4 address: %w", err)
+	}
+	var matches []iaas.NIC
+	for _, nic := range *listResp.Items {
+		nicAddr, err := netip.ParseAddr(nic.GetIpv4())
+		if err == nil && nicAddr == addr {
+			matches = append(matches, nic)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no network interface found")
+	case 1:
+		return &matches[0], nil
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, nic := range matches {
+			ids = append(ids, nic.GetId())
+		}
+		return nil, fmt.Errorf("found %d network interfaces with this IPv4 address (IDs: %s), use network_interface_id instead", len(matches), strings.Join(ids, ", "))
+	}
+}
@@ -0,0 +1,107 @@
+package networkinterface
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestFindNetworkInterfaceByIPv4(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaas.NICListResponse
+		ipv4        string
+		expected    *iaas.NIC
+		isValid     bool
+	}{
+		{
+			description: "network_interface_found",
+			input: &iaas.NICListResponse{
+				Items: &[]iaas.NIC{
+					{
+						Id:   utils.Ptr("nicid-1"),
+						Ipv4: utils.Ptr("10.0.0.1"),
+					},
+					{
+						Id:     utils.Ptr("nicid-2"),
+						Ipv4:   utils.Ptr("10.0.0.2"),
+						Device: utils.Ptr("server-id"),
+					},
+				},
+			},
+			ipv4: "10.0.0.2",
+			expected: &iaas.NIC{
+				Id:     utils.Ptr("nicid-2"),
+				Ipv4:   utils.Ptr("10.0.0.2"),
+				Device: utils.Ptr("server-id"),
+			},
+			isValid: true,
+		},
+		{
+			description: "network_interface_not_found",
+			input: &iaas.NICListResponse{
+				Items: &[]iaas.NIC{
+					{
+						Id:   utils.Ptr("nicid-1"),
+						Ipv4: utils.Ptr("10.0.0.1"),
+					},
+					{
+						Id: utils.Ptr("nicid-2"),
+					},
+				},
+			},
+			ipv4:    "10.0.0.2",
+			isValid: false,
+		},
+		{
+			description: "multiple_network_interfaces_found",
+			input: &iaas.NICListResponse{
+				Items: &[]iaas.NIC{
+					{
+						Id:   utils.Ptr("nicid-1"),
+						Ipv4: utils.Ptr("10.0.0.1"),
+					},
+					{
+						Id:   utils.Ptr("nicid-2"),
+						Ipv4: utils.Ptr("10.0.0.1"),
+					},
+				},
+			},
+			ipv4:    "10.0.0.1",
+			isValid: false,
+		},
+		{
+			description: "invalid_ipv4",
+			input: &iaas.NICListResponse{
+				Items: &[]iaas.NIC{},
+			},
+			ipv4:    "10.0.0",
+			isValid: false,
+		},
+		{
+			description: "nil_response",
+			input:       nil,
+			ipv4:        "10.0.0.1",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := findNetworkInterfaceByIPv4(tt.input, tt.ipv4)
+			if tt.isValid && err != nil {
+				t.Fatalf("expected success, got error: %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if tt.isValid {
+				if diff := cmp.Diff(tt.expected, output); diff != "" {
+					t.Errorf("unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -29,9 +29,12 @@ var (
 	_ resource.Resource                = &networkInterfaceAttachResource{}
 	_ resource.ResourceWithConfigure   = &networkInterfaceAttachResource{}
 	_ resource.ResourceWithImportState = &networkInterfaceAttachResource{}
+	_ resource.ResourceWithIdentity    = &networkInterfaceAttachResource{}
 	_ resource.ResourceWithModifyPlan  = &networkInterfaceAttachResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "server_id", "network_interface_id"}
+
 type Model struct {
 	Id                 types.String `tfsdk:"id"` // needed by TF
 	ProjectId          types.String `tfsdk:"project_id"`
@@ -56,6 +59,11 @@ func (r *networkInterfaceAttachResource) Metadata(_ context.Context, req resourc
 	resp.TypeName = req.ProviderTypeName + "_server_network_interface_attach"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *networkInterfaceAttachResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan.
 // Use the modifier to set the effective region in the current plan.
 func (r *networkInterfaceAttachResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
@@ -75,7 +83,7 @@ func (r *networkInterfaceAttachResource) ModifyPlan(ctx context.Context, req res
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -164,6 +172,8 @@ func (r *networkInterfaceAttachResource) Schema(_ context.Context, _ resource.Sc
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *networkInterfaceAttachResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -206,6 +216,8 @@ func (r *networkInterfaceAttachResource) Create(ctx context.Context, req resourc
 
 // Read refreshes the Terraform state with the latest data.
 func (r *networkInterfaceAttachResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -227,7 +239,7 @@ func (r *networkInterfaceAttachResource) Read(ctx context.Context, req resource.
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_server_network_interface_attach", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network interface attachment", fmt.Sprintf("Calling API: %v", err))
@@ -263,7 +275,7 @@ func (r *networkInterfaceAttachResource) Read(ctx context.Context, req resource.
 	}
 
 	// no matching network interface was found, the attachment no longer exists
-	resp.State.RemoveResource(ctx)
+	core.RemoveFromStateWithWarning(ctx, resp, "stackit_server_network_interface_attach", model.Id.ValueString())
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -307,12 +319,13 @@ func (r *networkInterfaceAttachResource) Delete(ctx context.Context, req resourc
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,server_id
 func (r *networkInterfaceAttachResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing network_interface attachment",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[server_id],[network_interface_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[server_id],[network_interface_id]  Got: %q", importId),
 		)
 		return
 	}
@@ -0,0 +1,474 @@
+package networkroutingtableattachment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas/wait"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &networkRoutingTableAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &networkRoutingTableAttachmentResource{}
+	_ resource.ResourceWithImportState = &networkRoutingTableAttachmentResource{}
+	_ resource.ResourceWithIdentity    = &networkRoutingTableAttachmentResource{}
+	_ resource.ResourceWithModifyPlan  = &networkRoutingTableAttachmentResource{}
+)
+
+var resourceIdentity = utils.Identity{"project_id", "region", "network_id"}
+
+type Model struct {
+	Id                     types.String `tfsdk:"id"` // needed by TF
+	ProjectId              types.String `tfsdk:"project_id"`
+	Region                 types.String `tfsdk:"region"`
+	NetworkId              types.String `tfsdk:"network_id"`
+	RoutingTableId         types.String `tfsdk:"routing_table_id"`
+	PreviousRoutingTableId types.String `tfsdk:"previous_routing_table_id"`
+}
+
+// NewNetworkRoutingTableAttachmentResource is a helper function to simplify the provider implementation.
+func NewNetworkRoutingTableAttachmentResource() resource.Resource {
+	return &networkRoutingTableAttachmentResource{}
+}
+
+// networkRoutingTableAttachmentResource is the resource implementation.
+type networkRoutingTableAttachmentResource struct {
+	client       *iaas.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *networkRoutingTableAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_routing_table_attachment"
+}
+
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *networkRoutingTableAttachmentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *networkRoutingTableAttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *networkRoutingTableAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *networkRoutingTableAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main": "Attaches a routing table to an existing network, without managing the network itself.",
+		"warning_message": "The `stackit_network_routing_table_attachment` resource should not be used together with the `routing_table_id` attribute of the `stackit_network` resource for the same network. " +
+			"Using both together WILL lead to conflicts, as they both have control of the routing table of the network.",
+		"detach_note": "A network always has a routing table attached. On deletion, the routing table which was attached before this resource was created is attached again. " +
+			"If that routing table is unknown, e.g. after an import, the routing table stays attached to the network.",
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("%s\n\n!> %s\n\n~> %s", descriptions["main"], descriptions["warning_message"], descriptions["detach_note"]),
+		Description:         fmt.Sprintf("%s\n\n%s\n\n%s", descriptions["main"], descriptions["warning_message"], descriptions["detach_note"]),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`network_id`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the network is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "The resource region. If not defined, the provider region is used.",
+				Optional:    true,
+				// must be computed to allow for storing the override value from the provider
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Description: "The network ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"routing_table_id": schema.StringAttribute{
+				Description: "The ID of the routing table which should be attached to the network.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"previous_routing_table_id": schema.StringAttribute{
+				Description: "The ID of the routing table which was attached to the network before this resource was created. It is attached again when this resource is deleted.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *networkRoutingTableAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	networkId := model.NetworkId.ValueString()
+	routingTableId := model.RoutingTableId.ValueString()
+
+	ctx = core.InitProviderContext(ctx)
+
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "network_id", networkId)
+	ctx = tflog.SetField(ctx, "routing_table_id", routingTableId)
+
+	// Remember the currently attached routing table, so it can be restored on deletion
+	networkResp, err := r.client.GetNetwork(ctx, projectId, region, networkId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error attaching routing table to network", fmt.Sprintf("Reading network: %v", err))
+		return
+	}
+	model.PreviousRoutingTableId = types.StringPointerValue(networkResp.RoutingTableId)
+
+	// Generate API request body from model
+	payload, err := toUpdatePayload(&model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error attaching routing table to network", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+	err = r.client.PartialUpdateNetwork(ctx, projectId, region, networkId).PartialUpdateNetworkPayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error attaching routing table to network", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	waitResp, err := core.ConfigureWaitHandler(wait.UpdateNetworkWaitHandler(ctx, r.client, projectId, region, networkId), r.providerData.Wait, core.WaitCreate).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error attaching routing table to network", fmt.Sprintf("Network update waiting: %v", err))
+		return
+	}
+
+	err = mapFields(waitResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error attaching routing table to network", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Routing table attached to network")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *networkRoutingTableAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	networkId := model.NetworkId.ValueString()
+
+	ctx = core.InitProviderContext(ctx)
+
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "network_id", networkId)
+
+	networkResp, err := r.client.GetNetwork(ctx, projectId, region, networkId).Execute()
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_network_routing_table_attachment", model.Id.ValueString())
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network routing table attachment", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	// Map response body to schema
+	err = mapFields(networkResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network routing table attachment", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Network routing table attachment read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *networkRoutingTableAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	networkId := model.NetworkId.ValueString()
+	routingTableId := model.RoutingTableId.ValueString()
+
+	ctx = core.InitProviderContext(ctx)
+
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "network_id", networkId)
+	ctx = tflog.SetField(ctx, "routing_table_id", routingTableId)
+
+	// Generate API request body from model
+	payload, err := toUpdatePayload(&model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network routing table attachment", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+	err = r.client.PartialUpdateNetwork(ctx, projectId, region, networkId).PartialUpdateNetworkPayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network routing table attachment", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	waitResp, err := core.ConfigureWaitHandler(wait.UpdateNetworkWaitHandler(ctx, r.client, projectId, region, networkId), r.providerData.Wait, core.WaitUpdate).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network routing table attachment", fmt.Sprintf("Network update waiting: %v", err))
+		return
+	}
+
+	err = mapFields(waitResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network routing table attachment", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Network routing table attachment updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *networkRoutingTableAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from state
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	networkId := model.NetworkId.ValueString()
+
+	ctx = core.InitProviderContext(ctx)
+
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "network_id", networkId)
+
+	payload := toDeletePayload(&model)
+	if payload == nil {
+		// The API does not allow to detach a routing table without attaching another one
+		tflog.Warn(ctx, "No previous routing table to restore, the routing table stays attached to the network")
+		return
+	}
+
+	err := r.client.PartialUpdateNetwork(ctx, projectId, region, networkId).PartialUpdateNetworkPayload(*payload).Execute()
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, "Network not found, routing table attachment deleted")
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network routing table attachment", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	_, err = core.ConfigureWaitHandler(wait.UpdateNetworkWaitHandler(ctx, r.client, projectId, region, networkId), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network routing table attachment", fmt.Sprintf("Network update waiting: %v", err))
+		return
+	}
+
+	tflog.Info(ctx, "Network routing table attachment deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,region,network_id
+func (r *networkRoutingTableAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing network routing table attachment",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[network_id]  Got: %q", importId),
+		)
+		return
+	}
+
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id": idParts[0],
+		"region":     idParts[1],
+		"network_id": idParts[2],
+	})
+
+	tflog.Info(ctx, "Network routing table attachment state imported")
+}
+
+func mapFields(networkResp *iaas.Network, model *Model, region string) error {
+	if networkResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	var networkId string
+	if model.NetworkId.ValueString() != "" {
+		networkId = model.NetworkId.ValueString()
+	} else if networkResp.Id != nil {
+		networkId = *networkResp.Id
+	} else {
+		return fmt.Errorf("network id not present")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, networkId)
+	model.Region = types.StringValue(region)
+	model.NetworkId = types.StringValue(networkId)
+	model.RoutingTableId = types.StringPointerValue(networkResp.RoutingTableId)
+	if model.PreviousRoutingTableId.IsUnknown() {
+		model.PreviousRoutingTableId = types.StringNull()
+	}
+
+	return nil
+}
+
+func toUpdatePayload(model *Model) (*iaas.PartialUpdateNetworkPayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+
+	return &iaas.PartialUpdateNetworkPayload{
+		RoutingTableId: conversion.StringValueToPointer(model.RoutingTableId),
+	}, nil
+}
+
+// toDeletePayload returns the payload which attaches the previous routing table again.
+// It returns nil if there is nothing to restore.
+func toDeletePayload(model *Model) *iaas.PartialUpdateNetworkPayload {
+	if model == nil || model.PreviousRoutingTableId.ValueString() == "" {
+		return nil
+	}
+	if model.PreviousRoutingTableId.ValueString() == model.RoutingTableId.ValueString() {
+		return nil
+	}
+
+	return &iaas.PartialUpdateNetworkPayload{
+		RoutingTableId: conversion.StringValueToPointer(model.PreviousRoutingTableId),
+	}
+}
@@ -0,0 +1,220 @@
+package networkroutingtableattachment
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapFields(t *testing.T) {
+	type args struct {
+		state  Model
+		input  *iaas.Network
+		region string
+	}
+	tests := []struct {
+		description string
+		args        args
+		expected    Model
+		isValid     bool
+	}{
+		{
+			description: "default_values",
+			args: args{
+				state: Model{
+					ProjectId: types.StringValue("pid"),
+					NetworkId: types.StringValue("nid"),
+				},
+				input: &iaas.Network{
+					Id: utils.Ptr("nid"),
+				},
+				region: "eu01",
+			},
+			expected: Model{
+				Id:             types.StringValue("pid,eu01,nid"),
+				ProjectId:      types.StringValue("pid"),
+				Region:         types.StringValue("eu01"),
+				NetworkId:      types.StringValue("nid"),
+				RoutingTableId: types.StringNull(),
+			},
+			isValid: true,
+		},
+		{
+			description: "simple_values",
+			args: args{
+				state: Model{
+					ProjectId:              types.StringValue("pid"),
+					NetworkId:              types.StringValue("nid"),
+					PreviousRoutingTableId: types.StringValue("prtid"),
+				},
+				input: &iaas.Network{
+					Id:             utils.Ptr("nid"),
+					RoutingTableId: utils.Ptr("rtid"),
+				},
+				region: "eu02",
+			},
+			expected: Model{
+				Id:                     types.StringValue("pid,eu02,nid"),
+				ProjectId:              types.StringValue("pid"),
+				Region:                 types.StringValue("eu02"),
+				NetworkId:              types.StringValue("nid"),
+				RoutingTableId:         types.StringValue("rtid"),
+				PreviousRoutingTableId: types.StringValue("prtid"),
+			},
+			isValid: true,
+		},
+		{
+			description: "unknown_previous_routing_table",
+			args: args{
+				state: Model{
+					ProjectId:              types.StringValue("pid"),
+					NetworkId:              types.StringValue("nid"),
+					PreviousRoutingTableId: types.StringUnknown(),
+				},
+				input: &iaas.Network{
+					Id:             utils.Ptr("nid"),
+					RoutingTableId: utils.Ptr("rtid"),
+				},
+				region: "eu01",
+			},
+			expected: Model{
+				Id:                     types.StringValue("pid,eu01,nid"),
+				ProjectId:              types.StringValue("pid"),
+				Region:                 types.StringValue("eu01"),
+				NetworkId:              types.StringValue("nid"),
+				RoutingTableId:         types.StringValue("rtid"),
+				PreviousRoutingTableId: types.StringNull(),
+			},
+			isValid: true,
+		},
+		{
+			description: "response_nil_fail",
+			args: args{
+				state: Model{},
+				input: nil,
+			},
+			isValid: false,
+		},
+		{
+			description: "no_network_id",
+			args: args{
+				state: Model{
+					ProjectId: types.StringValue("pid"),
+				},
+				input: &iaas.Network{},
+			},
+			isValid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.args.input, &tt.args.state, tt.args.region)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.args.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToUpdatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *iaas.PartialUpdateNetworkPayload
+		isValid     bool
+	}{
+		{
+			description: "default_ok",
+			input: &Model{
+				RoutingTableId: types.StringValue("rtid"),
+			},
+			expected: &iaas.PartialUpdateNetworkPayload{
+				RoutingTableId: utils.Ptr("rtid"),
+			},
+			isValid: true,
+		},
+		{
+			description: "nil_model",
+			input:       nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toUpdatePayload(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToDeletePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *iaas.PartialUpdateNetworkPayload
+	}{
+		{
+			description: "restore_previous",
+			input: &Model{
+				RoutingTableId:         types.StringValue("rtid"),
+				PreviousRoutingTableId: types.StringValue("prtid"),
+			},
+			expected: &iaas.PartialUpdateNetworkPayload{
+				RoutingTableId: utils.Ptr("prtid"),
+			},
+		},
+		{
+			description: "previous_unknown",
+			input: &Model{
+				RoutingTableId:         types.StringValue("rtid"),
+				PreviousRoutingTableId: types.StringNull(),
+			},
+			expected: nil,
+		},
+		{
+			description: "previous_equals_current",
+			input: &Model{
+				RoutingTableId:         types.StringValue("rtid"),
+				PreviousRoutingTableId: types.StringValue("rtid"),
+			},
+			expected: nil,
+		},
+		{
+			description: "nil_model",
+			input:       nil,
+			expected:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := toDeletePayload(tt.input)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
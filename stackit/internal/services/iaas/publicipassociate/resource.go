@@ -29,9 +29,12 @@ var (
 	_ resource.Resource                = &publicIpAssociateResource{}
 	_ resource.ResourceWithConfigure   = &publicIpAssociateResource{}
 	_ resource.ResourceWithImportState = &publicIpAssociateResource{}
+	_ resource.ResourceWithIdentity    = &publicIpAssociateResource{}
 	_ resource.ResourceWithModifyPlan  = &publicIpAssociateResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "public_ip_id", "network_interface_id"}
+
 type Model struct {
 	Id                 types.String `tfsdk:"id"` // needed by TF
 	ProjectId          types.String `tfsdk:"project_id"`
@@ -57,6 +60,11 @@ func (r *publicIpAssociateResource) Metadata(_ context.Context, req resource.Met
 	resp.TypeName = req.ProviderTypeName + "_public_ip_associate"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *publicIpAssociateResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan.
 // Use the modifier to set the effective region in the current plan.
 func (r *publicIpAssociateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
@@ -76,7 +84,7 @@ func (r *publicIpAssociateResource) ModifyPlan(ctx context.Context, req resource
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -185,6 +193,8 @@ func (r *publicIpAssociateResource) Schema(_ context.Context, _ resource.SchemaR
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *publicIpAssociateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -234,6 +244,8 @@ func (r *publicIpAssociateResource) Create(ctx context.Context, req resource.Cre
 
 // Read refreshes the Terraform state with the latest data.
 func (r *publicIpAssociateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -256,7 +268,7 @@ func (r *publicIpAssociateResource) Read(ctx context.Context, req resource.ReadR
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_public_ip_associate", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading public IP association", fmt.Sprintf("Calling API: %v", err))
@@ -325,12 +337,13 @@ func (r *publicIpAssociateResource) Delete(ctx context.Context, req resource.Del
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,public_ip_id
 func (r *publicIpAssociateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing public IP associate",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[public_ip_id],[network_interface_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[public_ip_id],[network_interface_id]  Got: %q", importId),
 		)
 		return
 	}
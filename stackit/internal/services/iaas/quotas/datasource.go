@@ -0,0 +1,301 @@
+package quotas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &quotasDataSource{}
+)
+
+type Model struct {
+	Id                 types.String `tfsdk:"id"` // needed by TF
+	ProjectId          types.String `tfsdk:"project_id"`
+	Region             types.String `tfsdk:"region"`
+	BackupGigabytes    types.Object `tfsdk:"backup_gigabytes"`
+	Backups            types.Object `tfsdk:"backups"`
+	Gigabytes          types.Object `tfsdk:"gigabytes"`
+	Networks           types.Object `tfsdk:"networks"`
+	Nics               types.Object `tfsdk:"nics"`
+	PublicIps          types.Object `tfsdk:"public_ips"`
+	Ram                types.Object `tfsdk:"ram"`
+	SecurityGroupRules types.Object `tfsdk:"security_group_rules"`
+	SecurityGroups     types.Object `tfsdk:"security_groups"`
+	Snapshots          types.Object `tfsdk:"snapshots"`
+	Vcpu               types.Object `tfsdk:"vcpu"`
+	Volumes            types.Object `tfsdk:"volumes"`
+}
+
+var quotaTypes = map[string]attr.Type{
+	"limit":     types.Int64Type,
+	"usage":     types.Int64Type,
+	"available": types.Int64Type,
+}
+
+// NewQuotasDataSource is a helper function to simplify the provider implementation.
+func NewQuotasDataSource() datasource.DataSource {
+	return &quotasDataSource{}
+}
+
+// quotasDataSource is the data source implementation.
+type quotasDataSource struct {
+	client       *iaas.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the data source type name.
+func (d *quotasDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iaas_quotas"
+}
+
+func (d *quotasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	d.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &d.providerData, &resp.Diagnostics, "stackit_iaas_quotas", "datasource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &d.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// quotaAttribute returns the schema of a single quota.
+func quotaAttribute(resource, unit string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: fmt.Sprintf("Quota of the %s.", resource),
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"limit": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum %s of the project in the region.", unit),
+				Computed:    true,
+			},
+			"usage": schema.Int64Attribute{
+				Description: fmt.Sprintf("Current %s of the project in the region.", unit),
+				Computed:    true,
+			},
+			"available": schema.Int64Attribute{
+				Description: fmt.Sprintf("Remaining %s until the limit is reached, i.e. `limit` - `usage`.", unit),
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *quotasDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Quotas data source. Lists the IaaS quotas of a project in a region, e.g. to check at plan time that the servers and volumes of a configuration fit into the remaining quotas. " +
+		"Quota increases can't be requested through the API, they have to be requested from the STACKIT support."
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: features.AddBetaDescription(description, core.Datasource),
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`region`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "The resource region. If not defined, the provider region is used.",
+				Optional:    true,
+			},
+			"backup_gigabytes":     quotaAttribute("total size of backups", "size of backups in GB"),
+			"backups":              quotaAttribute("number of backups", "number of backups"),
+			"gigabytes":            quotaAttribute("total size of volumes", "size of volumes in GB"),
+			"networks":             quotaAttribute("number of networks", "number of networks"),
+			"nics":                 quotaAttribute("number of network interfaces", "number of network interfaces"),
+			"public_ips":           quotaAttribute("number of public IPs", "number of public IPs"),
+			"ram":                  quotaAttribute("RAM of servers", "RAM of servers in MB"),
+			"security_group_rules": quotaAttribute("number of security group rules", "number of security group rules"),
+			"security_groups":      quotaAttribute("number of security groups", "number of security groups"),
+			"snapshots":            quotaAttribute("number of snapshots", "number of snapshots"),
+			"vcpu":                 quotaAttribute("vCPUs of servers", "number of vCPUs"),
+			"volumes":              quotaAttribute("number of volumes", "number of volumes"),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *quotasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := d.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	quotasResp, err := d.client.ListQuotasExecute(ctx, projectId, region)
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading quotas",
+			fmt.Sprintf("Unable to retrieve quotas for project %q in region %q.", projectId, region),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	// Map response body to schema
+	err = mapFields(quotasResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading quotas", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "read quotas")
+}
+
+func mapFields(quotasResp *iaas.QuotaListResponse, model *Model, region string) error {
+	if quotasResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if quotasResp.Quotas == nil {
+		return fmt.Errorf("quotas are missing in the response")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region)
+	model.Region = types.StringValue(region)
+
+	quotas := quotasResp.Quotas
+	values := map[string]quotaValue{}
+	if q, ok := quotas.GetBackupGigabytesOk(); ok {
+		values["backup_gigabytes"] = quotaValue{q.Limit, q.Usage}
+	}
+	if q, ok := quotas.GetBackupsOk(); ok {
+		values["backups"] = quotaValue{q.Limit, q.Usage}
+	}
+	if q, ok := quotas.GetGigabytesOk(); ok {
+		values["gigabytes"] = quotaValue{q.Limit, q.Usage}
+	}
+	if q, ok := quotas.GetNetworksOk(); ok {
+		values["networks"] = quotaValue{q.Limit, q.Usage}
+	}
+	if q, ok := quotas.GetNicsOk(); ok {
+		values["nics"] = quotaValue{q.Limit, q.Usage}
+	}
+	if q, ok := quotas.GetPublicIpsOk(); ok {
+		values["public_ips"] = quotaValue{q.Limit, q.Usage}
+	}
+	if q, ok := quotas.GetRamOk(); ok {
+		values["ram"] = quotaValue{q.Limit, q.Usage}
+	}
+	if q, ok := quotas.GetSecurityGroupRulesOk(); ok {
+		values["security_group_rules"] = quotaValue{q.Limit, q.Usage}
+	}
+	if q, ok := quotas.GetSecurityGroupsOk(); ok {
+		values["security_groups"] = quotaValue{q.Limit, q.Usage}
+	}
+	if q, ok := quotas.GetSnapshotsOk(); ok {
+		values["snapshots"] = quotaValue{q.Limit, q.Usage}
+	}
+	if q, ok := quotas.GetVcpuOk(); ok {
+		values["vcpu"] = quotaValue{q.Limit, q.Usage}
+	}
+	if q, ok := quotas.GetVolumesOk(); ok {
+		values["volumes"] = quotaValue{q.Limit, q.Usage}
+	}
+
+	targets := map[string]*types.Object{
+		"backup_gigabytes":     &model.BackupGigabytes,
+		"backups":              &model.Backups,
+		"gigabytes":            &model.Gigabytes,
+		"networks":             &model.Networks,
+		"nics":                 &model.Nics,
+		"public_ips":           &model.PublicIps,
+		"ram":                  &model.Ram,
+		"security_group_rules": &model.SecurityGroupRules,
+		"security_groups":      &model.SecurityGroups,
+		"snapshots":            &model.Snapshots,
+		"vcpu":                 &model.Vcpu,
+		"volumes":              &model.Volumes,
+	}
+	for name, target := range targets {
+		value, ok := values[name]
+		if !ok {
+			*target = types.ObjectNull(quotaTypes)
+			continue
+		}
+		quotaTF, err := value.toObject()
+		if err != nil {
+			return fmt.Errorf("mapping quota %q: %w", name, err)
+		}
+		*target = quotaTF
+	}
+	return nil
+}
+
+// quotaValue is the limit and usage of a single quota, as each quota has its own type in the SDK.
+type quotaValue struct {
+	limit *int64
+	usage *int64
+}
+
+func (q quotaValue) toObject() (types.Object, error) {
+	if q.limit == nil || q.usage == nil {
+		return types.ObjectNull(quotaTypes), fmt.Errorf("limit or usage is missing")
+	}
+	quotaTF, diags := types.ObjectValue(quotaTypes, map[string]attr.Value{
+		"limit":     types.Int64Value(*q.limit),
+		"usage":     types.Int64Value(*q.usage),
+		"available": types.Int64Value(*q.limit - *q.usage),
+	})
+	if diags.HasError() {
+		return types.ObjectNull(quotaTypes), core.DiagsToError(diags)
+	}
+	return quotaTF, nil
+}
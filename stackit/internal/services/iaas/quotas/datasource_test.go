@@ -0,0 +1,135 @@
+package quotas
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapFields(t *testing.T) {
+	quota := func(limit, usage int64) types.Object {
+		return types.ObjectValueMust(quotaTypes, map[string]attr.Value{
+			"limit":     types.Int64Value(limit),
+			"usage":     types.Int64Value(usage),
+			"available": types.Int64Value(limit - usage),
+		})
+	}
+	nullQuota := types.ObjectNull(quotaTypes)
+
+	tests := []struct {
+		description string
+		input       *iaas.QuotaListResponse
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_ok",
+			&iaas.QuotaListResponse{
+				Quotas: &iaas.QuotaList{
+					BackupGigabytes:    &iaas.QuotaListBackupGigabytes{Limit: utils.Ptr(int64(1000)), Usage: utils.Ptr(int64(100))},
+					Backups:            &iaas.QuotaListBackups{Limit: utils.Ptr(int64(50)), Usage: utils.Ptr(int64(5))},
+					Gigabytes:          &iaas.QuotaListGigabytes{Limit: utils.Ptr(int64(2000)), Usage: utils.Ptr(int64(640))},
+					Networks:           &iaas.QuotaListNetworks{Limit: utils.Ptr(int64(10)), Usage: utils.Ptr(int64(2))},
+					Nics:               &iaas.QuotaListNics{Limit: utils.Ptr(int64(100)), Usage: utils.Ptr(int64(12))},
+					PublicIps:          &iaas.QuotaListPublicIps{Limit: utils.Ptr(int64(10)), Usage: utils.Ptr(int64(3))},
+					Ram:                &iaas.QuotaListRam{Limit: utils.Ptr(int64(204800)), Usage: utils.Ptr(int64(65536))},
+					SecurityGroupRules: &iaas.QuotaListSecurityGroupRules{Limit: utils.Ptr(int64(500)), Usage: utils.Ptr(int64(40))},
+					SecurityGroups:     &iaas.QuotaListSecurityGroups{Limit: utils.Ptr(int64(50)), Usage: utils.Ptr(int64(4))},
+					Snapshots:          &iaas.QuotaListSnapshots{Limit: utils.Ptr(int64(50)), Usage: utils.Ptr(int64(0))},
+					Vcpu:               &iaas.QuotaListVcpu{Limit: utils.Ptr(int64(100)), Usage: utils.Ptr(int64(24))},
+					Volumes:            &iaas.QuotaListVolumes{Limit: utils.Ptr(int64(100)), Usage: utils.Ptr(int64(12))},
+				},
+			},
+			Model{
+				Id:                 types.StringValue("pid,eu01"),
+				ProjectId:          types.StringValue("pid"),
+				Region:             types.StringValue("eu01"),
+				BackupGigabytes:    quota(1000, 100),
+				Backups:            quota(50, 5),
+				Gigabytes:          quota(2000, 640),
+				Networks:           quota(10, 2),
+				Nics:               quota(100, 12),
+				PublicIps:          quota(10, 3),
+				Ram:                quota(204800, 65536),
+				SecurityGroupRules: quota(500, 40),
+				SecurityGroups:     quota(50, 4),
+				Snapshots:          quota(50, 0),
+				Vcpu:               quota(100, 24),
+				Volumes:            quota(100, 12),
+			},
+			true,
+		},
+		{
+			"missing_quotas",
+			&iaas.QuotaListResponse{
+				Quotas: &iaas.QuotaList{
+					Vcpu: &iaas.QuotaListVcpu{Limit: utils.Ptr(int64(100)), Usage: utils.Ptr(int64(110))},
+				},
+			},
+			Model{
+				Id:                 types.StringValue("pid,eu01"),
+				ProjectId:          types.StringValue("pid"),
+				Region:             types.StringValue("eu01"),
+				BackupGigabytes:    nullQuota,
+				Backups:            nullQuota,
+				Gigabytes:          nullQuota,
+				Networks:           nullQuota,
+				Nics:               nullQuota,
+				PublicIps:          nullQuota,
+				Ram:                nullQuota,
+				SecurityGroupRules: nullQuota,
+				SecurityGroups:     nullQuota,
+				Snapshots:          nullQuota,
+				Vcpu:               quota(100, 110),
+				Volumes:            nullQuota,
+			},
+			true,
+		},
+		{
+			"missing_limit",
+			&iaas.QuotaListResponse{
+				Quotas: &iaas.QuotaList{
+					Vcpu: &iaas.QuotaListVcpu{Usage: utils.Ptr(int64(24))},
+				},
+			},
+			Model{},
+			false,
+		},
+		{
+			"no_quotas",
+			&iaas.QuotaListResponse{},
+			Model{},
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				ProjectId: types.StringValue("pid"),
+			}
+			err := mapFields(tt.input, model, "eu01")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(*model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -105,7 +105,7 @@ func (d *securityGroupRuleDataSource) Schema(_ context.Context, _ datasource.Sch
 				Computed:    true,
 			},
 			"ether_type": schema.StringAttribute{
-				Description: "The ethertype which the rule should match.",
+				Description: "The ethertype which the rule should match. " + utils.FormatPossibleValues(etherTypeOptions...),
 				Computed:    true,
 			},
 			"icmp_parameters": schema.SingleNestedAttribute{
@@ -2,12 +2,10 @@ package securitygrouprule
 
 import (
 	"context"
-	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 )
 
 // UseNullForUnknownBasedOnProtocolModifier returns a plan modifier that sets a null
@@ -70,13 +68,14 @@ func (m useNullForUnknownBasedOnProtocolModifier) PlanModifyObject(ctx context.C
 		return
 	}
 
-	protocolName := conversion.StringValueToPointer(protocol.Name)
-
-	if protocolName == nil {
+	if protocol.Name.IsUnknown() || protocol.Number.IsUnknown() {
+		return
+	}
+	if protocol.Name.IsNull() && protocol.Number.IsNull() {
 		return
 	}
 
-	if slices.Contains(icmpProtocols, *protocolName) {
+	if isIcmpProtocol(protocol) {
 		if model.PortRange.IsUnknown() {
 			resp.PlanValue = types.ObjectNull(portRangeTypes)
 			return
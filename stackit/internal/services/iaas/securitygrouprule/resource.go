@@ -37,15 +37,20 @@ var (
 	_ resource.Resource                = &securityGroupRuleResource{}
 	_ resource.ResourceWithConfigure   = &securityGroupRuleResource{}
 	_ resource.ResourceWithImportState = &securityGroupRuleResource{}
+	_ resource.ResourceWithIdentity    = &securityGroupRuleResource{}
 	_ resource.ResourceWithModifyPlan  = &securityGroupRuleResource{}
 
 	icmpProtocols           = []string{"icmp", "ipv6-icmp"}
+	icmpProtocolNumbers     = []int64{1, 58}
+	etherTypeOptions        = []string{"IPv4", "IPv6"}
 	protocolsPossibleValues = []string{
 		"ah", "dccp", "egp", "esp", "gre", "icmp", "igmp", "ipip", "ipv6-encap", "ipv6-frag", "ipv6-icmp",
 		"ipv6-nonxt", "ipv6-opts", "ipv6-route", "ospf", "pgm", "rsvp", "sctp", "tcp", "udp", "udplite", "vrrp",
 	}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "security_group_id", "security_group_rule_id"}
+
 type Model struct {
 	Id                    types.String `tfsdk:"id"` // needed by TF
 	ProjectId             types.String `tfsdk:"project_id"`
@@ -111,6 +116,11 @@ func (r *securityGroupRuleResource) Metadata(_ context.Context, req resource.Met
 	resp.TypeName = req.ProviderTypeName + "_security_group_rule"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *securityGroupRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan.
 // Use the modifier to set the effective region in the current plan.
 func (r *securityGroupRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
@@ -130,7 +140,7 @@ func (r *securityGroupRuleResource) ModifyPlan(ctx context.Context, req resource
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -166,6 +176,22 @@ func (r *securityGroupRuleResource) ValidateConfig(ctx context.Context, req reso
 		return
 	}
 
+	if !(model.PortRange.IsNull() || model.PortRange.IsUnknown()) {
+		portRange := &portRangeModel{}
+		diags := model.PortRange.As(ctx, portRange, basetypes.ObjectAsOptions{})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := validatePortRange(portRange); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("port_range"),
+				"Invalid attribute configuration",
+				err.Error(),
+			)
+		}
+	}
+
 	// If protocol is not configured, return without error.
 	if model.Protocol.IsNull() || model.Protocol.IsUnknown() {
 		return
@@ -178,13 +204,14 @@ func (r *securityGroupRuleResource) ValidateConfig(ctx context.Context, req reso
 		return
 	}
 
-	protocolName := conversion.StringValueToPointer(protocol.Name)
-
-	if protocolName == nil {
+	if protocol.Name.IsUnknown() || protocol.Number.IsUnknown() {
+		return
+	}
+	if protocol.Name.IsNull() && protocol.Number.IsNull() {
 		return
 	}
 
-	if slices.Contains(icmpProtocols, *protocolName) {
+	if isIcmpProtocol(protocol) {
 		if !(model.PortRange.IsNull() || model.PortRange.IsUnknown()) {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("port_range"),
@@ -203,6 +230,34 @@ func (r *securityGroupRuleResource) ValidateConfig(ctx context.Context, req reso
 	}
 }
 
+// isIcmpProtocol returns whether the protocol is ICMP or ICMPv6, either by name or by number.
+func isIcmpProtocol(protocol *protocolModel) bool {
+	if protocol == nil {
+		return false
+	}
+	if name := conversion.StringValueToPointer(protocol.Name); name != nil {
+		return slices.Contains(icmpProtocols, *name)
+	}
+	if number := conversion.Int64ValueToPointer(protocol.Number); number != nil {
+		return slices.Contains(icmpProtocolNumbers, *number)
+	}
+	return false
+}
+
+// validatePortRange checks that the minimum port isn't greater than the maximum port.
+func validatePortRange(portRange *portRangeModel) error {
+	if portRange == nil {
+		return nil
+	}
+	if portRange.Min.IsNull() || portRange.Min.IsUnknown() || portRange.Max.IsNull() || portRange.Max.IsUnknown() {
+		return nil
+	}
+	if portRange.Min.ValueInt64() > portRange.Max.ValueInt64() {
+		return fmt.Errorf("`port_range.min` (%d) must be less or equal to `port_range.max` (%d)", portRange.Min.ValueInt64(), portRange.Max.ValueInt64())
+	}
+	return nil
+}
+
 // Schema defines the schema for the resource.
 func (r *securityGroupRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	directionOptions := []string{"ingress", "egress"}
@@ -279,18 +334,24 @@ func (r *securityGroupRuleResource) Schema(_ context.Context, _ resource.SchemaR
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(directionOptions...),
+				},
 			},
 			"ether_type": schema.StringAttribute{
-				Description: "The ethertype which the rule should match.",
+				Description: "The ethertype which the rule should match. If not set, `IPv4` is used. " + utils.FormatPossibleValues(etherTypeOptions...),
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(etherTypeOptions...),
+				},
 			},
 			"icmp_parameters": schema.SingleNestedAttribute{
-				Description: "ICMP Parameters. These parameters should only be provided if the protocol is ICMP.",
+				Description: "ICMP Parameters. These parameters should only be provided if the protocol is ICMP (`icmp`/`1`) or ICMPv6 (`ipv6-icmp`/`58`).",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Object{
@@ -335,7 +396,7 @@ func (r *securityGroupRuleResource) Schema(_ context.Context, _ resource.SchemaR
 				},
 			},
 			"port_range": schema.SingleNestedAttribute{
-				Description: "The range of ports. This should only be provided if the protocol is not ICMP.",
+				Description: "The range of ports. This should only be provided if the protocol is not ICMP. To match a single port, set `min` and `max` to the same value.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Object{
@@ -425,6 +486,8 @@ func (r *securityGroupRuleResource) Schema(_ context.Context, _ resource.SchemaR
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *securityGroupRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -507,6 +570,8 @@ func (r *securityGroupRuleResource) Create(ctx context.Context, req resource.Cre
 
 // Read refreshes the Terraform state with the latest data.
 func (r *securityGroupRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -529,7 +594,7 @@ func (r *securityGroupRuleResource) Read(ctx context.Context, req resource.ReadR
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_security_group_rule", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading security group rule", fmt.Sprintf("Calling API: %v", err))
@@ -596,12 +661,13 @@ func (r *securityGroupRuleResource) Delete(ctx context.Context, req resource.Del
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,security_group_id, security_group_rule_id
 func (r *securityGroupRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing security group rule",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[security_group_id],[security_group_rule_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[security_group_id],[security_group_rule_id]  Got: %q", importId),
 		)
 		return
 	}
@@ -320,3 +320,138 @@ func TestToCreatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestIsIcmpProtocol(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *protocolModel
+		expected    bool
+	}{
+		{
+			"icmp_name",
+			&protocolModel{
+				Name:   types.StringValue("icmp"),
+				Number: types.Int64Null(),
+			},
+			true,
+		},
+		{
+			"icmpv6_name",
+			&protocolModel{
+				Name:   types.StringValue("ipv6-icmp"),
+				Number: types.Int64Null(),
+			},
+			true,
+		},
+		{
+			"icmp_number",
+			&protocolModel{
+				Name:   types.StringNull(),
+				Number: types.Int64Value(1),
+			},
+			true,
+		},
+		{
+			"icmpv6_number",
+			&protocolModel{
+				Name:   types.StringNull(),
+				Number: types.Int64Value(58),
+			},
+			true,
+		},
+		{
+			"tcp_name",
+			&protocolModel{
+				Name:   types.StringValue("tcp"),
+				Number: types.Int64Null(),
+			},
+			false,
+		},
+		{
+			"tcp_number",
+			&protocolModel{
+				Name:   types.StringNull(),
+				Number: types.Int64Value(6),
+			},
+			false,
+		},
+		{
+			"empty_protocol",
+			&protocolModel{
+				Name:   types.StringNull(),
+				Number: types.Int64Null(),
+			},
+			false,
+		},
+		{
+			"nil_protocol",
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := isIcmpProtocol(tt.input)
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestValidatePortRange(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *portRangeModel
+		isValid     bool
+	}{
+		{
+			"range_ok",
+			&portRangeModel{
+				Min: types.Int64Value(80),
+				Max: types.Int64Value(443),
+			},
+			true,
+		},
+		{
+			"single_port_ok",
+			&portRangeModel{
+				Min: types.Int64Value(22),
+				Max: types.Int64Value(22),
+			},
+			true,
+		},
+		{
+			"unknown_port_ok",
+			&portRangeModel{
+				Min: types.Int64Value(80),
+				Max: types.Int64Unknown(),
+			},
+			true,
+		},
+		{
+			"nil_ok",
+			nil,
+			true,
+		},
+		{
+			"min_greater_than_max",
+			&portRangeModel{
+				Min: types.Int64Value(443),
+				Max: types.Int64Value(80),
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := validatePortRange(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
@@ -173,4 +173,26 @@ resource "stackit_server" "user-data-from-file" {
   keypair_name = stackit_key_pair.keypair.name
   user_data    = file("${path.module}/cloud-init.yaml")
 }
+` + "\n```" + `
+
+### Server with labels readable by cloud-init` + "\n" +
+	"```terraform" + `
+resource "stackit_server" "cloud-init-labels" {
+  project_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  boot_volume = {
+    size        = 64
+    source_type = "image"
+    source_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  }
+  name         = "example-server"
+  machine_type = "g2i.1"
+  keypair_name = stackit_key_pair.keypair.name
+  labels = {
+    env  = "prod"
+    role = "web"
+  }
+  # Copied to the metadata of the server, so that cloud-init can read them
+  metadata_labels = ["env", "role"]
+  user_data       = file("${path.module}/cloud-init.yaml")
+}
 ` + "\n```"
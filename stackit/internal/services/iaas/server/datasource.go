@@ -45,6 +45,7 @@ type DataSourceModel struct {
 	CreatedAt         types.String `tfsdk:"created_at"`
 	LaunchedAt        types.String `tfsdk:"launched_at"`
 	UpdatedAt         types.String `tfsdk:"updated_at"`
+	MaintenanceWindow types.Object `tfsdk:"maintenance_window"`
 }
 
 var bootVolumeDataTypes = map[string]attr.Type{
@@ -179,6 +180,28 @@ func (d *serverDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Description: "Date-time when the server was updated",
 				Computed:    true,
 			},
+			"maintenance_window": schema.SingleNestedAttribute{
+				Description: "The next maintenance window of the server planned by STACKIT, if any.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"starts_at": schema.StringAttribute{
+						Description: "Start of the maintenance window.",
+						Computed:    true,
+					},
+					"ends_at": schema.StringAttribute{
+						Description: "End of the maintenance window.",
+						Computed:    true,
+					},
+					"status": schema.StringAttribute{
+						Description: "Status of the maintenance window, e.g. `PLANNED` or `ONGOING`.",
+						Computed:    true,
+					},
+					"details": schema.StringAttribute{
+						Description: "Details of the maintenance.",
+						Computed:    true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -321,5 +344,11 @@ func mapDataSourceFields(ctx context.Context, serverResp *iaas.Server, model *Da
 	model.UpdatedAt = updatedAt
 	model.LaunchedAt = launchedAt
 
+	maintenanceWindow, err := mapMaintenanceWindow(serverResp.MaintenanceWindow)
+	if err != nil {
+		return fmt.Errorf("mapping maintenance window: %w", err)
+	}
+	model.MaintenanceWindow = maintenanceWindow
+
 	return nil
 }
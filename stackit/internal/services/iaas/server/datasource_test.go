@@ -51,6 +51,7 @@ func TestMapDataSourceFields(t *testing.T) {
 				UpdatedAt:         types.StringNull(),
 				LaunchedAt:        types.StringNull(),
 				Region:            types.StringValue("eu01"),
+				MaintenanceWindow: types.ObjectNull(maintenanceWindowTypes),
 			},
 			isValid: true,
 		},
@@ -84,6 +85,11 @@ func TestMapDataSourceFields(t *testing.T) {
 					UpdatedAt:     utils.Ptr(testTimestamp()),
 					LaunchedAt:    utils.Ptr(testTimestamp()),
 					Status:        utils.Ptr("active"),
+					MaintenanceWindow: &iaas.ServerMaintenance{
+						StartsAt: utils.Ptr(testTimestamp()),
+						EndsAt:   utils.Ptr(testTimestamp()),
+						Status:   utils.Ptr("PLANNED"),
+					},
 				},
 				region: "eu02",
 			},
@@ -107,6 +113,12 @@ func TestMapDataSourceFields(t *testing.T) {
 				UpdatedAt:     types.StringValue(testTimestampValue),
 				LaunchedAt:    types.StringValue(testTimestampValue),
 				Region:        types.StringValue("eu02"),
+				MaintenanceWindow: types.ObjectValueMust(maintenanceWindowTypes, map[string]attr.Value{
+					"starts_at": types.StringValue(testTimestampValue),
+					"ends_at":   types.StringValue(testTimestampValue),
+					"status":    types.StringValue("PLANNED"),
+					"details":   types.StringNull(),
+				}),
 			},
 			isValid: true,
 		},
@@ -139,6 +151,7 @@ func TestMapDataSourceFields(t *testing.T) {
 				UpdatedAt:         types.StringNull(),
 				LaunchedAt:        types.StringNull(),
 				Region:            types.StringValue("eu01"),
+				MaintenanceWindow: types.ObjectNull(maintenanceWindowTypes),
 			},
 			isValid: true,
 		},
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+)
+
+// The IaaS API only reports the next maintenance window planned by STACKIT, it has no maintenance preferences.
+// The preferences are therefore stored as labels of the server, so that patch orchestration tooling can read them.
+const (
+	maintenanceWindowLabel     = "maintenance-window"
+	maintenanceAutoRebootLabel = "maintenance-auto-reboot"
+)
+
+// maintenanceWindowRegex matches weekly maintenance windows like "sun-0200-0400". Label values can't contain colons or spaces.
+var maintenanceWindowRegex = regexp.MustCompile(`^(mon|tue|wed|thu|fri|sat|sun)-([01]\d|2[0-3])[0-5]\d-([01]\d|2[0-3])[0-5]\d$`)
+
+// Struct corresponding to Model.MaintenancePreferences
+type maintenancePreferencesModel struct {
+	Window     types.String `tfsdk:"window"`
+	AutoReboot types.Bool   `tfsdk:"auto_reboot"`
+}
+
+// Types corresponding to maintenancePreferencesModel
+var maintenancePreferencesTypes = map[string]attr.Type{
+	"window":      basetypes.StringType{},
+	"auto_reboot": basetypes.BoolType{},
+}
+
+// Types corresponding to Model.MaintenanceWindow
+var maintenanceWindowTypes = map[string]attr.Type{
+	"starts_at": basetypes.StringType{},
+	"ends_at":   basetypes.StringType{},
+	"status":    basetypes.StringType{},
+	"details":   basetypes.StringType{},
+}
+
+// toMaintenanceLabels returns the labels storing the maintenance preferences.
+func toMaintenanceLabels(ctx context.Context, preferences types.Object) (map[string]interface{}, error) {
+	labels := map[string]interface{}{}
+	if preferences.IsNull() || preferences.IsUnknown() {
+		return labels, nil
+	}
+
+	var model maintenancePreferencesModel
+	diags := preferences.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, fmt.Errorf("converting maintenance preferences: %w", core.DiagsToError(diags))
+	}
+	if !model.Window.IsNull() && !model.Window.IsUnknown() {
+		labels[maintenanceWindowLabel] = model.Window.ValueString()
+	}
+	if !model.AutoReboot.IsNull() && !model.AutoReboot.IsUnknown() {
+		labels[maintenanceAutoRebootLabel] = strconv.FormatBool(model.AutoReboot.ValueBool())
+	}
+	return labels, nil
+}
+
+// toMaintenanceLabelsPartialUpdate returns the labels storing the maintenance preferences for a partial update.
+// Labels of preferences which are no longer set are nil, so that they are removed.
+func toMaintenanceLabelsPartialUpdate(ctx context.Context, current, desired types.Object) (map[string]interface{}, error) {
+	currentLabels, err := toMaintenanceLabels(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+	labels, err := toMaintenanceLabels(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	for k := range currentLabels {
+		if _, ok := labels[k]; !ok {
+			labels[k] = nil
+		}
+	}
+	return labels, nil
+}
+
+// splitMaintenanceLabels removes the labels storing the maintenance preferences from the labels of the server
+// and returns the preferences separately.
+func splitMaintenanceLabels(labels, priorLabels types.Map) (types.Map, types.Object, error) {
+	if labels.IsNull() || labels.IsUnknown() {
+		return labels, types.ObjectNull(maintenancePreferencesTypes), nil
+	}
+
+	window := types.StringNull()
+	autoReboot := types.BoolNull()
+	elements := map[string]attr.Value{}
+	for k, v := range labels.Elements() {
+		value, ok := v.(types.String)
+		if !ok {
+			return labels, types.ObjectNull(maintenancePreferencesTypes), fmt.Errorf("label %q is not a string", k)
+		}
+		switch k {
+		case maintenanceWindowLabel:
+			window = value
+		case maintenanceAutoRebootLabel:
+			parsed, err := strconv.ParseBool(value.ValueString())
+			if err != nil {
+				return labels, types.ObjectNull(maintenancePreferencesTypes), fmt.Errorf("parsing label %q: %w", k, err)
+			}
+			autoReboot = types.BoolValue(parsed)
+		default:
+			elements[k] = v
+		}
+	}
+
+	preferences := types.ObjectNull(maintenancePreferencesTypes)
+	if !window.IsNull() || !autoReboot.IsNull() {
+		var diags diag.Diagnostics
+		preferences, diags = types.ObjectValue(maintenancePreferencesTypes, map[string]attr.Value{
+			"window":      window,
+			"auto_reboot": autoReboot,
+		})
+		if diags.HasError() {
+			return labels, preferences, core.DiagsToError(diags)
+		}
+	}
+
+	if len(elements) == 0 && priorLabels.IsNull() {
+		return types.MapNull(types.StringType), preferences, nil
+	}
+	return types.MapValueMust(types.StringType, elements), preferences, nil
+}
+
+// mapMaintenanceWindow maps the next maintenance window planned by STACKIT.
+func mapMaintenanceWindow(maintenance *iaas.ServerMaintenance) (types.Object, error) {
+	if maintenance == nil {
+		return types.ObjectNull(maintenanceWindowTypes), nil
+	}
+
+	startsAt := types.StringNull()
+	if maintenance.StartsAt != nil {
+		startsAt = types.StringValue(maintenance.StartsAt.Format(time.RFC3339))
+	}
+	endsAt := types.StringNull()
+	if maintenance.EndsAt != nil {
+		endsAt = types.StringValue(maintenance.EndsAt.Format(time.RFC3339))
+	}
+	maintenanceWindow, diags := types.ObjectValue(maintenanceWindowTypes, map[string]attr.Value{
+		"starts_at": startsAt,
+		"ends_at":   endsAt,
+		"status":    types.StringPointerValue(maintenance.Status),
+		"details":   types.StringPointerValue(maintenance.Details),
+	})
+	if diags.HasError() {
+		return types.ObjectNull(maintenanceWindowTypes), core.DiagsToError(diags)
+	}
+	return maintenanceWindow, nil
+}
@@ -0,0 +1,198 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func maintenancePreferences(window types.String, autoReboot types.Bool) types.Object {
+	return types.ObjectValueMust(maintenancePreferencesTypes, map[string]attr.Value{
+		"window":      window,
+		"auto_reboot": autoReboot,
+	})
+}
+
+func TestToMaintenanceLabelsPartialUpdate(t *testing.T) {
+	tests := []struct {
+		description string
+		current     types.Object
+		desired     types.Object
+		expected    map[string]interface{}
+	}{
+		{
+			description: "no preferences",
+			current:     types.ObjectNull(maintenancePreferencesTypes),
+			desired:     types.ObjectNull(maintenancePreferencesTypes),
+			expected:    map[string]interface{}{},
+		},
+		{
+			description: "set preferences",
+			current:     types.ObjectNull(maintenancePreferencesTypes),
+			desired:     maintenancePreferences(types.StringValue("sun-0200-0400"), types.BoolValue(true)),
+			expected: map[string]interface{}{
+				maintenanceWindowLabel:     "sun-0200-0400",
+				maintenanceAutoRebootLabel: "true",
+			},
+		},
+		{
+			description: "remove one preference",
+			current:     maintenancePreferences(types.StringValue("sun-0200-0400"), types.BoolValue(false)),
+			desired:     maintenancePreferences(types.StringValue("sat-2200-2359"), types.BoolNull()),
+			expected: map[string]interface{}{
+				maintenanceWindowLabel:     "sat-2200-2359",
+				maintenanceAutoRebootLabel: nil,
+			},
+		},
+		{
+			description: "remove all preferences",
+			current:     maintenancePreferences(types.StringValue("sun-0200-0400"), types.BoolValue(false)),
+			desired:     types.ObjectNull(maintenancePreferencesTypes),
+			expected: map[string]interface{}{
+				maintenanceWindowLabel:     nil,
+				maintenanceAutoRebootLabel: nil,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toMaintenanceLabelsPartialUpdate(context.Background(), tt.current, tt.desired)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSplitMaintenanceLabels(t *testing.T) {
+	tests := []struct {
+		description         string
+		labels              types.Map
+		priorLabels         types.Map
+		expectedLabels      types.Map
+		expectedPreferences types.Object
+		isValid             bool
+	}{
+		{
+			description:         "no labels",
+			labels:              types.MapNull(types.StringType),
+			priorLabels:         types.MapNull(types.StringType),
+			expectedLabels:      types.MapNull(types.StringType),
+			expectedPreferences: types.ObjectNull(maintenancePreferencesTypes),
+			isValid:             true,
+		},
+		{
+			description: "only maintenance labels",
+			labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+				maintenanceWindowLabel:     types.StringValue("sun-0200-0400"),
+				maintenanceAutoRebootLabel: types.StringValue("true"),
+			}),
+			priorLabels:         types.MapNull(types.StringType),
+			expectedLabels:      types.MapNull(types.StringType),
+			expectedPreferences: maintenancePreferences(types.StringValue("sun-0200-0400"), types.BoolValue(true)),
+			isValid:             true,
+		},
+		{
+			description: "other labels",
+			labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"key":                  types.StringValue("value"),
+				maintenanceWindowLabel: types.StringValue("sun-0200-0400"),
+			}),
+			priorLabels: types.MapNull(types.StringType),
+			expectedLabels: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"key": types.StringValue("value"),
+			}),
+			expectedPreferences: maintenancePreferences(types.StringValue("sun-0200-0400"), types.BoolNull()),
+			isValid:             true,
+		},
+		{
+			description: "empty prior labels are kept",
+			labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+				maintenanceAutoRebootLabel: types.StringValue("false"),
+			}),
+			priorLabels:         types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			expectedLabels:      types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			expectedPreferences: maintenancePreferences(types.StringNull(), types.BoolValue(false)),
+			isValid:             true,
+		},
+		{
+			description: "invalid auto reboot label",
+			labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+				maintenanceAutoRebootLabel: types.StringValue("sometimes"),
+			}),
+			priorLabels: types.MapNull(types.StringType),
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			labels, preferences, err := splitMaintenanceLabels(tt.labels, tt.priorLabels)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(labels, tt.expectedLabels)
+				if diff != "" {
+					t.Fatalf("Labels do not match: %s", diff)
+				}
+				diff = cmp.Diff(preferences, tt.expectedPreferences)
+				if diff != "" {
+					t.Fatalf("Maintenance preferences do not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestMapMaintenanceWindow(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaas.ServerMaintenance
+		expected    types.Object
+	}{
+		{
+			description: "no maintenance planned",
+			input:       nil,
+			expected:    types.ObjectNull(maintenanceWindowTypes),
+		},
+		{
+			description: "planned maintenance",
+			input: &iaas.ServerMaintenance{
+				StartsAt: utils.Ptr(testTimestamp()),
+				EndsAt:   utils.Ptr(testTimestamp()),
+				Status:   utils.Ptr("PLANNED"),
+				Details:  utils.Ptr("hypervisor update"),
+			},
+			expected: types.ObjectValueMust(maintenanceWindowTypes, map[string]attr.Value{
+				"starts_at": types.StringValue(testTimestampValue),
+				"ends_at":   types.StringValue(testTimestampValue),
+				"status":    types.StringValue("PLANNED"),
+				"details":   types.StringValue("hypervisor update"),
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := mapMaintenanceWindow(tt.input)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+)
+
+// The IaaS API separates the labels of a server from its metadata, and only the metadata is visible to cloud-init.
+// Model.MetadataLabels selects the labels whose values are copied to the metadata, so that bootstrap scripts can read them.
+
+// metadataLabelKeys returns the keys of the metadata labels.
+func metadataLabelKeys(ctx context.Context, metadataLabels types.Set) ([]string, error) {
+	var keys []string
+	if metadataLabels.IsNull() || metadataLabels.IsUnknown() {
+		return keys, nil
+	}
+	diags := metadataLabels.ElementsAs(ctx, &keys, false)
+	if diags.HasError() {
+		return nil, fmt.Errorf("converting metadata labels: %w", core.DiagsToError(diags))
+	}
+	return keys, nil
+}
+
+// missingMetadataLabels returns the keys of metadata labels which aren't set in the labels.
+func missingMetadataLabels(ctx context.Context, labels types.Map, metadataLabels types.Set) ([]string, error) {
+	keys, err := metadataLabelKeys(ctx, metadataLabels)
+	if err != nil {
+		return nil, err
+	}
+	elements := labels.Elements()
+	var missing []string
+	for _, k := range keys {
+		if _, ok := elements[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+	return missing, nil
+}
+
+// toMetadata returns the metadata of the server, i.e. the values of the labels selected by the metadata labels.
+func toMetadata(ctx context.Context, labels types.Map, metadataLabels types.Set) (map[string]interface{}, error) {
+	metadata := map[string]interface{}{}
+	keys, err := metadataLabelKeys(ctx, metadataLabels)
+	if err != nil {
+		return nil, err
+	}
+	elements := labels.Elements()
+	for _, k := range keys {
+		value, ok := elements[k].(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			return nil, fmt.Errorf("metadata label %q is not set in the labels", k)
+		}
+		metadata[k] = value.ValueString()
+	}
+	return metadata, nil
+}
+
+// toMetadataPartialUpdate returns the metadata of the server for a partial update.
+// Metadata of labels which are no longer selected is nil, so that it is removed.
+func toMetadataPartialUpdate(ctx context.Context, currentMetadataLabels types.Set, labels types.Map, metadataLabels types.Set) (map[string]interface{}, error) {
+	metadata, err := toMetadata(ctx, labels, metadataLabels)
+	if err != nil {
+		return nil, err
+	}
+	currentKeys, err := metadataLabelKeys(ctx, currentMetadataLabels)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range currentKeys {
+		if _, ok := metadata[k]; !ok {
+			metadata[k] = nil
+		}
+	}
+	return metadata, nil
+}
+
+// mapMetadataLabels returns the metadata labels whose values are still in sync with the metadata of the server.
+// Labels missing from the metadata or with a different value are dropped, so that the next apply syncs them again.
+func mapMetadataLabels(metadata *map[string]interface{}, labels types.Map, priorMetadataLabels types.Set) types.Set {
+	if priorMetadataLabels.IsNull() || priorMetadataLabels.IsUnknown() {
+		return types.SetNull(types.StringType)
+	}
+
+	var actual map[string]interface{}
+	if metadata != nil {
+		actual = *metadata
+	}
+	labelElements := labels.Elements()
+	elements := []attr.Value{}
+	for _, v := range priorMetadataLabels.Elements() {
+		key, ok := v.(types.String)
+		if !ok {
+			continue
+		}
+		value, ok := actual[key.ValueString()].(string)
+		if !ok {
+			continue
+		}
+		label, ok := labelElements[key.ValueString()].(types.String)
+		if !ok || label.ValueString() != value {
+			continue
+		}
+		elements = append(elements, key)
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func metadataLabels(keys ...string) types.Set {
+	elements := []attr.Value{}
+	for _, k := range keys {
+		elements = append(elements, types.StringValue(k))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+var fixtureLabels = types.MapValueMust(types.StringType, map[string]attr.Value{
+	"env":  types.StringValue("prod"),
+	"role": types.StringValue("web"),
+})
+
+func TestToMetadataPartialUpdate(t *testing.T) {
+	tests := []struct {
+		description    string
+		current        types.Set
+		labels         types.Map
+		metadataLabels types.Set
+		expected       map[string]interface{}
+		isValid        bool
+	}{
+		{
+			description:    "no metadata labels",
+			current:        types.SetNull(types.StringType),
+			labels:         fixtureLabels,
+			metadataLabels: types.SetNull(types.StringType),
+			expected:       map[string]interface{}{},
+			isValid:        true,
+		},
+		{
+			description:    "set metadata labels",
+			current:        types.SetNull(types.StringType),
+			labels:         fixtureLabels,
+			metadataLabels: metadataLabels("env", "role"),
+			expected: map[string]interface{}{
+				"env":  "prod",
+				"role": "web",
+			},
+			isValid: true,
+		},
+		{
+			description:    "remove one metadata label",
+			current:        metadataLabels("env", "role"),
+			labels:         fixtureLabels,
+			metadataLabels: metadataLabels("env"),
+			expected: map[string]interface{}{
+				"env":  "prod",
+				"role": nil,
+			},
+			isValid: true,
+		},
+		{
+			description:    "remove all metadata labels",
+			current:        metadataLabels("env"),
+			labels:         types.MapNull(types.StringType),
+			metadataLabels: types.SetNull(types.StringType),
+			expected: map[string]interface{}{
+				"env": nil,
+			},
+			isValid: true,
+		},
+		{
+			description:    "metadata label not in labels",
+			current:        types.SetNull(types.StringType),
+			labels:         fixtureLabels,
+			metadataLabels: metadataLabels("team"),
+			isValid:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toMetadataPartialUpdate(context.Background(), tt.current, tt.labels, tt.metadataLabels)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestMapMetadataLabels(t *testing.T) {
+	tests := []struct {
+		description string
+		metadata    *map[string]interface{}
+		prior       types.Set
+		expected    types.Set
+	}{
+		{
+			description: "no metadata labels",
+			metadata: &map[string]interface{}{
+				"env": "prod",
+			},
+			prior:    types.SetNull(types.StringType),
+			expected: types.SetNull(types.StringType),
+		},
+		{
+			description: "in sync",
+			metadata: &map[string]interface{}{
+				"env":   "prod",
+				"role":  "web",
+				"other": "value",
+			},
+			prior:    metadataLabels("env", "role"),
+			expected: metadataLabels("env", "role"),
+		},
+		{
+			description: "value changed outside terraform",
+			metadata: &map[string]interface{}{
+				"env":  "dev",
+				"role": "web",
+			},
+			prior:    metadataLabels("env", "role"),
+			expected: metadataLabels("role"),
+		},
+		{
+			description: "metadata removed outside terraform",
+			metadata:    nil,
+			prior:       metadataLabels("env"),
+			expected:    metadataLabels(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := mapMetadataLabels(tt.metadata, fixtureLabels, tt.prior)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
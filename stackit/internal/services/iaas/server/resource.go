@@ -12,7 +12,9 @@ import (
 	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -42,12 +44,15 @@ var (
 	_ resource.Resource                = &serverResource{}
 	_ resource.ResourceWithConfigure   = &serverResource{}
 	_ resource.ResourceWithImportState = &serverResource{}
+	_ resource.ResourceWithIdentity    = &serverResource{}
 	_ resource.ResourceWithModifyPlan  = &serverResource{}
 
 	supportedSourceTypes = []string{"volume", "image"}
 	desiredStatusOptions = []string{modelStateActive, modelStateInactive, modelStateDeallocated}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "server_id"}
+
 const (
 	modelStateActive      = "active"
 	modelStateInactive    = "inactive"
@@ -67,12 +72,18 @@ type Model struct {
 	NetworkInterfaces types.List   `tfsdk:"network_interfaces"`
 	KeypairName       types.String `tfsdk:"keypair_name"`
 	Labels            types.Map    `tfsdk:"labels"`
+	MetadataLabels    types.Set    `tfsdk:"metadata_labels"`
 	AffinityGroup     types.String `tfsdk:"affinity_group"`
 	UserData          types.String `tfsdk:"user_data"`
 	CreatedAt         types.String `tfsdk:"created_at"`
 	LaunchedAt        types.String `tfsdk:"launched_at"`
 	UpdatedAt         types.String `tfsdk:"updated_at"`
 	DesiredStatus     types.String `tfsdk:"desired_status"`
+	LifecyclePaused   types.Bool   `tfsdk:"lifecycle_paused"`
+	AuthProfile       types.String `tfsdk:"auth_profile"`
+	// Stored as labels of the server, see maintenance.go
+	MaintenancePreferences types.Object `tfsdk:"maintenance_preferences"`
+	MaintenanceWindow      types.Object `tfsdk:"maintenance_window"`
 }
 
 // Struct corresponding to Model.BootVolume
@@ -111,6 +122,11 @@ func (r *serverResource) Metadata(_ context.Context, req resource.MetadataReques
 	resp.TypeName = req.ProviderTypeName + "_server"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *serverResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan.
 // Use the modifier to set the effective region in the current plan.
 func (r *serverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
@@ -130,7 +146,7 @@ func (r *serverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -163,6 +179,15 @@ func (r *serverResource) ValidateConfig(ctx context.Context, req resource.Valida
 		}
 	}
 
+	if !model.Labels.IsUnknown() && !model.MetadataLabels.IsUnknown() {
+		missing, err := missingMetadataLabels(ctx, model.Labels, model.MetadataLabels)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring server", err.Error())
+		} else if len(missing) > 0 {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring server", fmt.Sprintf("The `metadata_labels` %q aren't set in `labels`.", missing))
+		}
+	}
+
 	if model.NetworkInterfaces.IsNull() || model.NetworkInterfaces.IsUnknown() || len(model.NetworkInterfaces.Elements()) < 1 {
 		core.LogAndAddWarning(ctx, &resp.Diagnostics, "No network interfaces configured", "You have no network interfaces configured for this server. This will be a problem when you want to (re-)create this server. Please note that modifying the network interfaces for an existing server will result in a replacement of the resource. We will provide a clear migration path soon.")
 	}
@@ -372,9 +397,62 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 			"labels": schema.MapAttribute{
-				Description: "Labels are key-value string pairs which can be attached to a resource container",
+				Description: fmt.Sprintf("Labels are key-value string pairs which can be attached to a resource container. The labels `%s` and `%s` are reserved for `maintenance_preferences`.", maintenanceWindowLabel, maintenanceAutoRebootLabel),
 				ElementType: types.StringType,
 				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.NoneOf(maintenanceWindowLabel, maintenanceAutoRebootLabel)),
+				},
+			},
+			"metadata_labels": schema.SetAttribute{
+				Description: "Keys of `labels` whose values are also set as metadata of the server. Unlike labels, the metadata can be read by cloud-init from the metadata service, e.g. to bootstrap the server based on its environment or role.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"maintenance_preferences": schema.SingleNestedAttribute{
+				Description: fmt.Sprintf("Maintenance preferences of the server, e.g. for patch orchestration tooling. The API has no maintenance preferences, so they are stored as the labels `%s` and `%s` of the server.", maintenanceWindowLabel, maintenanceAutoRebootLabel),
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"window": schema.StringAttribute{
+						Description: "Weekly maintenance window in the format `<day>-<HHMM>-<HHMM>`, e.g. `sun-0200-0400`. Days are `mon`, `tue`, `wed`, `thu`, `fri`, `sat` and `sun`.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(maintenanceWindowRegex, "must be a weekly maintenance window like sun-0200-0400"),
+						},
+					},
+					"auto_reboot": schema.BoolAttribute{
+						Description: "Whether the server may be rebooted automatically during the maintenance window.",
+						Optional:    true,
+					},
+				},
+			},
+			"maintenance_window": schema.SingleNestedAttribute{
+				Description: "The next maintenance window of the server planned by STACKIT, if any.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"starts_at": schema.StringAttribute{
+						Description: "Start of the maintenance window.",
+						Computed:    true,
+					},
+					"ends_at": schema.StringAttribute{
+						Description: "End of the maintenance window.",
+						Computed:    true,
+					},
+					"status": schema.StringAttribute{
+						Description: "Status of the maintenance window, e.g. `PLANNED` or `ONGOING`.",
+						Computed:    true,
+					},
+					"details": schema.StringAttribute{
+						Description: "Details of the maintenance.",
+						Computed:    true,
+					},
+				},
 			},
 			"affinity_group": schema.StringAttribute{
 				Description: "The affinity group the server is assigned to.",
@@ -423,6 +501,8 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					desiredStateModifier{},
 				},
 			},
+			"lifecycle_paused": utils.LifecyclePausedAttribute(),
+			"auth_profile":     utils.AuthProfileAttribute(),
 		},
 	}
 }
@@ -466,6 +546,8 @@ func (d desiredStateModifier) PlanModifyString(ctx context.Context, req planmodi
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -473,6 +555,7 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 
 	projectId := model.ProjectId.ValueString()
 	region := r.providerData.GetRegionWithOverride(model.Region)
@@ -480,6 +563,7 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 	ctx = tflog.SetField(ctx, "region", region)
 
 	ctx = core.InitProviderContext(ctx)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
 
 	// Generate API request body from model
 	payload, err := toCreatePayload(ctx, &model)
@@ -487,6 +571,7 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
+	payload.Labels = r.providerData.MergeDefaultLabels(payload.Labels)
 
 	// Create new server
 
@@ -499,7 +584,7 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 	ctx = core.LogResponse(ctx)
 
 	serverId := *server.Id
-	_, err = wait.CreateServerWaitHandler(ctx, r.client, projectId, region, serverId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.CreateServerWaitHandler(ctx, r.client, projectId, region, serverId), r.providerData.Wait, core.WaitCreate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server", fmt.Sprintf("server creation waiting: %v", err))
 		return
@@ -520,8 +605,9 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 
-	if err := updateServerStatus(ctx, r.client, server.Status, &model, region); err != nil {
+	if err := updateServerStatus(ctx, r.client, r.providerData.Wait, server.Status, &model, region); err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server", fmt.Sprintf("update server state: %v", err))
 		return
 	}
@@ -544,36 +630,36 @@ type serverControlClient interface {
 	DeallocateServerExecute(ctx context.Context, projectId string, region string, serverId string) error
 }
 
-func startServer(ctx context.Context, client serverControlClient, projectId, region, serverId string) error {
+func startServer(ctx context.Context, client serverControlClient, waitSettings core.WaitSettings, projectId, region, serverId string) error {
 	tflog.Debug(ctx, "starting server to enter active state")
 	if err := client.StartServerExecute(ctx, projectId, region, serverId); err != nil {
 		return fmt.Errorf("cannot start server: %w", err)
 	}
-	_, err := wait.StartServerWaitHandler(ctx, client, projectId, region, serverId).WaitWithContext(ctx)
+	_, err := core.ConfigureWaitHandler(wait.StartServerWaitHandler(ctx, client, projectId, region, serverId), waitSettings, core.WaitUpdate).WaitWithContext(ctx)
 	if err != nil {
 		return fmt.Errorf("cannot check started server: %w", err)
 	}
 	return nil
 }
 
-func stopServer(ctx context.Context, client serverControlClient, projectId, region, serverId string) error {
+func stopServer(ctx context.Context, client serverControlClient, waitSettings core.WaitSettings, projectId, region, serverId string) error {
 	tflog.Debug(ctx, "stopping server to enter inactive state")
 	if err := client.StopServerExecute(ctx, projectId, region, serverId); err != nil {
 		return fmt.Errorf("cannot stop server: %w", err)
 	}
-	_, err := wait.StopServerWaitHandler(ctx, client, projectId, region, serverId).WaitWithContext(ctx)
+	_, err := core.ConfigureWaitHandler(wait.StopServerWaitHandler(ctx, client, projectId, region, serverId), waitSettings, core.WaitUpdate).WaitWithContext(ctx)
 	if err != nil {
 		return fmt.Errorf("cannot check stopped server: %w", err)
 	}
 	return nil
 }
 
-func deallocateServer(ctx context.Context, client serverControlClient, projectId, region, serverId string) error {
+func deallocateServer(ctx context.Context, client serverControlClient, waitSettings core.WaitSettings, projectId, region, serverId string) error {
 	tflog.Debug(ctx, "deallocating server to enter shelved state")
 	if err := client.DeallocateServerExecute(ctx, projectId, region, serverId); err != nil {
 		return fmt.Errorf("cannot deallocate server: %w", err)
 	}
-	_, err := wait.DeallocateServerWaitHandler(ctx, client, projectId, region, serverId).WaitWithContext(ctx)
+	_, err := core.ConfigureWaitHandler(wait.DeallocateServerWaitHandler(ctx, client, projectId, region, serverId), waitSettings, core.WaitUpdate).WaitWithContext(ctx)
 	if err != nil {
 		return fmt.Errorf("cannot check deallocated server: %w", err)
 	}
@@ -581,7 +667,7 @@ func deallocateServer(ctx context.Context, client serverControlClient, projectId
 }
 
 // updateServerStatus applies the appropriate server state changes for the actual current and the intended state
-func updateServerStatus(ctx context.Context, client serverControlClient, currentState *string, model *Model, region string) error {
+func updateServerStatus(ctx context.Context, client serverControlClient, waitSettings core.WaitSettings, currentState *string, model *Model, region string) error {
 	if currentState == nil {
 		tflog.Warn(ctx, "no current state available, not updating server state")
 		return nil
@@ -590,13 +676,13 @@ func updateServerStatus(ctx context.Context, client serverControlClient, current
 	case wait.ServerActiveStatus:
 		switch strings.ToUpper(model.DesiredStatus.ValueString()) {
 		case wait.ServerInactiveStatus:
-			if err := stopServer(ctx, client, model.ProjectId.ValueString(), region, model.ServerId.ValueString()); err != nil {
+			if err := stopServer(ctx, client, waitSettings, model.ProjectId.ValueString(), region, model.ServerId.ValueString()); err != nil {
 				return err
 			}
 
 		case wait.ServerDeallocatedStatus:
 
-			if err := deallocateServer(ctx, client, model.ProjectId.ValueString(), region, model.ServerId.ValueString()); err != nil {
+			if err := deallocateServer(ctx, client, waitSettings, model.ProjectId.ValueString(), region, model.ServerId.ValueString()); err != nil {
 				return err
 			}
 		default:
@@ -608,11 +694,11 @@ func updateServerStatus(ctx context.Context, client serverControlClient, current
 	case wait.ServerInactiveStatus:
 		switch strings.ToUpper(model.DesiredStatus.ValueString()) {
 		case wait.ServerActiveStatus:
-			if err := startServer(ctx, client, model.ProjectId.ValueString(), region, model.ServerId.ValueString()); err != nil {
+			if err := startServer(ctx, client, waitSettings, model.ProjectId.ValueString(), region, model.ServerId.ValueString()); err != nil {
 				return err
 			}
 		case wait.ServerDeallocatedStatus:
-			if err := deallocateServer(ctx, client, model.ProjectId.ValueString(), region, model.ServerId.ValueString()); err != nil {
+			if err := deallocateServer(ctx, client, waitSettings, model.ProjectId.ValueString(), region, model.ServerId.ValueString()); err != nil {
 				return err
 			}
 
@@ -625,12 +711,12 @@ func updateServerStatus(ctx context.Context, client serverControlClient, current
 	case wait.ServerDeallocatedStatus:
 		switch strings.ToUpper(model.DesiredStatus.ValueString()) {
 		case wait.ServerActiveStatus:
-			if err := startServer(ctx, client, model.ProjectId.ValueString(), region, model.ServerId.ValueString()); err != nil {
+			if err := startServer(ctx, client, waitSettings, model.ProjectId.ValueString(), region, model.ServerId.ValueString()); err != nil {
 				return err
 			}
 
 		case wait.ServerInactiveStatus:
-			if err := stopServer(ctx, client, model.ProjectId.ValueString(), region, model.ServerId.ValueString()); err != nil {
+			if err := stopServer(ctx, client, waitSettings, model.ProjectId.ValueString(), region, model.ServerId.ValueString()); err != nil {
 				return err
 			}
 		default:
@@ -648,29 +734,37 @@ func updateServerStatus(ctx context.Context, client serverControlClient, current
 
 // Read refreshes the Terraform state with the latest data.
 func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 	projectId := model.ProjectId.ValueString()
 	region := r.providerData.GetRegionWithOverride(model.Region)
 	serverId := model.ServerId.ValueString()
 
 	ctx = core.InitProviderContext(ctx)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
 
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "region", region)
 	ctx = tflog.SetField(ctx, "server_id", serverId)
 
+	if utils.SkipReadIfLifecyclePaused(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+
 	serverReq := r.client.GetServer(ctx, projectId, region, serverId)
 	serverReq = serverReq.Details(true)
 	serverResp, err := serverReq.Execute()
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_server", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server", fmt.Sprintf("Calling API: %v", err))
@@ -685,6 +779,7 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -696,10 +791,11 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 func (r *serverResource) updateServerAttributes(ctx context.Context, model, stateModel *Model, region string) (*iaas.Server, error) {
 	// Generate API request body from model
-	payload, err := toUpdatePayload(ctx, model, stateModel.Labels)
+	payload, err := toUpdatePayload(ctx, model, stateModel.Labels, stateModel.MaintenancePreferences, stateModel.MetadataLabels)
 	if err != nil {
 		return nil, fmt.Errorf("Creating API payload: %w", err)
 	}
+	payload.Labels = r.providerData.MergeDefaultLabels(payload.Labels)
 	projectId := model.ProjectId.ValueString()
 	serverId := model.ServerId.ValueString()
 
@@ -721,7 +817,7 @@ func (r *serverResource) updateServerAttributes(ctx context.Context, model, stat
 			return nil, fmt.Errorf("Resizing the server, calling API: %w", err)
 		}
 
-		_, err = wait.ResizeServerWaitHandler(ctx, r.client, projectId, region, serverId).WaitWithContext(ctx)
+		_, err = core.ConfigureWaitHandler(wait.ResizeServerWaitHandler(ctx, r.client, projectId, region, serverId), r.providerData.Wait, core.WaitUpdate).WaitWithContext(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("server resize waiting: %w", err)
 		}
@@ -733,6 +829,8 @@ func (r *serverResource) updateServerAttributes(ctx context.Context, model, stat
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -740,11 +838,13 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 	projectId := model.ProjectId.ValueString()
 	region := r.providerData.GetRegionWithOverride(model.Region)
 	serverId := model.ServerId.ValueString()
 
 	ctx = core.InitProviderContext(ctx)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
 
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "region", region)
@@ -777,13 +877,13 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 		ctx = core.LogResponse(ctx)
 
-		if err := updateServerStatus(ctx, r.client, server.Status, &model, region); err != nil {
+		if err := updateServerStatus(ctx, r.client, r.providerData.Wait, server.Status, &model, region); err != nil {
 			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server", err.Error())
 			return
 		}
 	} else {
 		// potentially unfreeze first and update afterwards
-		if err := updateServerStatus(ctx, r.client, server.Status, &model, region); err != nil {
+		if err := updateServerStatus(ctx, r.client, r.providerData.Wait, server.Status, &model, region); err != nil {
 			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server", err.Error())
 			return
 		}
@@ -811,6 +911,7 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -835,6 +936,7 @@ func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	serverId := model.ServerId.ValueString()
 
 	ctx = core.InitProviderContext(ctx)
+	ctx = core.ContextWithAuthProfile(ctx, model.AuthProfile)
 
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "region", region)
@@ -849,7 +951,7 @@ func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.DeleteServerWaitHandler(ctx, r.client, projectId, region, serverId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.DeleteServerWaitHandler(ctx, r.client, projectId, region, serverId), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting server", fmt.Sprintf("server deletion waiting: %v", err))
 		return
@@ -861,12 +963,13 @@ func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest,
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,server_id
 func (r *serverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing server",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[server_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[server_id]  Got: %q", importId),
 		)
 		return
 	}
@@ -904,6 +1007,14 @@ func mapFields(ctx context.Context, serverResp *iaas.Server, model *Model, regio
 	if err != nil {
 		return err
 	}
+	labels, maintenancePreferences, err := splitMaintenanceLabels(labels, model.Labels)
+	if err != nil {
+		return fmt.Errorf("mapping maintenance preferences: %w", err)
+	}
+	maintenanceWindow, err := mapMaintenanceWindow(serverResp.MaintenanceWindow)
+	if err != nil {
+		return fmt.Errorf("mapping maintenance window: %w", err)
+	}
 
 	var createdAt basetypes.StringValue
 	if serverResp.CreatedAt != nil {
@@ -1007,6 +1118,9 @@ func mapFields(ctx context.Context, serverResp *iaas.Server, model *Model, regio
 	}
 	model.Name = types.StringPointerValue(serverResp.Name)
 	model.Labels = labels
+	model.MetadataLabels = mapMetadataLabels(serverResp.Metadata, labels, model.MetadataLabels)
+	model.MaintenancePreferences = maintenancePreferences
+	model.MaintenanceWindow = maintenanceWindow
 	model.ImageId = types.StringPointerValue(serverResp.ImageId)
 	model.KeypairName = types.StringPointerValue(serverResp.KeypairName)
 	model.AffinityGroup = types.StringPointerValue(serverResp.AffinityGroup)
@@ -1034,6 +1148,24 @@ func toCreatePayload(ctx context.Context, model *Model) (*iaas.CreateServerPaylo
 	if err != nil {
 		return nil, fmt.Errorf("converting to Go map: %w", err)
 	}
+	maintenanceLabels, err := toMaintenanceLabels(ctx, model.MaintenancePreferences)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range maintenanceLabels {
+		if labels == nil {
+			labels = map[string]interface{}{}
+		}
+		labels[k] = v
+	}
+	var metadataPayload *map[string]interface{}
+	metadata, err := toMetadata(ctx, model.Labels, model.MetadataLabels)
+	if err != nil {
+		return nil, err
+	}
+	if len(metadata) > 0 {
+		metadataPayload = &metadata
+	}
 
 	var bootVolumePayload *iaas.ServerBootVolume
 	if !bootVolume.SourceId.IsNull() && !bootVolume.SourceType.IsNull() {
@@ -1087,11 +1219,12 @@ func toCreatePayload(ctx context.Context, model *Model) (*iaas.CreateServerPaylo
 		Name:             conversion.StringValueToPointer(model.Name),
 		Networking:       network,
 		MachineType:      conversion.StringValueToPointer(model.MachineType),
+		Metadata:         metadataPayload,
 		UserData:         userData,
 	}, nil
 }
 
-func toUpdatePayload(ctx context.Context, model *Model, currentLabels types.Map) (*iaas.UpdateServerPayload, error) {
+func toUpdatePayload(ctx context.Context, model *Model, currentLabels types.Map, currentMaintenancePreferences types.Object, currentMetadataLabels types.Set) (*iaas.UpdateServerPayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
 	}
@@ -1100,9 +1233,25 @@ func toUpdatePayload(ctx context.Context, model *Model, currentLabels types.Map)
 	if err != nil {
 		return nil, fmt.Errorf("converting to Go map: %w", err)
 	}
+	maintenanceLabels, err := toMaintenanceLabelsPartialUpdate(ctx, currentMaintenancePreferences, model.MaintenancePreferences)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range maintenanceLabels {
+		labels[k] = v
+	}
+	var metadataPayload *map[string]interface{}
+	metadata, err := toMetadataPartialUpdate(ctx, currentMetadataLabels, model.Labels, model.MetadataLabels)
+	if err != nil {
+		return nil, err
+	}
+	if len(metadata) > 0 {
+		metadataPayload = &metadata
+	}
 
 	return &iaas.UpdateServerPayload{
-		Name:   conversion.StringValueToPointer(model.Name),
-		Labels: &labels,
+		Name:     conversion.StringValueToPointer(model.Name),
+		Labels:   &labels,
+		Metadata: metadataPayload,
 	}, nil
 }
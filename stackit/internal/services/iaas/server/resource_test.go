@@ -12,6 +12,7 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas/wait"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 )
 
 const (
@@ -56,6 +57,7 @@ func TestMapFields(t *testing.T) {
 				Name:              types.StringNull(),
 				AvailabilityZone:  types.StringNull(),
 				Labels:            types.MapNull(types.StringType),
+				MetadataLabels:    types.SetNull(types.StringType),
 				ImageId:           types.StringNull(),
 				NetworkInterfaces: types.ListNull(types.StringType),
 				KeypairName:       types.StringNull(),
@@ -110,6 +112,7 @@ func TestMapFields(t *testing.T) {
 				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
 					"key": types.StringValue("value"),
 				}),
+				MetadataLabels:    types.SetNull(types.StringType),
 				ImageId:           types.StringValue("image_id"),
 				NetworkInterfaces: types.ListNull(types.StringType),
 				KeypairName:       types.StringValue("keypair_name"),
@@ -141,6 +144,7 @@ func TestMapFields(t *testing.T) {
 				Name:              types.StringNull(),
 				AvailabilityZone:  types.StringNull(),
 				Labels:            types.MapValueMust(types.StringType, map[string]attr.Value{}),
+				MetadataLabels:    types.SetNull(types.StringType),
 				ImageId:           types.StringNull(),
 				NetworkInterfaces: types.ListNull(types.StringType),
 				KeypairName:       types.StringNull(),
@@ -243,6 +247,78 @@ func TestToCreatePayload(t *testing.T) {
 			},
 			isValid: true,
 		},
+		{
+			description: "metadata labels",
+			input: &Model{
+				Name:             types.StringValue("name"),
+				AvailabilityZone: types.StringValue("zone"),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				}),
+				MetadataLabels: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("key"),
+				}),
+				BootVolume: types.ObjectValueMust(bootVolumeTypes, map[string]attr.Value{
+					"performance_class":     types.StringValue("class"),
+					"size":                  types.Int64Value(1),
+					"source_type":           types.StringValue("type"),
+					"source_id":             types.StringValue("id"),
+					"delete_on_termination": types.BoolUnknown(),
+					"id":                    types.StringValue("id"),
+				}),
+				ImageId:     types.StringValue("image"),
+				KeypairName: types.StringValue("keypair"),
+				MachineType: types.StringValue("machine_type"),
+				UserData:    types.StringValue(userData),
+				NetworkInterfaces: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("nic1"),
+					types.StringValue("nic2"),
+				}),
+			},
+			expected: &iaas.CreateServerPayload{
+				Name:             utils.Ptr("name"),
+				AvailabilityZone: utils.Ptr("zone"),
+				Labels: &map[string]interface{}{
+					"key": "value",
+				},
+				BootVolume: &iaas.ServerBootVolume{
+					PerformanceClass: utils.Ptr("class"),
+					Size:             utils.Ptr(int64(1)),
+					Source: &iaas.BootVolumeSource{
+						Type: utils.Ptr("type"),
+						Id:   utils.Ptr("id"),
+					},
+				},
+				ImageId:     utils.Ptr("image"),
+				KeypairName: utils.Ptr("keypair"),
+				MachineType: utils.Ptr("machine_type"),
+				Metadata: &map[string]interface{}{
+					"key": "value",
+				},
+				UserData: utils.Ptr([]byte(base64EncodedUserData)),
+				Networking: &iaas.CreateServerPayloadAllOfNetworking{
+					CreateServerNetworkingWithNics: &iaas.CreateServerNetworkingWithNics{
+						NicIds: &[]string{"nic1", "nic2"},
+					},
+				},
+			},
+			isValid: true,
+		},
+		{
+			description: "metadata label not in labels",
+			input: &Model{
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				}),
+				MetadataLabels: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("other"),
+				}),
+				NetworkInterfaces: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("nic1"),
+				}),
+			},
+			isValid: false,
+		},
 		{
 			description: "delete on termination is set to true",
 			input: &Model{
@@ -341,7 +417,7 @@ func TestToUpdatePayload(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
-			output, err := toUpdatePayload(context.Background(), tt.input, types.MapNull(types.StringType))
+			output, err := toUpdatePayload(context.Background(), tt.input, types.MapNull(types.StringType), types.ObjectNull(maintenancePreferencesTypes), types.SetNull(types.StringType))
 			if !tt.isValid && err == nil {
 				t.Fatalf("Should have failed")
 			}
@@ -598,7 +674,7 @@ func Test_serverResource_updateServerStatus(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			err := updateServerStatus(context.Background(), tt.fields.client, tt.args.currentState, &tt.args.model, tt.args.region)
+			err := updateServerStatus(context.Background(), tt.fields.client, core.WaitSettings{}, tt.args.currentState, &tt.args.model, tt.args.region)
 			if (err != nil) != tt.want.err {
 				t.Errorf("inconsistent error, want %v and got %v", tt.want.err, err)
 			}
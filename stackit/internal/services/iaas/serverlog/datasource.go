@@ -0,0 +1,192 @@
+package serverlog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+const (
+	// defaultLength is the number of log lines returned, if no length is configured
+	defaultLength = 2000
+	// maxLength limits the number of log lines, to keep the state small
+	maxLength = 10000
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ datasource.DataSource = &serverLogDataSource{}
+
+type DataSourceModel struct {
+	Id        types.String `tfsdk:"id"` // required by Terraform to identify state
+	ProjectId types.String `tfsdk:"project_id"`
+	Region    types.String `tfsdk:"region"`
+	ServerId  types.String `tfsdk:"server_id"`
+	Length    types.Int64  `tfsdk:"length"`
+	Output    types.String `tfsdk:"output"`
+}
+
+// NewServerLogDataSource instantiates the data source
+func NewServerLogDataSource() datasource.DataSource {
+	return &serverLogDataSource{}
+}
+
+type serverLogDataSource struct {
+	client       *iaas.APIClient
+	providerData core.ProviderData
+}
+
+func (d *serverLogDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_log"
+}
+
+func (d *serverLogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	d.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &d.providerData, &resp.Diagnostics, "stackit_server_log", "datasource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := iaasUtils.ConfigureClient(ctx, &d.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = client
+
+	tflog.Info(ctx, "IAAS client configured")
+}
+
+func (d *serverLogDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Server log data source. Returns the recent console log output of a server, e.g. to validate the boot of a server after an image change."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: features.AddBetaDescription(description, core.Datasource),
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`server_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the server is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "The resource region. If not defined, the provider region is used.",
+				// the region cannot be found, so it has to be passed
+				Optional: true,
+			},
+			"server_id": schema.StringAttribute{
+				Description: "The server ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"length": schema.Int64Attribute{
+				Description: fmt.Sprintf("Number of the most recent log lines to return. Defaults to `%d`, at most `%d` lines can be returned.", defaultLength, maxLength),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxLength),
+				},
+			},
+			"output": schema.StringAttribute{
+				Description: "The console log output of the server. It is marked as sensitive, as it may contain secrets, e.g. printed by cloud-init.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (d *serverLogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	region := d.providerData.GetRegionWithOverride(model.Region)
+	serverId := model.ServerId.ValueString()
+	length := int64(defaultLength)
+	if !model.Length.IsNull() && !model.Length.IsUnknown() {
+		length = model.Length.ValueInt64()
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "server_id", serverId)
+
+	logResp, err := d.client.GetServerLog(ctx, projectId, region, serverId).Length(length).Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading server log",
+			fmt.Sprintf("Server with ID %q does not exist in project %q.", serverId, projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapDataSourceFields(logResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server log", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Server log read")
+}
+
+func mapDataSourceFields(logResp *iaas.GetServerLog200Response, model *DataSourceModel, region string) error {
+	if logResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, model.ServerId.ValueString())
+	model.Region = types.StringValue(region)
+	if logResp.Output != nil {
+		model.Output = types.StringValue(*logResp.Output)
+	} else {
+		model.Output = types.StringValue("")
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package serverlog
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       DataSourceModel
+		input       *iaas.GetServerLog200Response
+		region      string
+		expected    DataSourceModel
+		isValid     bool
+	}{
+		{
+			description: "default_values",
+			state: DataSourceModel{
+				ProjectId: types.StringValue("pid"),
+				ServerId:  types.StringValue("sid"),
+			},
+			input:  &iaas.GetServerLog200Response{},
+			region: "eu01",
+			expected: DataSourceModel{
+				Id:        types.StringValue("pid,eu01,sid"),
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+				ServerId:  types.StringValue("sid"),
+				Output:    types.StringValue(""),
+			},
+			isValid: true,
+		},
+		{
+			description: "simple_values",
+			state: DataSourceModel{
+				ProjectId: types.StringValue("pid"),
+				ServerId:  types.StringValue("sid"),
+				Length:    types.Int64Value(10),
+			},
+			input: &iaas.GetServerLog200Response{
+				Output: utils.Ptr("line 1\nline 2\n"),
+			},
+			region: "eu02",
+			expected: DataSourceModel{
+				Id:        types.StringValue("pid,eu02,sid"),
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu02"),
+				ServerId:  types.StringValue("sid"),
+				Length:    types.Int64Value(10),
+				Output:    types.StringValue("line 1\nline 2\n"),
+			},
+			isValid: true,
+		},
+		{
+			description: "response_nil_fail",
+			state:       DataSourceModel{},
+			input:       nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapDataSourceFields(tt.input, &tt.state, tt.region)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
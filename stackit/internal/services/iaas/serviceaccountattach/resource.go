@@ -29,9 +29,12 @@ var (
 	_ resource.Resource                = &serviceAccountAttachResource{}
 	_ resource.ResourceWithConfigure   = &serviceAccountAttachResource{}
 	_ resource.ResourceWithImportState = &serviceAccountAttachResource{}
+	_ resource.ResourceWithIdentity    = &serviceAccountAttachResource{}
 	_ resource.ResourceWithModifyPlan  = &serviceAccountAttachResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "server_id", "service_account_email"}
+
 type Model struct {
 	Id                  types.String `tfsdk:"id"` // needed by TF
 	ProjectId           types.String `tfsdk:"project_id"`
@@ -56,6 +59,11 @@ func (r *serviceAccountAttachResource) Metadata(_ context.Context, req resource.
 	resp.TypeName = req.ProviderTypeName + "_server_service_account_attach"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *serviceAccountAttachResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan.
 // Use the modifier to set the effective region in the current plan.
 func (r *serviceAccountAttachResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
@@ -75,7 +83,7 @@ func (r *serviceAccountAttachResource) ModifyPlan(ctx context.Context, req resou
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -160,6 +168,8 @@ func (r *serviceAccountAttachResource) Schema(_ context.Context, _ resource.Sche
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *serviceAccountAttachResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -202,6 +212,8 @@ func (r *serviceAccountAttachResource) Create(ctx context.Context, req resource.
 
 // Read refreshes the Terraform state with the latest data.
 func (r *serviceAccountAttachResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -224,7 +236,7 @@ func (r *serviceAccountAttachResource) Read(ctx context.Context, req resource.Re
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_server_service_account_attach", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading service account attachment", fmt.Sprintf("Calling API: %v", err))
@@ -259,7 +271,7 @@ func (r *serviceAccountAttachResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// no matching service account was found, the attachment no longer exists
-	resp.State.RemoveResource(ctx)
+	core.RemoveFromStateWithWarning(ctx, resp, "stackit_server_service_account_attach", model.Id.ValueString())
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -302,12 +314,13 @@ func (r *serviceAccountAttachResource) Delete(ctx context.Context, req resource.
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,server_id
 func (r *serviceAccountAttachResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing service_account attachment",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[server_id],[service_account_email]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[server_id],[service_account_email]  Got: %q", importId),
 		)
 		return
 	}
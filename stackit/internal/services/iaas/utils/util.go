@@ -23,7 +23,9 @@ func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags
 		apiClientConfigOptions = append(apiClientConfigOptions, config.WithEndpoint(providerData.IaaSCustomEndpoint))
 	}
 
-	apiClient, err := iaas.NewAPIClient(apiClientConfigOptions...)
+	apiClient, err := core.CachedClient(providerData.Clients, "iaas", "", providerData.IaaSCustomEndpoint, func() (*iaas.APIClient, error) {
+		return iaas.NewAPIClient(apiClientConfigOptions...)
+	})
 	if err != nil {
 		core.LogAndAddError(ctx, diags, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the resource configuration", err))
 		return nil
@@ -32,15 +34,24 @@ func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags
 	return apiClient
 }
 
+// SystemLabelPrefixes are the prefixes of the label keys, which are added by the IaaS API itself.
+var SystemLabelPrefixes = []string{"stackit.cloud/"}
+
+// MapLabels maps the labels returned by the API, without the system labels added by the API itself.
 func MapLabels(ctx context.Context, responseLabels *map[string]interface{}, currentLabels types.Map) (basetypes.MapValue, error) { //nolint:gocritic // Linter wants to have a non-pointer type for the map, but this would mean a nil check has to be done before every usage of this func.
 	labelsTF, diags := types.MapValueFrom(ctx, types.StringType, map[string]interface{}{})
 	if diags.HasError() {
 		return labelsTF, fmt.Errorf("convert labels to StringValue map: %w", core.DiagsToError(diags))
 	}
 
-	if responseLabels != nil && len(*responseLabels) != 0 {
+	var labels map[string]interface{}
+	if responseLabels != nil {
+		labels = utils.RemoveSystemLabels(*responseLabels, currentLabels, SystemLabelPrefixes)
+	}
+
+	if len(labels) != 0 {
 		var diags diag.Diagnostics
-		labelsTF, diags = types.MapValueFrom(ctx, types.StringType, *responseLabels)
+		labelsTF, diags = types.MapValueFrom(ctx, types.StringType, labels)
 		if diags.HasError() {
 			return labelsTF, fmt.Errorf("convert labels to StringValue map: %w", core.DiagsToError(diags))
 		}
@@ -161,6 +161,48 @@ func TestMapLabels(t *testing.T) {
 			wantErr: false,
 			want:    types.MapValueMust(types.StringType, map[string]attr.Value{}),
 		},
+		{
+			name: "system labels are removed",
+			args: args{
+				responseLabels: &map[string]interface{}{
+					"foo1":                 "bar1",
+					"stackit.cloud/system": "value",
+				},
+				currentLabels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"foo1": types.StringValue("bar1"),
+				}),
+			},
+			wantErr: false,
+			want: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"foo1": types.StringValue("bar1"),
+			}),
+		},
+		{
+			name: "only system labels and model labels is nil",
+			args: args{
+				responseLabels: &map[string]interface{}{
+					"stackit.cloud/system": "value",
+				},
+				currentLabels: types.MapNull(types.StringType),
+			},
+			wantErr: false,
+			want:    types.MapNull(types.StringType),
+		},
+		{
+			name: "system labels set by the user are kept",
+			args: args{
+				responseLabels: &map[string]interface{}{
+					"stackit.cloud/system": "value",
+				},
+				currentLabels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"stackit.cloud/system": types.StringValue("value"),
+				}),
+			},
+			wantErr: false,
+			want: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"stackit.cloud/system": types.StringValue("value"),
+			}),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
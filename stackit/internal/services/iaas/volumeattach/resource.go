@@ -31,9 +31,12 @@ var (
 	_ resource.Resource                = &volumeAttachResource{}
 	_ resource.ResourceWithConfigure   = &volumeAttachResource{}
 	_ resource.ResourceWithImportState = &volumeAttachResource{}
+	_ resource.ResourceWithIdentity    = &volumeAttachResource{}
 	_ resource.ResourceWithModifyPlan  = &volumeAttachResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "server_id", "volume_id"}
+
 type Model struct {
 	Id        types.String `tfsdk:"id"` // needed by TF
 	ProjectId types.String `tfsdk:"project_id"`
@@ -58,6 +61,11 @@ func (r *volumeAttachResource) Metadata(_ context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_server_volume_attach"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *volumeAttachResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan.
 // Use the modifier to set the effective region in the current plan.
 func (r *volumeAttachResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
@@ -77,7 +85,7 @@ func (r *volumeAttachResource) ModifyPlan(ctx context.Context, req resource.Modi
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -166,6 +174,8 @@ func (r *volumeAttachResource) Schema(_ context.Context, _ resource.SchemaReques
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *volumeAttachResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -198,7 +208,7 @@ func (r *volumeAttachResource) Create(ctx context.Context, req resource.CreateRe
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.AddVolumeToServerWaitHandler(ctx, r.client, projectId, region, serverId, volumeId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.AddVolumeToServerWaitHandler(ctx, r.client, projectId, region, serverId, volumeId), r.providerData.Wait, core.WaitCreate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error attaching volume to server", fmt.Sprintf("volume attachment waiting: %v", err))
 		return
@@ -217,6 +227,8 @@ func (r *volumeAttachResource) Create(ctx context.Context, req resource.CreateRe
 
 // Read refreshes the Terraform state with the latest data.
 func (r *volumeAttachResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -239,7 +251,7 @@ func (r *volumeAttachResource) Read(ctx context.Context, req resource.ReadReques
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_server_volume_attach", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volume attachment", fmt.Sprintf("Calling API: %v", err))
@@ -292,7 +304,7 @@ func (r *volumeAttachResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.RemoveVolumeFromServerWaitHandler(ctx, r.client, projectId, region, serverId, volumeId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.RemoveVolumeFromServerWaitHandler(ctx, r.client, projectId, region, serverId, volumeId), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error removing volume from server", fmt.Sprintf("volume removal waiting: %v", err))
 		return
@@ -304,12 +316,13 @@ func (r *volumeAttachResource) Delete(ctx context.Context, req resource.DeleteRe
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,server_id
 func (r *volumeAttachResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing volume attachment",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[server_id],[volume_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[server_id],[volume_id]  Got: %q", importId),
 		)
 		return
 	}
@@ -0,0 +1,258 @@
+package volumetypes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &volumeTypesDataSource{}
+)
+
+type Model struct {
+	Id          types.String `tfsdk:"id"` // needed by TF
+	ProjectId   types.String `tfsdk:"project_id"`
+	Region      types.String `tfsdk:"region"`
+	VolumeTypes types.List   `tfsdk:"volume_types"`
+	Names       types.List   `tfsdk:"names"`
+}
+
+var volumeTypeTypes = map[string]attr.Type{
+	"name":        types.StringType,
+	"description": types.StringType,
+	"iops":        types.Int64Type,
+	"throughput":  types.Int64Type,
+	"labels":      types.MapType{ElemType: types.StringType},
+}
+
+// NewVolumeTypesDataSource is a helper function to simplify the provider implementation.
+func NewVolumeTypesDataSource() datasource.DataSource {
+	return &volumeTypesDataSource{}
+}
+
+// volumeTypesDataSource is the data source implementation.
+type volumeTypesDataSource struct {
+	client       *iaas.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the data source type name.
+func (d *volumeTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iaas_volume_types"
+}
+
+func (d *volumeTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	d.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &d.providerData, &resp.Diagnostics, "stackit_iaas_volume_types", "datasource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &d.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *volumeTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Volume types data source. Lists the volume performance classes available in a region, e.g. to choose or validate the `performance_class` of a `stackit_volume`."
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: features.AddBetaDescription(description, core.Datasource),
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`region`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "The resource region. If not defined, the provider region is used.",
+				Optional:    true,
+			},
+			"volume_types": schema.ListNestedAttribute{
+				Description: "A list of all volume performance classes available in the region, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the performance class. Can be used as `performance_class` of a volume.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the performance class.",
+							Computed:    true,
+						},
+						"iops": schema.Int64Attribute{
+							Description: "Input/Output operations per second.",
+							Computed:    true,
+						},
+						"throughput": schema.Int64Attribute{
+							Description: "Throughput in megabytes per second.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of the performance class.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"names": schema.ListAttribute{
+				Description: "A list of the performance class names extracted from `volume_types` for easy consumption.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *volumeTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := d.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	volumeTypesResp, err := d.client.ListVolumePerformanceClassesExecute(ctx, projectId, region)
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading volume types",
+			fmt.Sprintf("Unable to retrieve volume types for project %q in region %q.", projectId, region),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	// Map response body to schema
+	err = mapFields(ctx, volumeTypesResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volume types", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "read volume types")
+}
+
+func mapFields(ctx context.Context, volumeTypesResp *iaas.VolumePerformanceClassListResponse, model *Model, region string) error {
+	if volumeTypesResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region)
+	model.Region = types.StringValue(region)
+
+	var volumeTypes []iaas.VolumePerformanceClass
+	if volumeTypesResp.Items != nil {
+		volumeTypes = *volumeTypesResp.Items
+	}
+
+	// Sort to prevent unnecessary diffs due to order changes.
+	sort.SliceStable(volumeTypes, func(i, j int) bool {
+		return volumeTypes[i].GetName() < volumeTypes[j].GetName()
+	})
+
+	volumeTypesList := []attr.Value{}
+	names := []string{}
+	for i := range volumeTypes {
+		volumeType := &volumeTypes[i]
+		if volumeType.Name == nil || *volumeType.Name == "" {
+			return fmt.Errorf("volume type at index %d has no name", i)
+		}
+
+		labels := types.MapNull(types.StringType)
+		if volumeType.Labels != nil && len(*volumeType.Labels) > 0 {
+			var diags diag.Diagnostics
+			labels, diags = types.MapValueFrom(ctx, types.StringType, *volumeType.Labels)
+			if diags.HasError() {
+				return fmt.Errorf("converting labels of volume type %q: %w", *volumeType.Name, core.DiagsToError(diags))
+			}
+		}
+
+		volumeTypeObject, diags := types.ObjectValue(volumeTypeTypes, map[string]attr.Value{
+			"name":        types.StringPointerValue(volumeType.Name),
+			"description": types.StringPointerValue(volumeType.Description),
+			"iops":        types.Int64PointerValue(volumeType.Iops),
+			"throughput":  types.Int64PointerValue(volumeType.Throughput),
+			"labels":      labels,
+		})
+		if diags.HasError() {
+			return core.DiagsToError(diags)
+		}
+		volumeTypesList = append(volumeTypesList, volumeTypeObject)
+		names = append(names, *volumeType.Name)
+	}
+
+	volumeTypesTF, diags := types.ListValue(types.ObjectType{AttrTypes: volumeTypeTypes}, volumeTypesList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.VolumeTypes = volumeTypesTF
+
+	namesTF, diags := types.ListValueFrom(ctx, types.StringType, names)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.Names = namesTF
+
+	return nil
+}
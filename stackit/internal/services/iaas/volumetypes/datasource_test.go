@@ -0,0 +1,125 @@
+package volumetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapFields(t *testing.T) {
+	volumeTypeObjectType := types.ObjectType{AttrTypes: volumeTypeTypes}
+
+	tests := []struct {
+		description string
+		input       *iaas.VolumePerformanceClassListResponse
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_ok",
+			&iaas.VolumePerformanceClassListResponse{
+				Items: &[]iaas.VolumePerformanceClass{
+					{
+						Name:        utils.Ptr("storage_premium_perf4"),
+						Description: utils.Ptr("premium"),
+						Iops:        utils.Ptr(int64(12000)),
+						Throughput:  utils.Ptr(int64(200)),
+						Labels: &map[string]interface{}{
+							"key": "value",
+						},
+					},
+					{
+						Name:       utils.Ptr("storage_premium_perf0"),
+						Iops:       utils.Ptr(int64(600)),
+						Throughput: utils.Ptr(int64(64)),
+					},
+				},
+			},
+			Model{
+				Id:        types.StringValue("pid,eu01"),
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+				VolumeTypes: types.ListValueMust(volumeTypeObjectType, []attr.Value{
+					types.ObjectValueMust(volumeTypeTypes, map[string]attr.Value{
+						"name":        types.StringValue("storage_premium_perf0"),
+						"description": types.StringNull(),
+						"iops":        types.Int64Value(600),
+						"throughput":  types.Int64Value(64),
+						"labels":      types.MapNull(types.StringType),
+					}),
+					types.ObjectValueMust(volumeTypeTypes, map[string]attr.Value{
+						"name":        types.StringValue("storage_premium_perf4"),
+						"description": types.StringValue("premium"),
+						"iops":        types.Int64Value(12000),
+						"throughput":  types.Int64Value(200),
+						"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+							"key": types.StringValue("value"),
+						}),
+					}),
+				}),
+				Names: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("storage_premium_perf0"),
+					types.StringValue("storage_premium_perf4"),
+				}),
+			},
+			true,
+		},
+		{
+			"empty_items",
+			&iaas.VolumePerformanceClassListResponse{
+				Items: &[]iaas.VolumePerformanceClass{},
+			},
+			Model{
+				Id:          types.StringValue("pid,eu01"),
+				ProjectId:   types.StringValue("pid"),
+				Region:      types.StringValue("eu01"),
+				VolumeTypes: types.ListValueMust(volumeTypeObjectType, []attr.Value{}),
+				Names:       types.ListValueMust(types.StringType, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"missing_name",
+			&iaas.VolumePerformanceClassListResponse{
+				Items: &[]iaas.VolumePerformanceClass{
+					{
+						Iops: utils.Ptr(int64(600)),
+					},
+				},
+			},
+			Model{},
+			false,
+		},
+		{
+			"nil_response",
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := Model{
+				ProjectId: types.StringValue("pid"),
+			}
+			err := mapFields(context.Background(), tt.input, &model, "eu01")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -45,20 +45,20 @@ var (
 var testConfigRoutingTableMin = config.Variables{
 	"organization_id": config.StringVariable(testutil.OrganizationId),
 	"network_area_id": config.StringVariable(testNetworkAreaId),
-	"name":            config.StringVariable(fmt.Sprintf("acc-test-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"name":            config.StringVariable(fmt.Sprintf("acc-test-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 }
 
 var testConfigRoutingTableMinUpdated = func() config.Variables {
 	updatedConfig := config.Variables{}
 	maps.Copy(updatedConfig, testConfigRoutingTableMin)
-	updatedConfig["name"] = config.StringVariable(fmt.Sprintf("acc-test-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)))
+	updatedConfig["name"] = config.StringVariable(fmt.Sprintf("acc-test-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum)))
 	return updatedConfig
 }()
 
 var testConfigRoutingTableMax = config.Variables{
 	"organization_id": config.StringVariable(testutil.OrganizationId),
 	"network_area_id": config.StringVariable(testNetworkAreaId),
-	"name":            config.StringVariable(fmt.Sprintf("acc-test-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"name":            config.StringVariable(fmt.Sprintf("acc-test-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 	"description":     config.StringVariable("This is the description of the routing table."),
 	"label":           config.StringVariable("routing-table-label-01"),
 	"system_routes":   config.BoolVariable(false),
@@ -70,7 +70,7 @@ var testConfigRoutingTableMaxUpdated = func() config.Variables {
 	for k, v := range testConfigRoutingTableMax {
 		updatedConfig[k] = v
 	}
-	updatedConfig["name"] = config.StringVariable(fmt.Sprintf("acc-test-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)))
+	updatedConfig["name"] = config.StringVariable(fmt.Sprintf("acc-test-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum)))
 	updatedConfig["description"] = config.StringVariable("This is the updated description of the routing table.")
 	updatedConfig["label"] = config.StringVariable("routing-table-updated-label-01")
 	return updatedConfig
@@ -79,7 +79,7 @@ var testConfigRoutingTableMaxUpdated = func() config.Variables {
 var testConfigRoutingTableRouteMin = config.Variables{
 	"organization_id":    config.StringVariable(testutil.OrganizationId),
 	"network_area_id":    config.StringVariable(testNetworkAreaId),
-	"routing_table_name": config.StringVariable(fmt.Sprintf("acc-test-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"routing_table_name": config.StringVariable(fmt.Sprintf("acc-test-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 	"destination_type":   config.StringVariable("cidrv4"),
 	"destination_value":  config.StringVariable("192.168.178.0/24"),
 	"next_hop_type":      config.StringVariable("ipv4"),
@@ -96,7 +96,7 @@ var testConfigRoutingTableRouteMinUpdated = func() config.Variables {
 var testConfigRoutingTableRouteMax = config.Variables{
 	"organization_id":    config.StringVariable(testutil.OrganizationId),
 	"network_area_id":    config.StringVariable(testNetworkAreaId),
-	"routing_table_name": config.StringVariable(fmt.Sprintf("acc-test-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"routing_table_name": config.StringVariable(fmt.Sprintf("acc-test-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 	"destination_type":   config.StringVariable("cidrv4"), // TODO: use cidrv6 once it's supported as we already test cidrv4 in the min test
 	"destination_value":  config.StringVariable("192.168.178.0/24"),
 	"next_hop_type":      config.StringVariable("ipv4"), // TODO: use ipv6, internet or blackhole once they are supported as we already test ipv4 in the min test
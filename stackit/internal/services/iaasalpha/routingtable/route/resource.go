@@ -2,7 +2,9 @@ package route
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/routingtable/shared"
@@ -14,9 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	sdkUtils "github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaasalpha"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
@@ -31,9 +32,12 @@ var (
 	_ resource.Resource                = &routeResource{}
 	_ resource.ResourceWithConfigure   = &routeResource{}
 	_ resource.ResourceWithImportState = &routeResource{}
+	_ resource.ResourceWithIdentity    = &routeResource{}
 	_ resource.ResourceWithModifyPlan  = &routeResource{}
 )
 
+var resourceIdentity = utils.Identity{"organization_id", "region", "network_area_id", "routing_table_id", "route_id"}
+
 // NewRoutingTableRouteResource is a helper function to simplify the provider implementation.
 func NewRoutingTableRouteResource() resource.Resource {
 	return &routeResource{}
@@ -50,6 +54,11 @@ func (r *routeResource) Metadata(_ context.Context, req resource.MetadataRequest
 	resp.TypeName = req.ProviderTypeName + "_routing_table_route"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *routeResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *routeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	var ok bool
@@ -90,7 +99,7 @@ func (r *routeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -236,12 +245,15 @@ func (r *routeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *routeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var model shared.RouteModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 
 	ctx = core.InitProviderContext(ctx)
 
@@ -261,6 +273,9 @@ func (r *routeResource) Create(ctx context.Context, req resource.CreateRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating routing table route", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
+	for i := range *payload.Items {
+		(*payload.Items)[i].Labels = r.providerData.MergeDefaultLabels((*payload.Items)[i].Labels)
+	}
 
 	routeResp, err := r.client.AddRoutesToRoutingTable(ctx, organizationId, networkAreaId, region, routingTableId).AddRoutesToRoutingTablePayload(*payload).Execute()
 	if err != nil {
@@ -276,6 +291,7 @@ func (r *routeResource) Create(ctx context.Context, req resource.CreateRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating routing table route", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 	ctx = tflog.SetField(ctx, "route_id", model.RouteId.ValueString())
 
 	diags = resp.State.Set(ctx, model)
@@ -288,12 +304,15 @@ func (r *routeResource) Create(ctx context.Context, req resource.CreateRequest,
 
 // Read refreshes the Terraform state with the latest data.
 func (r *routeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model shared.RouteModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 
 	ctx = core.InitProviderContext(ctx)
 
@@ -323,6 +342,7 @@ func (r *routeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading routing table route", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
@@ -335,6 +355,8 @@ func (r *routeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *routeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	// Retrieve values from plan
 	var model shared.RouteModel
 	diags := req.Plan.Get(ctx, &model)
@@ -342,6 +364,7 @@ func (r *routeResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 
 	ctx = core.InitProviderContext(ctx)
 
@@ -371,6 +394,7 @@ func (r *routeResource) Update(ctx context.Context, req resource.UpdateRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating routing table route", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
+	payload.Labels = r.providerData.MergeDefaultLabels(payload.Labels)
 
 	route, err := r.client.UpdateRouteOfRoutingTable(ctx, organizationId, networkAreaId, region, routingTableId, routeId).UpdateRouteOfRoutingTablePayload(*payload).Execute()
 	if err != nil {
@@ -386,6 +410,7 @@ func (r *routeResource) Update(ctx context.Context, req resource.UpdateRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating routing table route", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -421,7 +446,13 @@ func (r *routeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	// Delete existing routing table route
 	err := r.client.DeleteRouteFromRoutingTable(ctx, organizationId, networkAreaId, region, routingTableId, routeId).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error routing table route", fmt.Sprintf("Calling API: %v", err))
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, "Routing table route already deleted")
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting routing table route", fmt.Sprintf("Calling API: %v", err))
+		return
 	}
 
 	ctx = core.LogResponse(ctx)
@@ -432,12 +463,13 @@ func (r *routeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the routing table route resource import identifier is: organization_id,region,network_area_id,routing_table_id,route_id
 func (r *routeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 5 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" || idParts[4] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing routing table",
-			fmt.Sprintf("Expected import identifier with format: [organization_id],[region],[network_area_id],[routing_table_id],[route_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [organization_id],[region],[network_area_id],[routing_table_id],[route_id]  Got: %q", importId),
 		)
 		return
 	}
@@ -484,11 +516,11 @@ func toCreatePayload(ctx context.Context, model *shared.RouteReadModel) (*iaasal
 		return nil, fmt.Errorf("converting to Go map: %w", err)
 	}
 
-	nextHopPayload, err := toNextHopPayload(ctx, model)
+	nextHopPayload, err := shared.ToNextHopPayload(ctx, model)
 	if err != nil {
 		return nil, err
 	}
-	destinationPayload, err := toDestinationPayload(ctx, model)
+	destinationPayload, err := shared.ToDestinationPayload(ctx, model)
 	if err != nil {
 		return nil, err
 	}
@@ -518,53 +550,3 @@ func toUpdatePayload(ctx context.Context, model *shared.RouteModel, currentLabel
 		Labels: &labels,
 	}, nil
 }
-
-func toNextHopPayload(ctx context.Context, model *shared.RouteReadModel) (*iaasalpha.RouteNexthop, error) {
-	if model == nil {
-		return nil, fmt.Errorf("nil model")
-	}
-	if utils.IsUndefined(model.NextHop) {
-		return nil, nil
-	}
-
-	nexthopModel := shared.RouteNextHop{}
-	diags := model.NextHop.As(ctx, &nexthopModel, basetypes.ObjectAsOptions{})
-	if diags.HasError() {
-		return nil, core.DiagsToError(diags)
-	}
-
-	switch nexthopModel.Type.ValueString() {
-	case "blackhole":
-		return sdkUtils.Ptr(iaasalpha.NexthopBlackholeAsRouteNexthop(iaasalpha.NewNexthopBlackhole("blackhole"))), nil
-	case "internet":
-		return sdkUtils.Ptr(iaasalpha.NexthopInternetAsRouteNexthop(iaasalpha.NewNexthopInternet("internet"))), nil
-	case "ipv4":
-		return sdkUtils.Ptr(iaasalpha.NexthopIPv4AsRouteNexthop(iaasalpha.NewNexthopIPv4("ipv4", nexthopModel.Value.ValueString()))), nil
-	case "ipv6":
-		return sdkUtils.Ptr(iaasalpha.NexthopIPv6AsRouteNexthop(iaasalpha.NewNexthopIPv6("ipv6", nexthopModel.Value.ValueString()))), nil
-	}
-	return nil, fmt.Errorf("unknown nexthop type: %s", nexthopModel.Type.ValueString())
-}
-
-func toDestinationPayload(ctx context.Context, model *shared.RouteReadModel) (*iaasalpha.RouteDestination, error) {
-	if model == nil {
-		return nil, fmt.Errorf("nil model")
-	}
-	if utils.IsUndefined(model.Destination) {
-		return nil, nil
-	}
-
-	destinationModel := shared.RouteDestination{}
-	diags := model.Destination.As(ctx, &destinationModel, basetypes.ObjectAsOptions{})
-	if diags.HasError() {
-		return nil, core.DiagsToError(diags)
-	}
-
-	switch destinationModel.Type.ValueString() {
-	case "cidrv4":
-		return sdkUtils.Ptr(iaasalpha.DestinationCIDRv4AsRouteDestination(iaasalpha.NewDestinationCIDRv4("cidrv4", destinationModel.Value.ValueString()))), nil
-	case "cidrv6":
-		return sdkUtils.Ptr(iaasalpha.DestinationCIDRv6AsRouteDestination(iaasalpha.NewDestinationCIDRv6("cidrv6", destinationModel.Value.ValueString()))), nil
-	}
-	return nil, fmt.Errorf("unknown destination type: %s", destinationModel.Type.ValueString())
-}
@@ -0,0 +1,847 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaasalpha"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/routingtable/shared"
+	iaasalphaUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &routingTableRoutesResource{}
+	_ resource.ResourceWithConfigure      = &routingTableRoutesResource{}
+	_ resource.ResourceWithImportState    = &routingTableRoutesResource{}
+	_ resource.ResourceWithIdentity       = &routingTableRoutesResource{}
+	_ resource.ResourceWithModifyPlan     = &routingTableRoutesResource{}
+	_ resource.ResourceWithValidateConfig = &routingTableRoutesResource{}
+)
+
+var resourceIdentity = utils.Identity{"organization_id", "region", "network_area_id", "routing_table_id"}
+
+type Model struct {
+	Id             types.String `tfsdk:"id"` // needed by TF
+	OrganizationId types.String `tfsdk:"organization_id"`
+	NetworkAreaId  types.String `tfsdk:"network_area_id"`
+	RoutingTableId types.String `tfsdk:"routing_table_id"`
+	Region         types.String `tfsdk:"region"`
+	Routes         types.Set    `tfsdk:"routes"`
+}
+
+// routeModel is the struct corresponding to Model.Routes
+type routeModel struct {
+	Destination types.Object `tfsdk:"destination"`
+	NextHop     types.Object `tfsdk:"next_hop"`
+	Labels      types.Map    `tfsdk:"labels"`
+}
+
+// routeTypes Types corresponding to routeModel
+var routeTypes = map[string]attr.Type{
+	"destination": types.ObjectType{AttrTypes: shared.RouteDestinationTypes},
+	"next_hop":    types.ObjectType{AttrTypes: shared.RouteNextHopTypes},
+	"labels":      types.MapType{ElemType: types.StringType},
+}
+
+// routeUpdate is a route, whose labels are updated in place.
+type routeUpdate struct {
+	routeId string
+	payload *iaasalpha.UpdateRouteOfRoutingTablePayload
+}
+
+// routeChanges are the API calls needed to get from the current to the desired routes.
+type routeChanges struct {
+	delete []string
+	update []routeUpdate
+	add    []routeModel
+}
+
+// NewRoutingTableRoutesResource is a helper function to simplify the provider implementation.
+func NewRoutingTableRoutesResource() resource.Resource {
+	return &routingTableRoutesResource{}
+}
+
+// routingTableRoutesResource is the resource implementation.
+type routingTableRoutesResource struct {
+	client       *iaasalpha.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *routingTableRoutesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routing_table_routes"
+}
+
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *routingTableRoutesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *routingTableRoutesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckExperimentEnabled(ctx, &r.providerData, features.RoutingTablesExperiment, "stackit_routing_table_routes", core.Resource, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := iaasalphaUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "IaaS alpha client configured")
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *routingTableRoutesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+
+	var configModel Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *routingTableRoutesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := "Routing table routes resource schema. Manages all routes of a routing table at once, routes which aren't configured are deleted. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		Description: description,
+		MarkdownDescription: features.AddExperimentDescription(
+			description+"\n\n~> Don't use this resource together with `stackit_routing_table_route` resources for the same routing table, they would delete each others routes.",
+			features.RoutingTablesExperiment,
+			core.Resource,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`organization_id`,`region`,`network_area_id`,`routing_table_id`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "STACKIT organization ID to which the routing table is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"network_area_id": schema.StringAttribute{
+				Description: "The network area ID to which the routing table is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"routing_table_id": schema.StringAttribute{
+				Description: "The routing tables ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "The resource region. If not defined, the provider region is used.",
+				Optional:    true,
+				// must be computed to allow for storing the override value from the provider
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"routes": schema.SetNestedAttribute{
+				Description: "The routes of the routing table. The order of the routes doesn't matter.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"destination": schema.SingleNestedAttribute{
+							Description: "Destination of the route.",
+							Required:    true,
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									Description: fmt.Sprintf("CIDRV type. %s %s", utils.FormatPossibleValues("cidrv4", "cidrv6"), "Only `cidrv4` is supported during experimental stage."),
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("cidrv4", "cidrv6"),
+									},
+								},
+								"value": schema.StringAttribute{
+									Description: "An CIDR string.",
+									Required:    true,
+									Validators: []validator.String{
+										validate.CIDR(),
+									},
+								},
+							},
+						},
+						"next_hop": schema.SingleNestedAttribute{
+							Description: "Next hop destination.",
+							Required:    true,
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									Description: "Type of the next hop. " + utils.FormatPossibleValues("blackhole", "internet", "ipv4", "ipv6"),
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("blackhole", "internet", "ipv4", "ipv6"),
+									},
+								},
+								"value": schema.StringAttribute{
+									Description: "Either IPv4 or IPv6 (not set for blackhole and internet). Only IPv4 supported during experimental stage.",
+									Optional:    true,
+									Validators: []validator.String{
+										validate.IP(false),
+									},
+								},
+							},
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels are key-value string pairs which can be attached to a resource container",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig validates each route and makes sure that no destination is routed twice.
+func (r *routingTableRoutesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if model.Routes.IsNull() || model.Routes.IsUnknown() {
+		return
+	}
+
+	destinations := map[string]bool{}
+	for _, element := range model.Routes.Elements() {
+		route, ok := element.(types.Object)
+		if !ok || route.IsUnknown() {
+			continue
+		}
+		var routeConfig routeModel
+		diags := route.As(ctx, &routeConfig, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			continue
+		}
+		destination, err := validateRoute(ctx, &routeConfig)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Invalid route", err.Error())
+			continue
+		}
+		if destination == "" {
+			continue
+		}
+		if destinations[destination] {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Invalid route", fmt.Sprintf("Destination %q is routed more than once.", destination))
+		}
+		destinations[destination] = true
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *routingTableRoutesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+	ctx = r.setLogFields(ctx, &model)
+
+	err := r.applyRoutes(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating routing table routes", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Routing table routes created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *routingTableRoutesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+	ctx = r.setLogFields(ctx, &model)
+
+	organizationId := model.OrganizationId.ValueString()
+	networkAreaId := model.NetworkAreaId.ValueString()
+	routingTableId := model.RoutingTableId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+
+	routesResp, err := r.client.ListRoutesOfRoutingTable(ctx, organizationId, networkAreaId, region, routingTableId).Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading routing table routes",
+			fmt.Sprintf("Routing table with ID %q in network area with ID %q does not exist in organization %q.", routingTableId, networkAreaId, organizationId),
+			nil,
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapFields(ctx, routesResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading routing table routes", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Routing table routes read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *routingTableRoutesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+	ctx = r.setLogFields(ctx, &model)
+
+	err := r.applyRoutes(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating routing table routes", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Routing table routes updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *routingTableRoutesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+	ctx = r.setLogFields(ctx, &model)
+
+	organizationId := model.OrganizationId.ValueString()
+	networkAreaId := model.NetworkAreaId.ValueString()
+	routingTableId := model.RoutingTableId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+
+	routesResp, err := r.client.ListRoutesOfRoutingTable(ctx, organizationId, networkAreaId, region, routingTableId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting routing table routes", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	// Only the routes in the state are deleted, routes added in the meantime are kept
+	routes, err := toRouteModels(ctx, model.Routes)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting routing table routes", fmt.Sprintf("Converting routes: %v", err))
+		return
+	}
+	managedRoutes := map[string]bool{}
+	for i := range routes {
+		key, err := routeKeyFromModel(&routes[i])
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting routing table routes", fmt.Sprintf("Converting routes: %v", err))
+			return
+		}
+		managedRoutes[key] = true
+	}
+	for i := range routesResp.GetItems() {
+		route := routesResp.GetItems()[i]
+		key, err := routeKeyFromResponse(&route)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting routing table routes", fmt.Sprintf("Processing API payload: %v", err))
+			return
+		}
+		if !managedRoutes[key] {
+			continue
+		}
+		err = r.client.DeleteRouteFromRoutingTable(ctx, organizationId, networkAreaId, region, routingTableId, route.GetId()).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting routing table routes", fmt.Sprintf("Deleting route %q: %v", route.GetId(), err))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Routing table routes deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the routing table routes resource import identifier is: organization_id,region,network_area_id,routing_table_id
+func (r *routingTableRoutesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
+
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing routing table routes",
+			fmt.Sprintf("Expected import identifier with format: [organization_id],[region],[network_area_id],[routing_table_id]  Got: %q", importId),
+		)
+		return
+	}
+
+	organizationId := idParts[0]
+	region := idParts[1]
+	networkAreaId := idParts[2]
+	routingTableId := idParts[3]
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+	ctx = tflog.SetField(ctx, "routing_table_id", routingTableId)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_area_id"), networkAreaId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("routing_table_id"), routingTableId)...)
+	tflog.Info(ctx, "Routing table routes state imported")
+}
+
+func (r *routingTableRoutesResource) setLogFields(ctx context.Context, model *Model) context.Context {
+	ctx = tflog.SetField(ctx, "organization_id", model.OrganizationId.ValueString())
+	ctx = tflog.SetField(ctx, "network_area_id", model.NetworkAreaId.ValueString())
+	ctx = tflog.SetField(ctx, "routing_table_id", model.RoutingTableId.ValueString())
+	ctx = tflog.SetField(ctx, "region", r.providerData.GetRegionWithOverride(model.Region))
+	return ctx
+}
+
+// applyRoutes makes the routes of the routing table match the routes of the model and maps the resulting routes to the model.
+// Routes are deleted before new routes are added, so that a destination can be routed to a new next hop.
+// New routes are added with a single request.
+func (r *routingTableRoutesResource) applyRoutes(ctx context.Context, model *Model) error {
+	organizationId := model.OrganizationId.ValueString()
+	networkAreaId := model.NetworkAreaId.ValueString()
+	routingTableId := model.RoutingTableId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+
+	desiredRoutes, err := toRouteModels(ctx, model.Routes)
+	if err != nil {
+		return fmt.Errorf("converting routes: %w", err)
+	}
+
+	currentRoutes, err := r.client.ListRoutesOfRoutingTable(ctx, organizationId, networkAreaId, region, routingTableId).Execute()
+	if err != nil {
+		return fmt.Errorf("listing routes: %w", err)
+	}
+	changes, err := computeRouteChanges(ctx, currentRoutes.GetItems(), desiredRoutes)
+	if err != nil {
+		return fmt.Errorf("computing route changes: %w", err)
+	}
+
+	for _, routeId := range changes.delete {
+		err = r.client.DeleteRouteFromRoutingTable(ctx, organizationId, networkAreaId, region, routingTableId, routeId).Execute()
+		if err != nil {
+			return fmt.Errorf("deleting route %q: %w", routeId, err)
+		}
+	}
+	for _, update := range changes.update {
+		_, err = r.client.UpdateRouteOfRoutingTable(ctx, organizationId, networkAreaId, region, routingTableId, update.routeId).UpdateRouteOfRoutingTablePayload(*update.payload).Execute()
+		if err != nil {
+			return fmt.Errorf("updating route %q: %w", update.routeId, err)
+		}
+	}
+	if len(changes.add) > 0 {
+		payload, err := toAddPayload(ctx, changes.add)
+		if err != nil {
+			return fmt.Errorf("creating API payload: %w", err)
+		}
+		_, err = r.client.AddRoutesToRoutingTable(ctx, organizationId, networkAreaId, region, routingTableId).AddRoutesToRoutingTablePayload(*payload).Execute()
+		if err != nil {
+			return fmt.Errorf("adding routes: %w", err)
+		}
+	}
+
+	routesResp, err := r.client.ListRoutesOfRoutingTable(ctx, organizationId, networkAreaId, region, routingTableId).Execute()
+	if err != nil {
+		return fmt.Errorf("listing routes: %w", err)
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapFields(ctx, routesResp, model, region)
+	if err != nil {
+		return fmt.Errorf("processing API payload: %w", err)
+	}
+	return nil
+}
+
+// validateRoute checks that the destination and the next hop of the route match their types and returns the destination.
+// Unknown values are skipped, the returned destination is empty if it isn't known yet.
+func validateRoute(ctx context.Context, route *routeModel) (string, error) {
+	var destination shared.RouteDestination
+	if !utils.IsUndefined(route.Destination) {
+		diags := route.Destination.As(ctx, &destination, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return "", core.DiagsToError(diags)
+		}
+	}
+	var nextHop shared.RouteNextHop
+	if !utils.IsUndefined(route.NextHop) {
+		diags := route.NextHop.As(ctx, &nextHop, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return "", core.DiagsToError(diags)
+		}
+	}
+
+	destinationValue := ""
+	if !utils.IsUndefined(destination.Type) && !utils.IsUndefined(destination.Value) {
+		prefix, err := netip.ParsePrefix(destination.Value.ValueString())
+		if err != nil {
+			return "", fmt.Errorf("destination %q is not in CIDR notation", destination.Value.ValueString())
+		}
+		if destination.Type.ValueString() == "cidrv4" && !prefix.Addr().Is4() {
+			return "", fmt.Errorf("destination %q of type cidrv4 is not an IPv4 CIDR", destination.Value.ValueString())
+		}
+		if destination.Type.ValueString() == "cidrv6" && !prefix.Addr().Is6() {
+			return "", fmt.Errorf("destination %q of type cidrv6 is not an IPv6 CIDR", destination.Value.ValueString())
+		}
+		destinationValue = destination.Value.ValueString()
+	}
+
+	if utils.IsUndefined(nextHop.Type) || nextHop.Value.IsUnknown() {
+		return destinationValue, nil
+	}
+	switch nextHop.Type.ValueString() {
+	case "blackhole", "internet":
+		if !nextHop.Value.IsNull() {
+			return "", fmt.Errorf("next hop of type %s to %q must not have a value", nextHop.Type.ValueString(), destinationValue)
+		}
+	case "ipv4", "ipv6":
+		if nextHop.Value.IsNull() {
+			return "", fmt.Errorf("next hop of type %s to %q must have a value", nextHop.Type.ValueString(), destinationValue)
+		}
+		addr, err := netip.ParseAddr(nextHop.Value.ValueString())
+		if err != nil {
+			return "", fmt.Errorf("next hop %q is not an IP address", nextHop.Value.ValueString())
+		}
+		if nextHop.Type.ValueString() == "ipv4" && !addr.Is4() {
+			return "", fmt.Errorf("next hop %q of type ipv4 is not an IPv4 address", nextHop.Value.ValueString())
+		}
+		if nextHop.Type.ValueString() == "ipv6" && !addr.Is6() {
+			return "", fmt.Errorf("next hop %q of type ipv6 is not an IPv6 address", nextHop.Value.ValueString())
+		}
+	}
+	return destinationValue, nil
+}
+
+// computeRouteChanges compares the current routes of the routing table with the desired routes.
+// Routes are identified by their destination and next hop, labels are updated in place.
+func computeRouteChanges(ctx context.Context, current []iaasalpha.Route, desired []routeModel) (*routeChanges, error) {
+	desiredRoutes := map[string]*routeModel{}
+	for i := range desired {
+		key, err := routeKeyFromModel(&desired[i])
+		if err != nil {
+			return nil, err
+		}
+		desiredRoutes[key] = &desired[i]
+	}
+
+	changes := &routeChanges{}
+	existingRoutes := map[string]bool{}
+	for i := range current {
+		route := &current[i]
+		key, err := routeKeyFromResponse(route)
+		if err != nil {
+			return nil, err
+		}
+		desiredRoute, ok := desiredRoutes[key]
+		if !ok || existingRoutes[key] {
+			changes.delete = append(changes.delete, route.GetId())
+			continue
+		}
+		existingRoutes[key] = true
+
+		currentLabels, err := iaasUtils.MapLabels(ctx, route.Labels, types.MapNull(types.StringType))
+		if err != nil {
+			return nil, err
+		}
+		desiredLabels, err := conversion.ToStringInterfaceMap(ctx, desiredRoute.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("converting labels: %w", err)
+		}
+		if labelsEqual(route.Labels, desiredLabels) {
+			continue
+		}
+		labels, err := conversion.ToJSONMapPartialUpdatePayload(ctx, currentLabels, desiredRoute.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("converting labels: %w", err)
+		}
+		changes.update = append(changes.update, routeUpdate{
+			routeId: route.GetId(),
+			payload: &iaasalpha.UpdateRouteOfRoutingTablePayload{
+				Labels: &labels,
+			},
+		})
+	}
+
+	for i := range desired {
+		key, err := routeKeyFromModel(&desired[i])
+		if err != nil {
+			return nil, err
+		}
+		if !existingRoutes[key] {
+			changes.add = append(changes.add, desired[i])
+		}
+	}
+	return changes, nil
+}
+
+func labelsEqual(current *map[string]interface{}, desired map[string]interface{}) bool {
+	currentLabels := map[string]interface{}{}
+	if current != nil {
+		currentLabels = *current
+	}
+	if len(currentLabels) != len(desired) {
+		return false
+	}
+	for k, v := range desired {
+		if currentValue, ok := currentLabels[k]; !ok || fmt.Sprint(currentValue) != fmt.Sprint(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func routeKey(destination, nextHop types.Object) (string, error) {
+	destinationAttributes := destination.Attributes()
+	nextHopAttributes := nextHop.Attributes()
+	destinationType, ok1 := destinationAttributes["type"].(types.String)
+	destinationValue, ok2 := destinationAttributes["value"].(types.String)
+	nextHopType, ok3 := nextHopAttributes["type"].(types.String)
+	nextHopValue, ok4 := nextHopAttributes["value"].(types.String)
+	if !ok1 || !ok2 || !ok3 || !ok4 {
+		return "", fmt.Errorf("route destination or next hop not present")
+	}
+	return strings.Join([]string{
+		destinationType.ValueString(),
+		destinationValue.ValueString(),
+		nextHopType.ValueString(),
+		nextHopValue.ValueString(),
+	}, core.Separator), nil
+}
+
+func routeKeyFromModel(route *routeModel) (string, error) {
+	return routeKey(route.Destination, route.NextHop)
+}
+
+func routeKeyFromResponse(route *iaasalpha.Route) (string, error) {
+	destination, err := shared.MapRouteDestination(route)
+	if err != nil {
+		return "", fmt.Errorf("mapping route destination: %w", err)
+	}
+	nextHop, err := shared.MapRouteNextHop(route)
+	if err != nil {
+		return "", fmt.Errorf("mapping route next hop: %w", err)
+	}
+	return routeKey(destination, nextHop)
+}
+
+func toRouteModels(ctx context.Context, routes types.Set) ([]routeModel, error) {
+	models := []routeModel{}
+	if routes.IsNull() || routes.IsUnknown() {
+		return models, nil
+	}
+	diags := routes.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+	return models, nil
+}
+
+func mapFields(ctx context.Context, routesResp *iaasalpha.RouteListResponse, model *Model, region string) error {
+	if routesResp == nil || routesResp.Items == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	// The labels of the prior routes are needed to keep null labels, if the API returns no labels
+	priorRoutes, err := toRouteModels(ctx, model.Routes)
+	if err != nil {
+		return fmt.Errorf("converting prior routes: %w", err)
+	}
+	priorLabels := map[string]types.Map{}
+	for i := range priorRoutes {
+		key, err := routeKeyFromModel(&priorRoutes[i])
+		if err != nil {
+			return err
+		}
+		priorLabels[key] = priorRoutes[i].Labels
+	}
+
+	routes := []attr.Value{}
+	for i := range *routesResp.Items {
+		route := &(*routesResp.Items)[i]
+		destination, err := shared.MapRouteDestination(route)
+		if err != nil {
+			return fmt.Errorf("mapping route destination: %w", err)
+		}
+		nextHop, err := shared.MapRouteNextHop(route)
+		if err != nil {
+			return fmt.Errorf("mapping route next hop: %w", err)
+		}
+		key, err := routeKey(destination, nextHop)
+		if err != nil {
+			return err
+		}
+		currentLabels, ok := priorLabels[key]
+		if !ok {
+			currentLabels = types.MapNull(types.StringType)
+		}
+		labels, err := iaasUtils.MapLabels(ctx, route.Labels, currentLabels)
+		if err != nil {
+			return err
+		}
+
+		routeTF, diags := types.ObjectValue(routeTypes, map[string]attr.Value{
+			"destination": destination,
+			"next_hop":    nextHop,
+			"labels":      labels,
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+		}
+		routes = append(routes, routeTF)
+	}
+
+	routesTF, diags := types.SetValue(types.ObjectType{AttrTypes: routeTypes}, routes)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.OrganizationId.ValueString(), region, model.NetworkAreaId.ValueString(), model.RoutingTableId.ValueString())
+	model.Region = types.StringValue(region)
+	model.Routes = routesTF
+	return nil
+}
+
+func toAddPayload(ctx context.Context, routes []routeModel) (*iaasalpha.AddRoutesToRoutingTablePayload, error) {
+	items := []iaasalpha.Route{}
+	for i := range routes {
+		route := &shared.RouteReadModel{
+			Destination: routes[i].Destination,
+			NextHop:     routes[i].NextHop,
+		}
+		labels, err := conversion.ToStringInterfaceMap(ctx, routes[i].Labels)
+		if err != nil {
+			return nil, fmt.Errorf("converting to Go map: %w", err)
+		}
+		nextHop, err := shared.ToNextHopPayload(ctx, route)
+		if err != nil {
+			return nil, err
+		}
+		destination, err := shared.ToDestinationPayload(ctx, route)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, iaasalpha.Route{
+			Labels:      &labels,
+			Nexthop:     nextHop,
+			Destination: destination,
+		})
+	}
+	return &iaasalpha.AddRoutesToRoutingTablePayload{
+		Items: &items,
+	}, nil
+}
@@ -0,0 +1,370 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaasalpha"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/routingtable/shared"
+)
+
+func testRoute(destinationType, destination, nextHopType string, nextHop types.String, labels types.Map) routeModel {
+	return routeModel{
+		Destination: types.ObjectValueMust(shared.RouteDestinationTypes, map[string]attr.Value{
+			"type":  types.StringValue(destinationType),
+			"value": types.StringValue(destination),
+		}),
+		NextHop: types.ObjectValueMust(shared.RouteNextHopTypes, map[string]attr.Value{
+			"type":  types.StringValue(nextHopType),
+			"value": nextHop,
+		}),
+		Labels: labels,
+	}
+}
+
+func testRouteObject(route routeModel) types.Object {
+	return types.ObjectValueMust(routeTypes, map[string]attr.Value{
+		"destination": route.Destination,
+		"next_hop":    route.NextHop,
+		"labels":      route.Labels,
+	})
+}
+
+func testRouteSet(routes ...routeModel) types.Set {
+	elements := []attr.Value{}
+	for _, route := range routes {
+		elements = append(elements, testRouteObject(route))
+	}
+	return types.SetValueMust(types.ObjectType{AttrTypes: routeTypes}, elements)
+}
+
+func testRouteResponse(id, destination string, nextHop *iaasalpha.RouteNexthop, labels *map[string]interface{}) iaasalpha.Route {
+	return iaasalpha.Route{
+		Id:          utils.Ptr(id),
+		Destination: utils.Ptr(iaasalpha.DestinationCIDRv4AsRouteDestination(iaasalpha.NewDestinationCIDRv4("cidrv4", destination))),
+		Nexthop:     nextHop,
+		Labels:      labels,
+	}
+}
+
+func ipv4NextHop(value string) *iaasalpha.RouteNexthop {
+	return utils.Ptr(iaasalpha.NexthopIPv4AsRouteNexthop(iaasalpha.NewNexthopIPv4("ipv4", value)))
+}
+
+func TestMapFields(t *testing.T) {
+	const region = "eu01"
+	id := fmt.Sprintf("%s,%s,%s,%s", testOrganizationId, region, testNetworkAreaId, testRoutingTableId)
+	tests := []struct {
+		description string
+		state       Model
+		input       *iaasalpha.RouteListResponse
+		expected    Model
+		isValid     bool
+	}{
+		{
+			description: "default_values",
+			state: Model{
+				OrganizationId: types.StringValue(testOrganizationId),
+				NetworkAreaId:  types.StringValue(testNetworkAreaId),
+				RoutingTableId: types.StringValue(testRoutingTableId),
+				Routes:         types.SetNull(types.ObjectType{AttrTypes: routeTypes}),
+			},
+			input: &iaasalpha.RouteListResponse{
+				Items: &[]iaasalpha.Route{},
+			},
+			expected: Model{
+				Id:             types.StringValue(id),
+				OrganizationId: types.StringValue(testOrganizationId),
+				NetworkAreaId:  types.StringValue(testNetworkAreaId),
+				RoutingTableId: types.StringValue(testRoutingTableId),
+				Region:         types.StringValue(region),
+				Routes:         testRouteSet(),
+			},
+			isValid: true,
+		},
+		{
+			description: "values_ok",
+			state: Model{
+				OrganizationId: types.StringValue(testOrganizationId),
+				NetworkAreaId:  types.StringValue(testNetworkAreaId),
+				RoutingTableId: types.StringValue(testRoutingTableId),
+				Routes: testRouteSet(
+					testRoute("cidrv4", "10.0.0.0/24", "ipv4", types.StringValue("192.168.0.1"), types.MapNull(types.StringType)),
+				),
+			},
+			input: &iaasalpha.RouteListResponse{
+				Items: &[]iaasalpha.Route{
+					testRouteResponse(testRouteId1, "10.0.0.0/24", ipv4NextHop("192.168.0.1"), &map[string]interface{}{}),
+					testRouteResponse(testRouteId2, "0.0.0.0/0", utils.Ptr(iaasalpha.NexthopInternetAsRouteNexthop(iaasalpha.NewNexthopInternet("internet"))), &map[string]interface{}{
+						"key": "value",
+					}),
+				},
+			},
+			expected: Model{
+				Id:             types.StringValue(id),
+				OrganizationId: types.StringValue(testOrganizationId),
+				NetworkAreaId:  types.StringValue(testNetworkAreaId),
+				RoutingTableId: types.StringValue(testRoutingTableId),
+				Region:         types.StringValue(region),
+				Routes: testRouteSet(
+					testRoute("cidrv4", "10.0.0.0/24", "ipv4", types.StringValue("192.168.0.1"), types.MapNull(types.StringType)),
+					testRoute("cidrv4", "0.0.0.0/0", "internet", types.StringNull(), types.MapValueMust(types.StringType, map[string]attr.Value{
+						"key": types.StringValue("value"),
+					})),
+				),
+			},
+			isValid: true,
+		},
+		{
+			description: "response_nil_fail",
+			state:       Model{},
+			input:       nil,
+			isValid:     false,
+		},
+		{
+			description: "items_nil_fail",
+			state:       Model{},
+			input:       &iaasalpha.RouteListResponse{},
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(context.Background(), tt.input, &tt.state, region)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateRoute(t *testing.T) {
+	tests := []struct {
+		description         string
+		input               routeModel
+		expectedDestination string
+		isValid             bool
+	}{
+		{
+			description:         "ipv4 next hop",
+			input:               testRoute("cidrv4", "10.0.0.0/24", "ipv4", types.StringValue("192.168.0.1"), types.MapNull(types.StringType)),
+			expectedDestination: "10.0.0.0/24",
+			isValid:             true,
+		},
+		{
+			description:         "internet next hop",
+			input:               testRoute("cidrv4", "0.0.0.0/0", "internet", types.StringNull(), types.MapNull(types.StringType)),
+			expectedDestination: "0.0.0.0/0",
+			isValid:             true,
+		},
+		{
+			description:         "ipv6",
+			input:               testRoute("cidrv6", "2001:db8::/32", "ipv6", types.StringValue("2001:db8::1"), types.MapNull(types.StringType)),
+			expectedDestination: "2001:db8::/32",
+			isValid:             true,
+		},
+		{
+			description:         "unknown next hop value",
+			input:               testRoute("cidrv4", "10.0.0.0/24", "ipv4", types.StringUnknown(), types.MapNull(types.StringType)),
+			expectedDestination: "10.0.0.0/24",
+			isValid:             true,
+		},
+		{
+			description: "destination family mismatch",
+			input:       testRoute("cidrv4", "2001:db8::/32", "blackhole", types.StringNull(), types.MapNull(types.StringType)),
+			isValid:     false,
+		},
+		{
+			description: "destination not a cidr",
+			input:       testRoute("cidrv4", "10.0.0.1", "blackhole", types.StringNull(), types.MapNull(types.StringType)),
+			isValid:     false,
+		},
+		{
+			description: "next hop value missing",
+			input:       testRoute("cidrv4", "10.0.0.0/24", "ipv4", types.StringNull(), types.MapNull(types.StringType)),
+			isValid:     false,
+		},
+		{
+			description: "next hop value not allowed",
+			input:       testRoute("cidrv4", "10.0.0.0/24", "blackhole", types.StringValue("192.168.0.1"), types.MapNull(types.StringType)),
+			isValid:     false,
+		},
+		{
+			description: "next hop family mismatch",
+			input:       testRoute("cidrv4", "10.0.0.0/24", "ipv4", types.StringValue("2001:db8::1"), types.MapNull(types.StringType)),
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			destination, err := validateRoute(context.Background(), &tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid && destination != tt.expectedDestination {
+				t.Fatalf("Destination does not match: expected %q, got %q", tt.expectedDestination, destination)
+			}
+		})
+	}
+}
+
+func TestComputeRouteChanges(t *testing.T) {
+	tests := []struct {
+		description string
+		current     []iaasalpha.Route
+		desired     []routeModel
+		expected    *routeChanges
+	}{
+		{
+			description: "no changes",
+			current: []iaasalpha.Route{
+				testRouteResponse(testRouteId1, "10.0.0.0/24", ipv4NextHop("192.168.0.1"), &map[string]interface{}{"key": "value"}),
+			},
+			desired: []routeModel{
+				testRoute("cidrv4", "10.0.0.0/24", "ipv4", types.StringValue("192.168.0.1"), types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				})),
+			},
+			expected: &routeChanges{},
+		},
+		{
+			description: "add and delete",
+			current: []iaasalpha.Route{
+				testRouteResponse(testRouteId1, "10.0.0.0/24", ipv4NextHop("192.168.0.1"), nil),
+			},
+			desired: []routeModel{
+				testRoute("cidrv4", "10.0.0.0/24", "ipv4", types.StringValue("192.168.0.2"), types.MapNull(types.StringType)),
+			},
+			expected: &routeChanges{
+				delete: []string{testRouteId1},
+				add: []routeModel{
+					testRoute("cidrv4", "10.0.0.0/24", "ipv4", types.StringValue("192.168.0.2"), types.MapNull(types.StringType)),
+				},
+			},
+		},
+		{
+			description: "update labels",
+			current: []iaasalpha.Route{
+				testRouteResponse(testRouteId1, "10.0.0.0/24", ipv4NextHop("192.168.0.1"), &map[string]interface{}{"key": "value"}),
+			},
+			desired: []routeModel{
+				testRoute("cidrv4", "10.0.0.0/24", "ipv4", types.StringValue("192.168.0.1"), types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key2": types.StringValue("value2"),
+				})),
+			},
+			expected: &routeChanges{
+				update: []routeUpdate{
+					{
+						routeId: testRouteId1,
+						payload: &iaasalpha.UpdateRouteOfRoutingTablePayload{
+							Labels: &map[string]interface{}{
+								"key":  nil,
+								"key2": "value2",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "duplicate route is deleted",
+			current: []iaasalpha.Route{
+				testRouteResponse(testRouteId1, "10.0.0.0/24", ipv4NextHop("192.168.0.1"), nil),
+				testRouteResponse(testRouteId2, "10.0.0.0/24", ipv4NextHop("192.168.0.1"), nil),
+			},
+			desired: []routeModel{
+				testRoute("cidrv4", "10.0.0.0/24", "ipv4", types.StringValue("192.168.0.1"), types.MapNull(types.StringType)),
+			},
+			expected: &routeChanges{
+				delete: []string{testRouteId2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := computeRouteChanges(context.Background(), tt.current, tt.desired)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(output, tt.expected, cmp.AllowUnexported(routeChanges{}, routeUpdate{}, routeModel{}))
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestToAddPayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []routeModel
+		expected    *iaasalpha.AddRoutesToRoutingTablePayload
+		isValid     bool
+	}{
+		{
+			description: "default_ok",
+			input: []routeModel{
+				testRoute("cidrv4", "10.0.0.0/24", "ipv4", types.StringValue("192.168.0.1"), types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				})),
+				testRoute("cidrv4", "0.0.0.0/0", "blackhole", types.StringNull(), types.MapNull(types.StringType)),
+			},
+			expected: &iaasalpha.AddRoutesToRoutingTablePayload{
+				Items: &[]iaasalpha.Route{
+					{
+						Labels:      &map[string]interface{}{"key": "value"},
+						Nexthop:     ipv4NextHop("192.168.0.1"),
+						Destination: utils.Ptr(iaasalpha.DestinationCIDRv4AsRouteDestination(iaasalpha.NewDestinationCIDRv4("cidrv4", "10.0.0.0/24"))),
+					},
+					{
+						Labels:      &map[string]interface{}{},
+						Nexthop:     utils.Ptr(iaasalpha.NexthopBlackholeAsRouteNexthop(iaasalpha.NewNexthopBlackhole("blackhole"))),
+						Destination: utils.Ptr(iaasalpha.DestinationCIDRv4AsRouteDestination(iaasalpha.NewDestinationCIDRv4("cidrv4", "0.0.0.0/0"))),
+					},
+				},
+			},
+			isValid: true,
+		},
+		{
+			description: "invalid next hop type",
+			input: []routeModel{
+				testRoute("cidrv4", "10.0.0.0/24", "foo", types.StringNull(), types.MapNull(types.StringType)),
+			},
+			isValid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toAddPayload(context.Background(), tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -8,9 +8,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	sdkUtils "github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaasalpha"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 )
 
 type RouteReadModel struct {
@@ -211,3 +214,55 @@ func MapRouteDestination(routeResp *iaasalpha.Route) (types.Object, error) {
 
 	return destinationTF, nil
 }
+
+// ToNextHopPayload converts the next hop of the route into the API representation.
+func ToNextHopPayload(ctx context.Context, model *RouteReadModel) (*iaasalpha.RouteNexthop, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+	if utils.IsUndefined(model.NextHop) {
+		return nil, nil
+	}
+
+	nexthopModel := RouteNextHop{}
+	diags := model.NextHop.As(ctx, &nexthopModel, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+
+	switch nexthopModel.Type.ValueString() {
+	case "blackhole":
+		return sdkUtils.Ptr(iaasalpha.NexthopBlackholeAsRouteNexthop(iaasalpha.NewNexthopBlackhole("blackhole"))), nil
+	case "internet":
+		return sdkUtils.Ptr(iaasalpha.NexthopInternetAsRouteNexthop(iaasalpha.NewNexthopInternet("internet"))), nil
+	case "ipv4":
+		return sdkUtils.Ptr(iaasalpha.NexthopIPv4AsRouteNexthop(iaasalpha.NewNexthopIPv4("ipv4", nexthopModel.Value.ValueString()))), nil
+	case "ipv6":
+		return sdkUtils.Ptr(iaasalpha.NexthopIPv6AsRouteNexthop(iaasalpha.NewNexthopIPv6("ipv6", nexthopModel.Value.ValueString()))), nil
+	}
+	return nil, fmt.Errorf("unknown nexthop type: %s", nexthopModel.Type.ValueString())
+}
+
+// ToDestinationPayload converts the destination of the route into the API representation.
+func ToDestinationPayload(ctx context.Context, model *RouteReadModel) (*iaasalpha.RouteDestination, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+	if utils.IsUndefined(model.Destination) {
+		return nil, nil
+	}
+
+	destinationModel := RouteDestination{}
+	diags := model.Destination.As(ctx, &destinationModel, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+
+	switch destinationModel.Type.ValueString() {
+	case "cidrv4":
+		return sdkUtils.Ptr(iaasalpha.DestinationCIDRv4AsRouteDestination(iaasalpha.NewDestinationCIDRv4("cidrv4", destinationModel.Value.ValueString()))), nil
+	case "cidrv6":
+		return sdkUtils.Ptr(iaasalpha.DestinationCIDRv6AsRouteDestination(iaasalpha.NewDestinationCIDRv6("cidrv6", destinationModel.Value.ValueString()))), nil
+	}
+	return nil, fmt.Errorf("unknown destination type: %s", destinationModel.Type.ValueString())
+}
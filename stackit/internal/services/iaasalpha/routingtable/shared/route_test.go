@@ -3,6 +3,7 @@ package shared
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
@@ -308,3 +309,159 @@ func TestMapRouteModel(t *testing.T) {
 		})
 	}
 }
+
+func TestToNextHopPayload(t *testing.T) {
+	type args struct {
+		model *RouteReadModel
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *iaasalpha.RouteNexthop
+		wantErr bool
+	}{
+		{
+			name: "model is nil",
+			args: args{
+				model: nil,
+			},
+			wantErr: true,
+		},
+		{
+			name: "ipv4",
+			args: args{
+				model: &RouteReadModel{
+					NextHop: types.ObjectValueMust(RouteNextHopTypes, map[string]attr.Value{
+						"type":  types.StringValue("ipv4"),
+						"value": types.StringValue("10.20.42.2"),
+					}),
+				},
+			},
+			wantErr: false,
+			want: utils.Ptr(iaasalpha.NexthopIPv4AsRouteNexthop(
+				iaasalpha.NewNexthopIPv4("ipv4", "10.20.42.2"),
+			)),
+		},
+		{
+			name: "ipv6",
+			args: args{
+				model: &RouteReadModel{
+					NextHop: types.ObjectValueMust(RouteNextHopTypes, map[string]attr.Value{
+						"type":  types.StringValue("ipv6"),
+						"value": types.StringValue("172b:f881:46fe:d89a:9332:90f7:3485:236d"),
+					}),
+				},
+			},
+			wantErr: false,
+			want: utils.Ptr(iaasalpha.NexthopIPv6AsRouteNexthop(
+				iaasalpha.NewNexthopIPv6("ipv6", "172b:f881:46fe:d89a:9332:90f7:3485:236d"),
+			)),
+		},
+		{
+			name: "internet",
+			args: args{
+				model: &RouteReadModel{
+					NextHop: types.ObjectValueMust(RouteNextHopTypes, map[string]attr.Value{
+						"type":  types.StringValue("internet"),
+						"value": types.StringNull(),
+					}),
+				},
+			},
+			wantErr: false,
+			want: utils.Ptr(iaasalpha.NexthopInternetAsRouteNexthop(
+				iaasalpha.NewNexthopInternet("internet"),
+			)),
+		},
+		{
+			name: "blackhole",
+			args: args{
+				model: &RouteReadModel{
+					NextHop: types.ObjectValueMust(RouteNextHopTypes, map[string]attr.Value{
+						"type":  types.StringValue("blackhole"),
+						"value": types.StringNull(),
+					}),
+				},
+			},
+			wantErr: false,
+			want: utils.Ptr(iaasalpha.NexthopBlackholeAsRouteNexthop(
+				iaasalpha.NewNexthopBlackhole("blackhole"),
+			)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			got, err := ToNextHopPayload(ctx, tt.args.model)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ToNextHopPayload() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToNextHopPayload() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToDestinationPayload(t *testing.T) {
+	type args struct {
+		model *RouteReadModel
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *iaasalpha.RouteDestination
+		wantErr bool
+	}{
+		{
+			name: "model is nil",
+			args: args{
+				model: nil,
+			},
+			wantErr: true,
+		},
+		{
+			name: "cidrv4",
+			args: args{
+				model: &RouteReadModel{
+					Destination: types.ObjectValueMust(RouteDestinationTypes, map[string]attr.Value{
+						"type":  types.StringValue("cidrv4"),
+						"value": types.StringValue("58.251.236.138/32"),
+					}),
+				},
+			},
+			wantErr: false,
+			want: utils.Ptr(iaasalpha.DestinationCIDRv4AsRouteDestination(
+				iaasalpha.NewDestinationCIDRv4("cidrv4", "58.251.236.138/32"),
+			)),
+		},
+		{
+			name: "cidrv6",
+			args: args{
+				model: &RouteReadModel{
+					Destination: types.ObjectValueMust(RouteDestinationTypes, map[string]attr.Value{
+						"type":  types.StringValue("cidrv6"),
+						"value": types.StringValue("2001:0db8:3c4d:1a2b::/64"),
+					}),
+				},
+			},
+			wantErr: false,
+			want: utils.Ptr(iaasalpha.DestinationCIDRv6AsRouteDestination(
+				iaasalpha.NewDestinationCIDRv6("cidrv6", "2001:0db8:3c4d:1a2b::/64"),
+			)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			got, err := ToDestinationPayload(ctx, tt.args.model)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ToDestinationPayload() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToDestinationPayload() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -81,7 +81,7 @@ func GetRouteDataSourceAttributes() map[string]schema.Attribute {
 func GetRoutesDataSourceAttributes() map[string]schema.Attribute {
 	getAttributes := datasourceGetAttributes()
 	getAttributes["id"] = schema.StringAttribute{
-		Description: "Terraform's internal datasource ID. It is structured as \"`organization_id`,`region`,`network_area_id`,`routing_table_id`,`route_id`\".",
+		Description: "Terraform's internal datasource ID. It is structured as \"`organization_id`,`region`,`network_area_id`,`routing_table_id`\".",
 		Computed:    true,
 	}
 	getAttributes["routes"] = schema.ListNestedAttribute{
@@ -19,7 +19,9 @@ func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags
 	if providerData.IaaSCustomEndpoint != "" {
 		apiClientConfigOptions = append(apiClientConfigOptions, config.WithEndpoint(providerData.IaaSCustomEndpoint))
 	}
-	apiClient, err := iaasalpha.NewAPIClient(apiClientConfigOptions...)
+	apiClient, err := core.CachedClient(providerData.Clients, "iaasalpha", "", providerData.IaaSCustomEndpoint, func() (*iaasalpha.APIClient, error) {
+		return iaasalpha.NewAPIClient(apiClientConfigOptions...)
+	})
 	if err != nil {
 		core.LogAndAddError(ctx, diags, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the resource configuration", err))
 		return nil
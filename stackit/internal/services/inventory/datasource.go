@@ -0,0 +1,631 @@
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/stackit-sdk-go/services/loadbalancer"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+	loadBalancerUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/loadbalancer/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &inventoryDataSource{}
+)
+
+type Model struct {
+	Id            types.String `tfsdk:"id"` // needed by TF
+	ProjectId     types.String `tfsdk:"project_id"`
+	Region        types.String `tfsdk:"region"`
+	Filter        types.Object `tfsdk:"filter"`
+	Servers       types.List   `tfsdk:"servers"`
+	Networks      types.List   `tfsdk:"networks"`
+	LoadBalancers types.List   `tfsdk:"load_balancers"`
+}
+
+type filterModel struct {
+	Labels types.Map `tfsdk:"labels"`
+}
+
+var nicTypes = map[string]attr.Type{
+	"network_id":   types.StringType,
+	"network_name": types.StringType,
+	"ipv4":         types.StringType,
+	"ipv6":         types.StringType,
+	"public_ip":    types.StringType,
+}
+
+var serverTypes = map[string]attr.Type{
+	"server_id":         types.StringType,
+	"name":              types.StringType,
+	"machine_type":      types.StringType,
+	"availability_zone": types.StringType,
+	"status":            types.StringType,
+	"labels":            types.MapType{ElemType: types.StringType},
+	"metadata":          types.StringType,
+	"nics":              types.ListType{ElemType: types.ObjectType{AttrTypes: nicTypes}},
+}
+
+var networkTypes = map[string]attr.Type{
+	"network_id":    types.StringType,
+	"name":          types.StringType,
+	"ipv4_prefixes": types.ListType{ElemType: types.StringType},
+	"ipv6_prefixes": types.ListType{ElemType: types.StringType},
+	"routed":        types.BoolType,
+	"labels":        types.MapType{ElemType: types.StringType},
+}
+
+var loadBalancerTypes = map[string]attr.Type{
+	"name":             types.StringType,
+	"external_address": types.StringType,
+	"private_address":  types.StringType,
+	"status":           types.StringType,
+	"labels":           types.MapType{ElemType: types.StringType},
+}
+
+// NewInventoryDataSource is a helper function to simplify the provider implementation.
+func NewInventoryDataSource() datasource.DataSource {
+	return &inventoryDataSource{}
+}
+
+// inventoryDataSource is the data source implementation.
+type inventoryDataSource struct {
+	iaasClient         *iaas.APIClient
+	loadBalancerClient *loadbalancer.APIClient
+	providerData       core.ProviderData
+}
+
+// Metadata returns the data source type name.
+func (d *inventoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory"
+}
+
+func (d *inventoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	d.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &d.providerData, &resp.Diagnostics, "stackit_inventory", "datasource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iaasClient := iaasUtils.ConfigureClient(ctx, &d.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.iaasClient = iaasClient
+
+	loadBalancerClient := loadBalancerUtils.ConfigureClient(ctx, &d.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.loadBalancerClient = loadBalancerClient
+	tflog.Info(ctx, "Inventory clients configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *inventoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Inventory data source. Aggregates the servers, networks and load balancers of a project in a region, e.g. to generate Ansible inventories from Terraform outputs."
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: features.AddBetaDescription(description, core.Datasource),
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`region`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "The resource region. If not defined, the provider region is used.",
+				Optional:    true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Description: "Filters the returned resources. Servers and networks are filtered by the API, load balancers by the provider.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"labels": schema.MapAttribute{
+						Description: "Only resources having all of these labels are returned.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			"servers": schema.ListNestedAttribute{
+				Description: "The servers of the project, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"server_id": schema.StringAttribute{
+							Description: "The server ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the server.",
+							Computed:    true,
+						},
+						"machine_type": schema.StringAttribute{
+							Description: "Name of the type of the machine for the server.",
+							Computed:    true,
+						},
+						"availability_zone": schema.StringAttribute{
+							Description: "The availability zone of the server.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the server.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of the server. Can be used to build inventory groups.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"metadata": schema.StringAttribute{
+							Description: "Metadata of the server as JSON string. Can be decoded with `jsondecode`.",
+							Computed:    true,
+						},
+						"nics": schema.ListNestedAttribute{
+							Description: "The network interfaces of the server.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"network_id": schema.StringAttribute{
+										Description: "The ID of the network the network interface is attached to.",
+										Computed:    true,
+									},
+									"network_name": schema.StringAttribute{
+										Description: "The name of the network the network interface is attached to.",
+										Computed:    true,
+									},
+									"ipv4": schema.StringAttribute{
+										Description: "The private IPv4 address of the network interface.",
+										Computed:    true,
+									},
+									"ipv6": schema.StringAttribute{
+										Description: "The private IPv6 address of the network interface.",
+										Computed:    true,
+									},
+									"public_ip": schema.StringAttribute{
+										Description: "The public IP address associated with the network interface.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"networks": schema.ListNestedAttribute{
+				Description: "The networks of the project, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"network_id": schema.StringAttribute{
+							Description: "The network ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the network.",
+							Computed:    true,
+						},
+						"ipv4_prefixes": schema.ListAttribute{
+							Description: "The IPv4 prefixes of the network.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"ipv6_prefixes": schema.ListAttribute{
+							Description: "The IPv6 prefixes of the network.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"routed": schema.BoolAttribute{
+							Description: "Shows if the network is routed and therefore accessible from other networks.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of the network.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"load_balancers": schema.ListNestedAttribute{
+				Description: "The load balancers of the project, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the load balancer.",
+							Computed:    true,
+						},
+						"external_address": schema.StringAttribute{
+							Description: "External load balancer IP address where this load balancer is exposed.",
+							Computed:    true,
+						},
+						"private_address": schema.StringAttribute{
+							Description: "Private IP address of the load balancer in the network.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the load balancer.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels of the load balancer.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *inventoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := d.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	forbiddenMessage := map[int]string{
+		http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+	}
+
+	var filterLabels map[string]string
+	if !model.Filter.IsNull() && !model.Filter.IsUnknown() {
+		var filter filterModel
+		diags = model.Filter.As(ctx, &filter, basetypes.ObjectAsOptions{})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		diags = filter.Labels.ElementsAs(ctx, &filterLabels, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	listServersReq := d.iaasClient.ListServers(ctx, projectId, region).Details(true)
+	listNetworksReq := d.iaasClient.ListNetworks(ctx, projectId, region)
+	if labelSelector := buildLabelSelector(filterLabels); labelSelector != "" {
+		listServersReq = listServersReq.LabelSelector(labelSelector)
+		listNetworksReq = listNetworksReq.LabelSelector(labelSelector)
+	}
+
+	serversResp, err := listServersReq.Execute()
+	if err != nil {
+		utils.LogError(ctx, &resp.Diagnostics, err, "Reading inventory", fmt.Sprintf("Unable to list servers of project %q.", projectId), forbiddenMessage)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	networksResp, err := listNetworksReq.Execute()
+	if err != nil {
+		utils.LogError(ctx, &resp.Diagnostics, err, "Reading inventory", fmt.Sprintf("Unable to list networks of project %q.", projectId), forbiddenMessage)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	loadBalancers, err := utils.ListAllPages(ctx, func(ctx context.Context, pageToken string) (utils.Page[loadbalancer.LoadBalancer], error) {
+		listLoadBalancersReq := d.loadBalancerClient.ListLoadBalancers(ctx, projectId, region)
+		if pageToken != "" {
+			listLoadBalancersReq = listLoadBalancersReq.PageId(pageToken)
+		}
+		loadBalancersResp, err := listLoadBalancersReq.Execute()
+		if err != nil {
+			return utils.Page[loadbalancer.LoadBalancer]{}, err
+		}
+		return utils.Page[loadbalancer.LoadBalancer]{
+			Items:         loadBalancersResp.GetLoadBalancers(),
+			NextPageToken: loadBalancersResp.GetNextPageId(),
+		}, nil
+	})
+	if err != nil {
+		utils.LogError(ctx, &resp.Diagnostics, err, "Reading inventory", fmt.Sprintf("Unable to list load balancers of project %q.", projectId), forbiddenMessage)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	// The load balancer API doesn't support filtering by labels
+	loadBalancers = filterLoadBalancersByLabels(loadBalancers, filterLabels)
+
+	ctx = core.LogResponse(ctx)
+
+	// Map response body to schema
+	err = mapFields(ctx, serversResp.Items, networksResp.Items, loadBalancers, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading inventory", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "read inventory")
+}
+
+func mapFields(ctx context.Context, servers *[]iaas.Server, networks *[]iaas.Network, loadBalancers []loadbalancer.LoadBalancer, model *Model, region string) error {
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region)
+	model.Region = types.StringValue(region)
+
+	serversTF, err := mapServers(ctx, servers)
+	if err != nil {
+		return fmt.Errorf("mapping servers: %w", err)
+	}
+	model.Servers = serversTF
+
+	networksTF, err := mapNetworks(ctx, networks)
+	if err != nil {
+		return fmt.Errorf("mapping networks: %w", err)
+	}
+	model.Networks = networksTF
+
+	loadBalancersTF, err := mapLoadBalancers(ctx, loadBalancers)
+	if err != nil {
+		return fmt.Errorf("mapping load balancers: %w", err)
+	}
+	model.LoadBalancers = loadBalancersTF
+
+	return nil
+}
+
+// buildLabelSelector builds an IaaS API label selector, which matches resources having all the given labels.
+func buildLabelSelector(labels map[string]string) string {
+	selectors := make([]string, 0, len(labels))
+	for key, value := range labels {
+		selectors = append(selectors, fmt.Sprintf("%s=%s", key, value))
+	}
+	// Sort to get a deterministic label selector
+	sort.Strings(selectors)
+	return strings.Join(selectors, ",")
+}
+
+// filterLoadBalancersByLabels returns the load balancers having all the given labels.
+func filterLoadBalancersByLabels(loadBalancers []loadbalancer.LoadBalancer, labels map[string]string) []loadbalancer.LoadBalancer {
+	if len(labels) == 0 {
+		return loadBalancers
+	}
+	filtered := []loadbalancer.LoadBalancer{}
+	for i := range loadBalancers {
+		loadBalancerLabels := loadBalancers[i].GetLabels()
+		matches := true
+		for key, value := range labels {
+			if actual, ok := loadBalancerLabels[key]; !ok || actual != value {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			filtered = append(filtered, loadBalancers[i])
+		}
+	}
+	return filtered
+}
+
+func mapServers(ctx context.Context, serversResp *[]iaas.Server) (types.List, error) {
+	var servers []iaas.Server
+	if serversResp != nil {
+		servers = *serversResp
+	}
+	// Sort to prevent unnecessary diffs due to order changes.
+	sort.SliceStable(servers, func(i, j int) bool {
+		return servers[i].GetName() < servers[j].GetName()
+	})
+
+	serversList := []attr.Value{}
+	for i := range servers {
+		server := &servers[i]
+		if server.Id == nil {
+			return types.ListNull(types.ObjectType{AttrTypes: serverTypes}), fmt.Errorf("server %q has no ID", server.GetName())
+		}
+
+		labels, err := iaasUtils.MapLabels(ctx, server.Labels, types.MapNull(types.StringType))
+		if err != nil {
+			return types.ListNull(types.ObjectType{AttrTypes: serverTypes}), fmt.Errorf("server %q: %w", *server.Id, err)
+		}
+
+		metadata := types.StringNull()
+		if server.Metadata != nil && len(*server.Metadata) > 0 {
+			metadataBytes, err := json.Marshal(*server.Metadata)
+			if err != nil {
+				return types.ListNull(types.ObjectType{AttrTypes: serverTypes}), fmt.Errorf("server %q: encoding metadata: %w", *server.Id, err)
+			}
+			metadata = types.StringValue(string(metadataBytes))
+		}
+
+		nicsList := []attr.Value{}
+		if server.Nics != nil {
+			for _, nic := range *server.Nics {
+				nicObject, diags := types.ObjectValue(nicTypes, map[string]attr.Value{
+					"network_id":   types.StringPointerValue(nic.NetworkId),
+					"network_name": types.StringPointerValue(nic.NetworkName),
+					"ipv4":         types.StringPointerValue(nic.Ipv4),
+					"ipv6":         types.StringPointerValue(nic.Ipv6),
+					"public_ip":    types.StringPointerValue(nic.PublicIp),
+				})
+				if diags.HasError() {
+					return types.ListNull(types.ObjectType{AttrTypes: serverTypes}), core.DiagsToError(diags)
+				}
+				nicsList = append(nicsList, nicObject)
+			}
+		}
+		nicsTF, diags := types.ListValue(types.ObjectType{AttrTypes: nicTypes}, nicsList)
+		if diags.HasError() {
+			return types.ListNull(types.ObjectType{AttrTypes: serverTypes}), core.DiagsToError(diags)
+		}
+
+		serverObject, diags := types.ObjectValue(serverTypes, map[string]attr.Value{
+			"server_id":         types.StringPointerValue(server.Id),
+			"name":              types.StringPointerValue(server.Name),
+			"machine_type":      types.StringPointerValue(server.MachineType),
+			"availability_zone": types.StringPointerValue(server.AvailabilityZone),
+			"status":            types.StringPointerValue(server.Status),
+			"labels":            labels,
+			"metadata":          metadata,
+			"nics":              nicsTF,
+		})
+		if diags.HasError() {
+			return types.ListNull(types.ObjectType{AttrTypes: serverTypes}), core.DiagsToError(diags)
+		}
+		serversList = append(serversList, serverObject)
+	}
+
+	serversTF, diags := types.ListValue(types.ObjectType{AttrTypes: serverTypes}, serversList)
+	if diags.HasError() {
+		return types.ListNull(types.ObjectType{AttrTypes: serverTypes}), core.DiagsToError(diags)
+	}
+	return serversTF, nil
+}
+
+func mapNetworks(ctx context.Context, networksResp *[]iaas.Network) (types.List, error) {
+	var networks []iaas.Network
+	if networksResp != nil {
+		networks = *networksResp
+	}
+	// Sort to prevent unnecessary diffs due to order changes.
+	sort.SliceStable(networks, func(i, j int) bool {
+		return networks[i].GetName() < networks[j].GetName()
+	})
+
+	networksList := []attr.Value{}
+	for i := range networks {
+		network := &networks[i]
+		if network.Id == nil {
+			return types.ListNull(types.ObjectType{AttrTypes: networkTypes}), fmt.Errorf("network %q has no ID", network.GetName())
+		}
+
+		labels, err := iaasUtils.MapLabels(ctx, network.Labels, types.MapNull(types.StringType))
+		if err != nil {
+			return types.ListNull(types.ObjectType{AttrTypes: networkTypes}), fmt.Errorf("network %q: %w", *network.Id, err)
+		}
+
+		ipv4Prefixes := []string{}
+		if network.Ipv4 != nil && network.Ipv4.Prefixes != nil {
+			ipv4Prefixes = *network.Ipv4.Prefixes
+		}
+		ipv4PrefixesTF, diags := types.ListValueFrom(ctx, types.StringType, ipv4Prefixes)
+		if diags.HasError() {
+			return types.ListNull(types.ObjectType{AttrTypes: networkTypes}), core.DiagsToError(diags)
+		}
+
+		ipv6Prefixes := []string{}
+		if network.Ipv6 != nil && network.Ipv6.Prefixes != nil {
+			ipv6Prefixes = *network.Ipv6.Prefixes
+		}
+		ipv6PrefixesTF, diags := types.ListValueFrom(ctx, types.StringType, ipv6Prefixes)
+		if diags.HasError() {
+			return types.ListNull(types.ObjectType{AttrTypes: networkTypes}), core.DiagsToError(diags)
+		}
+
+		networkObject, diags := types.ObjectValue(networkTypes, map[string]attr.Value{
+			"network_id":    types.StringPointerValue(network.Id),
+			"name":          types.StringPointerValue(network.Name),
+			"ipv4_prefixes": ipv4PrefixesTF,
+			"ipv6_prefixes": ipv6PrefixesTF,
+			"routed":        types.BoolPointerValue(network.Routed),
+			"labels":        labels,
+		})
+		if diags.HasError() {
+			return types.ListNull(types.ObjectType{AttrTypes: networkTypes}), core.DiagsToError(diags)
+		}
+		networksList = append(networksList, networkObject)
+	}
+
+	networksTF, diags := types.ListValue(types.ObjectType{AttrTypes: networkTypes}, networksList)
+	if diags.HasError() {
+		return types.ListNull(types.ObjectType{AttrTypes: networkTypes}), core.DiagsToError(diags)
+	}
+	return networksTF, nil
+}
+
+func mapLoadBalancers(ctx context.Context, loadBalancers []loadbalancer.LoadBalancer) (types.List, error) {
+	// Sort to prevent unnecessary diffs due to order changes.
+	sort.SliceStable(loadBalancers, func(i, j int) bool {
+		return loadBalancers[i].GetName() < loadBalancers[j].GetName()
+	})
+
+	loadBalancersList := []attr.Value{}
+	for i := range loadBalancers {
+		loadBalancer := &loadBalancers[i]
+
+		labels := types.MapNull(types.StringType)
+		if loadBalancer.Labels != nil && len(*loadBalancer.Labels) > 0 {
+			var diags diag.Diagnostics
+			labels, diags = types.MapValueFrom(ctx, types.StringType, *loadBalancer.Labels)
+			if diags.HasError() {
+				return types.ListNull(types.ObjectType{AttrTypes: loadBalancerTypes}), core.DiagsToError(diags)
+			}
+		}
+
+		status := types.StringNull()
+		if loadBalancer.Status != nil {
+			status = types.StringValue(string(*loadBalancer.Status))
+		}
+
+		loadBalancerObject, diags := types.ObjectValue(loadBalancerTypes, map[string]attr.Value{
+			"name":             types.StringPointerValue(loadBalancer.Name),
+			"external_address": types.StringPointerValue(loadBalancer.ExternalAddress),
+			"private_address":  types.StringPointerValue(loadBalancer.PrivateAddress),
+			"status":           status,
+			"labels":           labels,
+		})
+		if diags.HasError() {
+			return types.ListNull(types.ObjectType{AttrTypes: loadBalancerTypes}), core.DiagsToError(diags)
+		}
+		loadBalancersList = append(loadBalancersList, loadBalancerObject)
+	}
+
+	loadBalancersTF, diags := types.ListValue(types.ObjectType{AttrTypes: loadBalancerTypes}, loadBalancersList)
+	if diags.HasError() {
+		return types.ListNull(types.ObjectType{AttrTypes: loadBalancerTypes}), core.DiagsToError(diags)
+	}
+	return loadBalancersTF, nil
+}
@@ -0,0 +1,311 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/stackit-sdk-go/services/loadbalancer"
+)
+
+func TestMapFields(t *testing.T) {
+	serverObjectType := types.ObjectType{AttrTypes: serverTypes}
+	nicObjectType := types.ObjectType{AttrTypes: nicTypes}
+	networkObjectType := types.ObjectType{AttrTypes: networkTypes}
+	loadBalancerObjectType := types.ObjectType{AttrTypes: loadBalancerTypes}
+
+	tests := []struct {
+		description   string
+		servers       *[]iaas.Server
+		networks      *[]iaas.Network
+		loadBalancers []loadbalancer.LoadBalancer
+		expected      Model
+		isValid       bool
+	}{
+		{
+			"default_ok",
+			&[]iaas.Server{
+				{
+					Id:               utils.Ptr("sid-2"),
+					Name:             utils.Ptr("web"),
+					MachineType:      utils.Ptr("c1.2"),
+					AvailabilityZone: utils.Ptr("eu01-1"),
+					Status:           utils.Ptr("ACTIVE"),
+					Labels: &map[string]interface{}{
+						"role": "web",
+					},
+					Metadata: &map[string]interface{}{
+						"ansible_user": "ubuntu",
+					},
+					Nics: &[]iaas.ServerNetwork{
+						{
+							NetworkId:   utils.Ptr("nid"),
+							NetworkName: utils.Ptr("net"),
+							Ipv4:        utils.Ptr("10.0.0.2"),
+							PublicIp:    utils.Ptr("193.148.160.10"),
+						},
+					},
+				},
+				{
+					Id:          utils.Ptr("sid-1"),
+					Name:        utils.Ptr("db"),
+					MachineType: utils.Ptr("m1.4"),
+				},
+			},
+			&[]iaas.Network{
+				{
+					Id:   utils.Ptr("nid"),
+					Name: utils.Ptr("net"),
+					Ipv4: &iaas.NetworkIPv4{
+						Prefixes: &[]string{"10.0.0.0/24"},
+					},
+					Routed: utils.Ptr(true),
+					Labels: &map[string]interface{}{
+						"env": "prod",
+					},
+				},
+			},
+			[]loadbalancer.LoadBalancer{
+				{
+					Name:            utils.Ptr("lb"),
+					ExternalAddress: utils.Ptr("193.148.160.11"),
+					PrivateAddress:  utils.Ptr("10.0.0.3"),
+					Status:          loadbalancer.LOADBALANCERSTATUS_READY.Ptr(),
+					Labels: &map[string]string{
+						"env": "prod",
+					},
+				},
+			},
+			Model{
+				Id:        types.StringValue("pid,eu01"),
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+				Servers: types.ListValueMust(serverObjectType, []attr.Value{
+					types.ObjectValueMust(serverTypes, map[string]attr.Value{
+						"server_id":         types.StringValue("sid-1"),
+						"name":              types.StringValue("db"),
+						"machine_type":      types.StringValue("m1.4"),
+						"availability_zone": types.StringNull(),
+						"status":            types.StringNull(),
+						"labels":            types.MapNull(types.StringType),
+						"metadata":          types.StringNull(),
+						"nics":              types.ListValueMust(nicObjectType, []attr.Value{}),
+					}),
+					types.ObjectValueMust(serverTypes, map[string]attr.Value{
+						"server_id":         types.StringValue("sid-2"),
+						"name":              types.StringValue("web"),
+						"machine_type":      types.StringValue("c1.2"),
+						"availability_zone": types.StringValue("eu01-1"),
+						"status":            types.StringValue("ACTIVE"),
+						"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+							"role": types.StringValue("web"),
+						}),
+						"metadata": types.StringValue(`{"ansible_user":"ubuntu"}`),
+						"nics": types.ListValueMust(nicObjectType, []attr.Value{
+							types.ObjectValueMust(nicTypes, map[string]attr.Value{
+								"network_id":   types.StringValue("nid"),
+								"network_name": types.StringValue("net"),
+								"ipv4":         types.StringValue("10.0.0.2"),
+								"ipv6":         types.StringNull(),
+								"public_ip":    types.StringValue("193.148.160.10"),
+							}),
+						}),
+					}),
+				}),
+				Networks: types.ListValueMust(networkObjectType, []attr.Value{
+					types.ObjectValueMust(networkTypes, map[string]attr.Value{
+						"network_id": types.StringValue("nid"),
+						"name":       types.StringValue("net"),
+						"ipv4_prefixes": types.ListValueMust(types.StringType, []attr.Value{
+							types.StringValue("10.0.0.0/24"),
+						}),
+						"ipv6_prefixes": types.ListValueMust(types.StringType, []attr.Value{}),
+						"routed":        types.BoolValue(true),
+						"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+							"env": types.StringValue("prod"),
+						}),
+					}),
+				}),
+				LoadBalancers: types.ListValueMust(loadBalancerObjectType, []attr.Value{
+					types.ObjectValueMust(loadBalancerTypes, map[string]attr.Value{
+						"name":             types.StringValue("lb"),
+						"external_address": types.StringValue("193.148.160.11"),
+						"private_address":  types.StringValue("10.0.0.3"),
+						"status":           types.StringValue("STATUS_READY"),
+						"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+							"env": types.StringValue("prod"),
+						}),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"empty_project",
+			nil,
+			&[]iaas.Network{},
+			nil,
+			Model{
+				Id:            types.StringValue("pid,eu01"),
+				ProjectId:     types.StringValue("pid"),
+				Region:        types.StringValue("eu01"),
+				Servers:       types.ListValueMust(serverObjectType, []attr.Value{}),
+				Networks:      types.ListValueMust(networkObjectType, []attr.Value{}),
+				LoadBalancers: types.ListValueMust(loadBalancerObjectType, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"server_without_id",
+			&[]iaas.Server{
+				{
+					Name: utils.Ptr("web"),
+				},
+			},
+			nil,
+			nil,
+			Model{},
+			false,
+		},
+		{
+			"network_without_id",
+			nil,
+			&[]iaas.Network{
+				{
+					Name: utils.Ptr("net"),
+				},
+			},
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := Model{
+				ProjectId: types.StringValue("pid"),
+			}
+			err := mapFields(context.Background(), tt.servers, tt.networks, tt.loadBalancers, &model, "eu01")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildLabelSelector(t *testing.T) {
+	tests := []struct {
+		description string
+		labels      map[string]string
+		expected    string
+	}{
+		{
+			"no_labels",
+			nil,
+			"",
+		},
+		{
+			"single_label",
+			map[string]string{
+				"env": "prod",
+			},
+			"env=prod",
+		},
+		{
+			"multiple_labels_sorted",
+			map[string]string{
+				"role": "web",
+				"env":  "prod",
+			},
+			"env=prod,role=web",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := buildLabelSelector(tt.labels)
+			if output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestFilterLoadBalancersByLabels(t *testing.T) {
+	loadBalancers := []loadbalancer.LoadBalancer{
+		{
+			Name: utils.Ptr("lb-prod-web"),
+			Labels: &map[string]string{
+				"env":  "prod",
+				"role": "web",
+			},
+		},
+		{
+			Name: utils.Ptr("lb-prod"),
+			Labels: &map[string]string{
+				"env": "prod",
+			},
+		},
+		{
+			Name: utils.Ptr("lb-unlabeled"),
+		},
+	}
+
+	tests := []struct {
+		description string
+		labels      map[string]string
+		expected    []string
+	}{
+		{
+			"no_filter",
+			nil,
+			[]string{"lb-prod-web", "lb-prod", "lb-unlabeled"},
+		},
+		{
+			"single_label",
+			map[string]string{
+				"env": "prod",
+			},
+			[]string{"lb-prod-web", "lb-prod"},
+		},
+		{
+			"all_labels_must_match",
+			map[string]string{
+				"env":  "prod",
+				"role": "web",
+			},
+			[]string{"lb-prod-web"},
+		},
+		{
+			"value_mismatch",
+			map[string]string{
+				"env": "dev",
+			},
+			[]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := filterLoadBalancersByLabels(loadBalancers, tt.labels)
+			names := []string{}
+			for i := range output {
+				names = append(names, output[i].GetName())
+			}
+			if diff := cmp.Diff(tt.expected, names); diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -34,9 +34,12 @@ var (
 	_ resource.Resource                = &keyRingResource{}
 	_ resource.ResourceWithConfigure   = &keyRingResource{}
 	_ resource.ResourceWithImportState = &keyRingResource{}
+	_ resource.ResourceWithIdentity    = &keyRingResource{}
 	_ resource.ResourceWithModifyPlan  = &keyRingResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "keyring_id"}
+
 type Model struct {
 	Description types.String `tfsdk:"description"`
 	DisplayName types.String `tfsdk:"display_name"`
@@ -59,6 +62,11 @@ func (r *keyRingResource) Metadata(_ context.Context, request resource.MetadataR
 	response.TypeName = request.ProviderTypeName + "_kms_keyring"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *keyRingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 func (r *keyRingResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	var ok bool
 	r.providerData, ok = conversion.ParseProviderData(ctx, request.ProviderData, &response.Diagnostics)
@@ -93,7 +101,7 @@ func (r *keyRingResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -171,6 +179,8 @@ func (r *keyRingResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 }
 
 func (r *keyRingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -212,7 +222,7 @@ func (r *keyRingResource) Create(ctx context.Context, req resource.CreateRequest
 		"keyring_id": keyRingId,
 	})
 
-	waitResp, err := wait.CreateKeyRingWaitHandler(ctx, r.client, projectId, region, keyRingId).SetSleepBeforeWait(5 * time.Second).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandler(wait.CreateKeyRingWaitHandler(ctx, r.client, projectId, region, keyRingId).SetSleepBeforeWait(5*time.Second), r.providerData.Wait, core.WaitCreate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating keyring", fmt.Sprintf("Key Ring creation waiting: %v", err))
 		return
@@ -233,6 +243,8 @@ func (r *keyRingResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *keyRingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -255,7 +267,7 @@ func (r *keyRingResource) Read(ctx context.Context, req resource.ReadRequest, re
 		var oapiErr *oapierror.GenericOpenAPIError
 		ok := errors.As(err, &oapiErr)
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_kms_keyring", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading keyring", fmt.Sprintf("Calling API: %v", err))
@@ -298,12 +310,13 @@ func (r *keyRingResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *keyRingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing keyring",
-			fmt.Sprintf("Exptected import identifier with format: [project_id],[region],[keyring_id], got :%q", req.ID),
+			fmt.Sprintf("Exptected import identifier with format: [project_id],[region],[keyring_id], got :%q", importId),
 		)
 		return
 	}
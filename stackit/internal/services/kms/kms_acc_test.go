@@ -48,7 +48,7 @@ var (
 
 var testConfigKeyRingVarsMin = config.Variables{
 	"project_id":   config.StringVariable(testutil.ProjectId),
-	"display_name": config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"display_name": config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
 }
 
 var testConfigKeyRingVarsMinUpdated = func() config.Variables {
@@ -63,7 +63,7 @@ var testConfigKeyRingVarsMinUpdated = func() config.Variables {
 var testConfigKeyRingVarsMax = config.Variables{
 	"project_id":   config.StringVariable(testutil.ProjectId),
 	"description":  config.StringVariable("description"),
-	"display_name": config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"display_name": config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
 }
 
 var testConfigKeyRingVarsMaxUpdated = func() config.Variables {
@@ -78,8 +78,8 @@ var testConfigKeyRingVarsMaxUpdated = func() config.Variables {
 
 var testConfigKeyVarsMin = config.Variables{
 	"project_id":           config.StringVariable(testutil.ProjectId),
-	"keyring_display_name": config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
-	"display_name":         config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"keyring_display_name": config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"display_name":         config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
 	"algorithm":            config.StringVariable(string(kms.ALGORITHM_AES_256_GCM)),
 	"protection":           config.StringVariable("software"),
 	"purpose":              config.StringVariable(string(kms.PURPOSE_SYMMETRIC_ENCRYPT_DECRYPT)),
@@ -98,8 +98,8 @@ var testConfigKeyVarsMinUpdated = func() config.Variables {
 
 var testConfigKeyVarsMax = config.Variables{
 	"project_id":           config.StringVariable(testutil.ProjectId),
-	"keyring_display_name": config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
-	"display_name":         config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"keyring_display_name": config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"display_name":         config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
 	"algorithm":            config.StringVariable(string(kms.ALGORITHM_AES_256_GCM)),
 	"protection":           config.StringVariable("software"),
 	"purpose":              config.StringVariable(string(kms.PURPOSE_SYMMETRIC_ENCRYPT_DECRYPT)),
@@ -123,8 +123,8 @@ var testConfigKeyVarsMaxUpdated = func() config.Variables {
 
 var testConfigWrappingKeyVarsMin = config.Variables{
 	"project_id":           config.StringVariable(testutil.ProjectId),
-	"keyring_display_name": config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
-	"display_name":         config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"keyring_display_name": config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"display_name":         config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
 	"algorithm":            config.StringVariable(string(kms.WRAPPINGALGORITHM__2048_OAEP_SHA256)),
 	"protection":           config.StringVariable(string(kms.PROTECTION_SOFTWARE)),
 	"purpose":              config.StringVariable(string(kms.WRAPPINGPURPOSE_SYMMETRIC_KEY)),
@@ -143,8 +143,8 @@ var testConfigWrappingKeyVarsMinUpdated = func() config.Variables {
 
 var testConfigWrappingKeyVarsMax = config.Variables{
 	"project_id":           config.StringVariable(testutil.ProjectId),
-	"keyring_display_name": config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
-	"display_name":         config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"keyring_display_name": config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"display_name":         config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
 	"algorithm":            config.StringVariable(string(kms.WRAPPINGALGORITHM__2048_OAEP_SHA256)),
 	"protection":           config.StringVariable(string(kms.PROTECTION_SOFTWARE)),
 	"purpose":              config.StringVariable(string(kms.WRAPPINGPURPOSE_SYMMETRIC_KEY)),
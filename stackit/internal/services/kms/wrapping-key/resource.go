@@ -34,9 +34,12 @@ var (
 	_ resource.Resource                = &wrappingKeyResource{}
 	_ resource.ResourceWithConfigure   = &wrappingKeyResource{}
 	_ resource.ResourceWithImportState = &wrappingKeyResource{}
+	_ resource.ResourceWithIdentity    = &wrappingKeyResource{}
 	_ resource.ResourceWithModifyPlan  = &wrappingKeyResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "keyring_id", "wrapping_key_id"}
+
 type Model struct {
 	AccessScope   types.String `tfsdk:"access_scope"`
 	Algorithm     types.String `tfsdk:"algorithm"`
@@ -67,6 +70,11 @@ func (r *wrappingKeyResource) Metadata(_ context.Context, request resource.Metad
 	response.TypeName = request.ProviderTypeName + "_kms_wrapping_key"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *wrappingKeyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 func (r *wrappingKeyResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	var ok bool
 	r.providerData, ok = conversion.ParseProviderData(ctx, request.ProviderData, &response.Diagnostics)
@@ -101,7 +109,7 @@ func (r *wrappingKeyResource) ModifyPlan(ctx context.Context, req resource.Modif
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -241,6 +249,8 @@ func (r *wrappingKeyResource) Schema(_ context.Context, _ resource.SchemaRequest
 }
 
 func (r *wrappingKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -287,7 +297,7 @@ func (r *wrappingKeyResource) Create(ctx context.Context, req resource.CreateReq
 		"wrapping_key_id": wrappingKeyId,
 	})
 
-	wrappingKey, err := wait.CreateWrappingKeyWaitHandler(ctx, r.client, projectId, region, keyRingId, wrappingKeyId).WaitWithContext(ctx)
+	wrappingKey, err := core.ConfigureWaitHandler(wait.CreateWrappingKeyWaitHandler(ctx, r.client, projectId, region, keyRingId, wrappingKeyId), r.providerData.Wait, core.WaitCreate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error waiting for wrapping key creation", fmt.Sprintf("Calling API: %v", err))
 		return
@@ -308,6 +318,8 @@ func (r *wrappingKeyResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *wrappingKeyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, response.Identity, &response.Diagnostics, &response.State, &request.State)
+
 	var model Model
 	diags := request.State.Get(ctx, &model)
 	response.Diagnostics.Append(diags...)
@@ -332,7 +344,7 @@ func (r *wrappingKeyResource) Read(ctx context.Context, request resource.ReadReq
 		var oapiErr *oapierror.GenericOpenAPIError
 		ok := errors.As(err, &oapiErr)
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			response.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, response, "stackit_kms_wrapping_key", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &response.Diagnostics, "Error reading wrapping key", fmt.Sprintf("Calling API: %v", err))
@@ -385,12 +397,13 @@ func (r *wrappingKeyResource) Delete(ctx context.Context, request resource.Delet
 }
 
 func (r *wrappingKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing wrapping key",
-			fmt.Sprintf("Exptected import identifier with format: [project_id],[region],[keyring_id],[wrapping_key_id], got :%q", req.ID),
+			fmt.Sprintf("Exptected import identifier with format: [project_id],[region],[keyring_id],[wrapping_key_id], got :%q", importId),
 		)
 		return
 	}
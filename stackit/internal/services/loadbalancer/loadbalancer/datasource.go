@@ -66,7 +66,7 @@ func (r *loadBalancerDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 
 	descriptions := map[string]string{
 		"main":                                  "Load Balancer data source schema. Must have a `region` specified in the provider configuration.",
-		"id":                                    "Terraform's internal resource ID. It is structured as \"`project_id`\",\"region\",\"`name`\".",
+		"id":                                    "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`name`\".",
 		"project_id":                            "STACKIT project ID to which the Load Balancer is associated.",
 		"external_address":                      "External Load Balancer IP address where this Load Balancer is exposed.",
 		"disable_security_group_assignment":     "If set to true, this will disable the automatic assignment of a security group to the load balancer's targets. This option is primarily used to allow targets that are not within the load balancer's own network or SNA (STACKIT Network area). When this is enabled, you are fully responsible for ensuring network connectivity to the targets, including managing all routing and security group rules manually. This setting cannot be changed after the load balancer is created.",
@@ -105,6 +105,7 @@ func (r *loadBalancerDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 		"target_port":                           "Identical port number where each target listens for traffic.",
 		"targets":                               "List of all targets which will be used in the pool. Limited to 1000.",
 		"targets.display_name":                  "Target display name",
+		"targets.administratively_down":         "Always false, since the targets which are administratively down aren't part of the target pool. They're only kept in the state of the resource.",
 		"ip":                                    "Target IP",
 		"region":                                "The resource region. If not defined, the provider region is used.",
 		"tcp_options":                           "Options that are specific to the TCP protocol.",
@@ -350,6 +351,10 @@ func (r *loadBalancerDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 							},
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
+									"administratively_down": schema.BoolAttribute{
+										Description: descriptions["targets.administratively_down"],
+										Computed:    true,
+									},
 									"display_name": schema.StringAttribute{
 										Description: descriptions["targets.display_name"],
 										Computed:    true,
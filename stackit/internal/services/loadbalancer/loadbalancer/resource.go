@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
 	loadbalancerUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/loadbalancer/utils"
 
 	"github.com/google/uuid"
@@ -21,7 +22,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
@@ -32,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 	sdkUtils "github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
 	"github.com/stackitcloud/stackit-sdk-go/services/loadbalancer"
 	"github.com/stackitcloud/stackit-sdk-go/services/loadbalancer/wait"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
@@ -45,9 +46,12 @@ var (
 	_ resource.Resource                = &loadBalancerResource{}
 	_ resource.ResourceWithConfigure   = &loadBalancerResource{}
 	_ resource.ResourceWithImportState = &loadBalancerResource{}
+	_ resource.ResourceWithIdentity    = &loadBalancerResource{}
 	_ resource.ResourceWithModifyPlan  = &loadBalancerResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "name"}
+
 type Model struct {
 	Id                             types.String `tfsdk:"id"` // needed by TF
 	ProjectId                      types.String `tfsdk:"project_id"`
@@ -64,6 +68,12 @@ type Model struct {
 	SecurityGroupId                types.String `tfsdk:"security_group_id"`
 }
 
+// resourceModel extends the model shared with the data source by the attributes which only apply to the resource
+type resourceModel struct {
+	Model
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+}
+
 // Struct corresponding to Model.Listeners[i]
 type listener struct {
 	DisplayName          types.String `tfsdk:"display_name"`
@@ -196,14 +206,16 @@ var activeHealthCheckTypes = map[string]attr.Type{
 
 // Struct corresponding to targetPool.Targets[i]
 type target struct {
-	DisplayName types.String `tfsdk:"display_name"`
-	Ip          types.String `tfsdk:"ip"`
+	AdministrativelyDown types.Bool   `tfsdk:"administratively_down"`
+	DisplayName          types.String `tfsdk:"display_name"`
+	Ip                   types.String `tfsdk:"ip"`
 }
 
 // Types corresponding to target
 var targetTypes = map[string]attr.Type{
-	"display_name": types.StringType,
-	"ip":           types.StringType,
+	"administratively_down": types.BoolType,
+	"display_name":          types.StringType,
+	"ip":                    types.StringType,
 }
 
 // Struct corresponding to targetPool.SessionPersistence
@@ -224,6 +236,7 @@ func NewLoadBalancerResource() resource.Resource {
 // loadBalancerResource is the resource implementation.
 type loadBalancerResource struct {
 	client       *loadbalancer.APIClient
+	iaasClient   *iaas.APIClient
 	providerData core.ProviderData
 }
 
@@ -232,10 +245,15 @@ func (r *loadBalancerResource) Metadata(_ context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_loadbalancer"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *loadBalancerResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan.
 // Use the modifier to set the effective region in the current plan.
 func (r *loadBalancerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
-	var configModel Model
+	var configModel resourceModel
 	// skip initial empty configuration to avoid follow-up errors
 	if req.Config.Raw.IsNull() {
 		return
@@ -245,13 +263,13 @@ func (r *loadBalancerResource) ModifyPlan(ctx context.Context, req resource.Modi
 		return
 	}
 
-	var planModel Model
+	var planModel resourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -264,14 +282,16 @@ func (r *loadBalancerResource) ModifyPlan(ctx context.Context, req resource.Modi
 
 // ConfigValidators validates the resource configuration
 func (r *loadBalancerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var model Model
+	var model resourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// validation is done in extracted func so it's easier to unit-test it
-	validateConfig(ctx, &resp.Diagnostics, &model)
+	validateConfig(ctx, &resp.Diagnostics, &model.Model)
+	validateProxyProtocol(ctx, &resp.Diagnostics, &model.Model)
+	validateDrainedTargetPools(ctx, &resp.Diagnostics, &model.Model)
 }
 
 func validateConfig(ctx context.Context, diags *diag.Diagnostics, model *Model) {
@@ -299,6 +319,80 @@ func validateConfig(ctx context.Context, diags *diag.Diagnostics, model *Model)
 	}
 }
 
+// validateProxyProtocol checks that the targets of a target pool either receive the PROXY protocol header from all TCP listeners or from none of them.
+func validateProxyProtocol(ctx context.Context, diags *diag.Diagnostics, model *Model) {
+	if utils.IsUndefined(model.Listeners) {
+		return
+	}
+	listenersModel := []listener{}
+	if d := model.Listeners.ElementsAs(ctx, &listenersModel, false); d.HasError() {
+		return
+	}
+
+	usesProxyProtocol := map[string]bool{}
+	for i := range listenersModel {
+		listenerModel := listenersModel[i]
+		if utils.IsUndefined(listenerModel.TargetPool) || utils.IsUndefined(listenerModel.Protocol) {
+			continue
+		}
+		protocol := loadbalancer.ListenerProtocol(listenerModel.Protocol.ValueString())
+		if protocol != loadbalancer.LISTENERPROTOCOL_TCP && protocol != loadbalancer.LISTENERPROTOCOL_TCP_PROXY {
+			continue
+		}
+
+		targetPoolName := listenerModel.TargetPool.ValueString()
+		proxyProtocol := protocol == loadbalancer.LISTENERPROTOCOL_TCP_PROXY
+		if prior, ok := usesProxyProtocol[targetPoolName]; ok && prior != proxyProtocol {
+			diags.AddAttributeError(
+				path.Root("listeners").AtListIndex(i).AtName("protocol"),
+				"Error configuring load balancer",
+				fmt.Sprintf("Target pool %q is used by listeners with and without PROXY protocol. Its targets can't tell whether a connection starts with a PROXY protocol header, use either `%s` or `%s` for all of its listeners.", targetPoolName, loadbalancer.LISTENERPROTOCOL_TCP_PROXY, loadbalancer.LISTENERPROTOCOL_TCP),
+			)
+			continue
+		}
+		usesProxyProtocol[targetPoolName] = proxyProtocol
+	}
+}
+
+// validateDrainedTargetPools checks that each target pool keeps at least one target which isn't administratively down.
+// Drained targets are removed from the target pool, so a target pool with only drained targets would be sent without targets.
+func validateDrainedTargetPools(ctx context.Context, diags *diag.Diagnostics, model *Model) {
+	if utils.IsUndefined(model.TargetPools) {
+		return
+	}
+	targetPoolsModel := []targetPool{}
+	if d := model.TargetPools.ElementsAs(ctx, &targetPoolsModel, false); d.HasError() {
+		return
+	}
+
+	for i := range targetPoolsModel {
+		targetPoolModel := targetPoolsModel[i]
+		if utils.IsUndefined(targetPoolModel.Targets) {
+			continue
+		}
+		targetsModel := []target{}
+		if d := targetPoolModel.Targets.ElementsAs(ctx, &targetsModel, false); d.HasError() || len(targetsModel) == 0 {
+			continue
+		}
+
+		allDrained := true
+		for j := range targetsModel {
+			// Unknown values may still turn out to be false
+			if targetsModel[j].AdministrativelyDown.IsUnknown() || !targetsModel[j].AdministrativelyDown.ValueBool() {
+				allDrained = false
+				break
+			}
+		}
+		if allDrained {
+			diags.AddAttributeError(
+				path.Root("target_pools").AtListIndex(i).AtName("targets"),
+				"Error configuring load balancer",
+				fmt.Sprintf("All targets of target pool %q are administratively down. A target pool needs at least one target, set `administratively_down` to false for at least one of them.", targetPoolModel.Name.ValueString()),
+			)
+		}
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *loadBalancerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	var ok bool
@@ -311,7 +405,13 @@ func (r *loadBalancerResource) Configure(ctx context.Context, req resource.Confi
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// The IaaS client is needed to verify user-supplied security groups
+	iaasClient := iaasUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	r.client = apiClient
+	r.iaasClient = iaasClient
 	tflog.Info(ctx, "Load Balancer client configured")
 }
 
@@ -323,14 +423,14 @@ func (r *loadBalancerResource) Schema(_ context.Context, _ resource.SchemaReques
 
 	descriptions := map[string]string{
 		"main":                                  "Load Balancer resource schema.",
-		"id":                                    "Terraform's internal resource ID. It is structured as \"`project_id`\",\"region\",\"`name`\".",
+		"id":                                    "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`name`\".",
 		"project_id":                            "STACKIT project ID to which the Load Balancer is associated.",
 		"external_address":                      "External Load Balancer IP address where this Load Balancer is exposed.",
 		"disable_security_group_assignment":     "If set to true, this will disable the automatic assignment of a security group to the load balancer's targets. This option is primarily used to allow targets that are not within the load balancer's own network or SNA (STACKIT network area). When this is enabled, you are fully responsible for ensuring network connectivity to the targets, including managing all routing and security group rules manually. This setting cannot be changed after the load balancer is created.",
 		"listeners":                             "List of all listeners which will accept traffic. Limited to 20.",
 		"port":                                  "Port number where we listen for traffic.",
-		"protocol":                              "Protocol is the highest network protocol we understand to load balance. " + utils.FormatPossibleValues(protocolOptions...),
-		"target_pool":                           "Reference target pool by target pool name.",
+		"protocol":                              "Protocol is the highest network protocol we understand to load balance. " + utils.FormatPossibleValues(protocolOptions...) + " `PROTOCOL_TCP_PROXY` adds a PROXY protocol header to the TCP connections, so the targets receive the IP address of the client. The targets must expect the header, so all TCP listeners of a target pool must use the same protocol.",
+		"target_pool":                           "Reference target pool by target pool name. Changing it switches the listener to another target pool in place, without touching the other listeners, e.g. for blue/green cutovers.",
 		"name":                                  "Load balancer name.",
 		"plan_id":                               "The service plan ID. If not defined, the default service plan is `p10`. " + utils.FormatPossibleValues(servicePlanOptions...),
 		"networks":                              "List of networks that listeners and targets reside in.",
@@ -361,13 +461,15 @@ func (r *loadBalancerResource) Schema(_ context.Context, _ resource.SchemaReques
 		"target_port":                           "Identical port number where each target listens for traffic.",
 		"targets":                               "List of all targets which will be used in the pool. Limited to 1000.",
 		"targets.display_name":                  "Target display name",
+		"targets.administratively_down":         "If set to true, the target is drained: it stays in the configuration, but is removed from the target pool, so the load balancer stops sending traffic to it. Set it back to false to add the target again. At least one target of each target pool must not be administratively down. The API has no maintenance mode for targets, so the target is kept in the state only.",
 		"ip":                                    "Target IP",
 		"region":                                "The resource region. If not defined, the provider region is used.",
-		"security_group_id":                     "The ID of the egress security group assigned to the Load Balancer's internal machines. This ID is essential for allowing traffic from the Load Balancer to targets in different networks or STACKIT network areas (SNA). To enable this, create a security group rule for your target VMs and set the `remote_security_group_id` of that rule to this value. This is typically used when `disable_security_group_assignment` is set to `true`.",
-		"tcp_options":                           "Options that are specific to the TCP protocol.",
-		"tcp_options_idle_timeout":              "Time after which an idle connection is closed. The default value is set to 300 seconds, and the maximum value is 3600 seconds. The format is a duration and the unit must be seconds. Example: 30s",
-		"udp_options":                           "Options that are specific to the UDP protocol.",
-		"udp_options_idle_timeout":              "Time after which an idle session is closed. The default value is set to 1 minute, and the maximum value is 2 minutes. The format is a duration and the unit must be seconds. Example: 30s",
+		"security_group_id": "The ID of the egress security group assigned to the Load Balancer's internal machines. This ID is essential for allowing traffic from the Load Balancer to targets in different networks or STACKIT network areas (SNA). To enable this, create a security group rule for your target VMs and set the `remote_security_group_id` of that rule to this value. This is typically used when `disable_security_group_assignment` is set to `true`. " +
+			"If not set, the security group is created automatically. Otherwise the given security group, which must exist in the project, is used.",
+		"tcp_options":              "Options that are specific to the TCP protocol.",
+		"tcp_options_idle_timeout": "Time after which an idle connection is closed. The default value is set to 300 seconds, and the maximum value is 3600 seconds. The format is a duration and the unit must be seconds. Example: 30s",
+		"udp_options":              "Options that are specific to the UDP protocol.",
+		"udp_options_idle_timeout": "Time after which an idle session is closed. The default value is set to 1 minute, and the maximum value is 2 minutes. The format is a duration and the unit must be seconds. Example: 30s",
 	}
 
 	resp.Schema = schema.Schema{
@@ -402,6 +504,7 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"deletion_protection": utils.DeletionProtectionAttribute("load balancer"),
 			"disable_security_group_assignment": schema.BoolAttribute{
 				Description: descriptions["disable_security_group_assignment"],
 				Optional:    true,
@@ -423,9 +526,6 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 			"listeners": schema.ListNestedAttribute{
 				Description: descriptions["listeners"],
 				Required:    true,
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
-				},
 				Validators: []validator.List{
 					listvalidator.SizeBetween(1, 20),
 				},
@@ -436,7 +536,6 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 							Optional:    true,
 							Computed:    true,
 							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
 								stringplanmodifier.UseStateForUnknown(),
 							},
 						},
@@ -444,7 +543,6 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 							Description: descriptions["port"],
 							Required:    true,
 							PlanModifiers: []planmodifier.Int64{
-								int64planmodifier.RequiresReplace(),
 								int64planmodifier.UseStateForUnknown(),
 							},
 						},
@@ -452,7 +550,6 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 							Description: descriptions["protocol"],
 							Required:    true,
 							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
 								stringplanmodifier.UseStateForUnknown(),
 							},
 							Validators: []validator.String{
@@ -475,7 +572,6 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 							Description: descriptions["target_pool"],
 							Required:    true,
 							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
 								stringplanmodifier.UseStateForUnknown(),
 							},
 						},
@@ -518,9 +614,6 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 			"networks": schema.ListNestedAttribute{
 				Description: descriptions["networks"],
 				Required:    true,
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
-				},
 				Validators: []validator.List{
 					listvalidator.SizeBetween(1, 1),
 				},
@@ -529,9 +622,6 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 						"network_id": schema.StringAttribute{
 							Description: descriptions["network_id"],
 							Required:    true,
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
-							},
 							Validators: []validator.String{
 								validate.UUID(),
 								validate.NoSeparator(),
@@ -541,7 +631,6 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 							Description: descriptions["role"],
 							Required:    true,
 							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
 								stringplanmodifier.UseStateForUnknown(),
 							},
 							Validators: []validator.String{
@@ -556,7 +645,6 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.RequiresReplace(),
 					objectplanmodifier.UseStateForUnknown(),
 				},
 				Attributes: map[string]schema.Attribute{
@@ -566,7 +654,6 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 						Optional:    true,
 						Computed:    true,
 						PlanModifiers: []planmodifier.Set{
-							setplanmodifier.RequiresReplace(),
 							setplanmodifier.UseStateForUnknown(),
 						},
 						Validators: []validator.Set{
@@ -706,6 +793,12 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 							},
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
+									"administratively_down": schema.BoolAttribute{
+										Description: descriptions["targets.administratively_down"],
+										Optional:    true,
+										Computed:    true,
+										Default:     booldefault.StaticBool(false),
+									},
 									"display_name": schema.StringAttribute{
 										Description: descriptions["targets.display_name"],
 										Required:    true,
@@ -729,11 +822,13 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			// No UseStateForUnknown, so removing a custom security group from the configuration reverts to the automatically created one
 			"security_group_id": schema.StringAttribute{
 				Description: descriptions["security_group_id"],
+				Optional:    true,
 				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+				Validators: []validator.String{
+					validate.UUID(),
 				},
 			},
 		},
@@ -742,8 +837,10 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
-	var model Model
+	var model resourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -757,12 +854,21 @@ func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "region", region)
 
+	if !utils.IsUndefined(model.SecurityGroupId) {
+		err := r.checkSecurityGroupExists(ctx, projectId, region, model.SecurityGroupId.ValueString())
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating load balancer", err.Error())
+			return
+		}
+	}
+
 	// Generate API request body from model
-	payload, err := toCreatePayload(ctx, &model)
+	payload, err := toCreatePayload(ctx, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating load balancer", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
+	payload.Labels = toLabelsPayload(payload.Labels, r.providerData.DefaultLabels)
 
 	// Create a new load balancer
 	createResp, err := r.client.CreateLoadBalancer(ctx, projectId, region).CreateLoadBalancerPayload(*payload).XRequestID(uuid.NewString()).Execute()
@@ -773,14 +879,14 @@ func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 
 	ctx = core.LogResponse(ctx)
 
-	waitResp, err := wait.CreateLoadBalancerWaitHandler(ctx, r.client, projectId, region, *createResp.Name).SetTimeout(90 * time.Minute).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandlerWithTimeout(wait.CreateLoadBalancerWaitHandler(ctx, r.client, projectId, region, *createResp.Name), r.providerData.Wait, core.WaitCreate, 90*time.Minute).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating load balancer", fmt.Sprintf("Load balancer creation waiting: %v", err))
 		return
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, waitResp, &model, region)
+	err = mapFields(ctx, waitResp, &model.Model, region)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating load balancer", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -798,7 +904,9 @@ func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 
 // Read refreshes the Terraform state with the latest data.
 func (r *loadBalancerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var model resourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -819,7 +927,7 @@ func (r *loadBalancerResource) Read(ctx context.Context, req resource.ReadReques
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_loadbalancer", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading load balancer", fmt.Sprintf("Calling API: %v", err))
@@ -829,7 +937,7 @@ func (r *loadBalancerResource) Read(ctx context.Context, req resource.ReadReques
 	ctx = core.LogResponse(ctx)
 
 	// Map response body to schema
-	err = mapFields(ctx, lbResp, &model, region)
+	err = mapFields(ctx, lbResp, &model.Model, region)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading load balancer", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -846,8 +954,10 @@ func (r *loadBalancerResource) Read(ctx context.Context, req resource.ReadReques
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *loadBalancerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	// Retrieve values from plan
-	var model Model
+	var model resourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -863,6 +973,29 @@ func (r *loadBalancerResource) Update(ctx context.Context, req resource.UpdateRe
 	ctx = tflog.SetField(ctx, "name", name)
 	ctx = tflog.SetField(ctx, "region", region)
 
+	var stateModel resourceModel
+	diags = req.State.Get(ctx, &stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	securityGroupChanged := !model.SecurityGroupId.Equal(stateModel.SecurityGroupId)
+	if securityGroupChanged && !utils.IsUndefined(model.SecurityGroupId) {
+		err := r.checkSecurityGroupExists(ctx, projectId, region, model.SecurityGroupId.ValueString())
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating load balancer", err.Error())
+			return
+		}
+	}
+
+	// Listeners, networks, options and the security group can only be changed by updating the whole load balancer,
+	// target pools alone are updated individually
+	if !model.Listeners.Equal(stateModel.Listeners) || !model.Networks.Equal(stateModel.Networks) || !model.Options.Equal(stateModel.Options) || securityGroupChanged {
+		r.updateLoadBalancer(ctx, &model, resp)
+		return
+	}
+
 	targetPoolsModel := []targetPool{}
 	diags = model.TargetPools.ElementsAs(ctx, &targetPoolsModel, false)
 	resp.Diagnostics.Append(diags...)
@@ -900,7 +1033,7 @@ func (r *loadBalancerResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, getResp, &model, region)
+	err = mapFields(ctx, getResp, &model.Model, region)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating load balancer", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -916,9 +1049,82 @@ func (r *loadBalancerResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Info(ctx, "Load balancer updated")
 }
 
+// updateLoadBalancer updates the whole load balancer, including its listeners, networks, options and target pools,
+// and sets the updated Terraform state on success.
+func (r *loadBalancerResource) updateLoadBalancer(ctx context.Context, model *resourceModel, resp *resource.UpdateResponse) {
+	projectId := model.ProjectId.ValueString()
+	name := model.Name.ValueString()
+	region := model.Region.ValueString()
+
+	// The current version of the load balancer is needed, as the API rejects updates based on an outdated version
+	getResp, err := r.client.GetLoadBalancer(ctx, projectId, region, name).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating load balancer", fmt.Sprintf("Getting current load balancer: %v", err))
+		return
+	}
+	if getResp == nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating load balancer", "Getting current load balancer: empty response")
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	// Generate API request body from model
+	payload, err := toUpdatePayload(ctx, &model.Model, getResp)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating load balancer", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+	payload.Labels = toLabelsPayload(payload.Labels, r.providerData.DefaultLabels)
+
+	// Update load balancer
+	_, err = r.client.UpdateLoadBalancer(ctx, projectId, region, name).UpdateLoadBalancerPayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating load balancer", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	waitResp, err := core.ConfigureWaitHandler(wait.CreateLoadBalancerWaitHandler(ctx, r.client, projectId, region, name), r.providerData.Wait, core.WaitUpdate).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating load balancer", fmt.Sprintf("Load balancer update waiting: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapFields(ctx, waitResp, &model.Model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating load balancer", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set state to fully populated data
+	diags := resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Load balancer updated")
+}
+
+// checkSecurityGroupExists returns an error if the security group doesn't exist in the project.
+func (r *loadBalancerResource) checkSecurityGroupExists(ctx context.Context, projectId, region, securityGroupId string) error {
+	_, err := r.iaasClient.GetSecurityGroup(ctx, projectId, region, securityGroupId).Execute()
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("security group %q does not exist in project %q", securityGroupId, projectId)
+		}
+		return fmt.Errorf("checking security group %q: %w", securityGroupId, err)
+	}
+	return nil
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *loadBalancerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model resourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -934,6 +1140,10 @@ func (r *loadBalancerResource) Delete(ctx context.Context, req resource.DeleteRe
 	ctx = tflog.SetField(ctx, "name", name)
 	ctx = tflog.SetField(ctx, "region", region)
 
+	if utils.BlockDeletionIfProtected(ctx, req.State, "load balancer", &resp.Diagnostics) {
+		return
+	}
+
 	// Delete load balancer
 	_, err := r.client.DeleteLoadBalancer(ctx, projectId, region, name).Execute()
 	if err != nil {
@@ -943,7 +1153,7 @@ func (r *loadBalancerResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.DeleteLoadBalancerWaitHandler(ctx, r.client, projectId, region, name).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.DeleteLoadBalancerWaitHandler(ctx, r.client, projectId, region, name), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting load balancer", fmt.Sprintf("Load balancer deleting waiting: %v", err))
 		return
@@ -955,12 +1165,13 @@ func (r *loadBalancerResource) Delete(ctx context.Context, req resource.DeleteRe
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,name
 func (r *loadBalancerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing load balancer",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[name]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[name]  Got: %q", importId),
 		)
 		return
 	}
@@ -1003,9 +1214,65 @@ func toCreatePayload(ctx context.Context, model *Model) (*loadbalancer.CreateLoa
 		Networks:                             networksPayload,
 		Options:                              optionsPayload,
 		TargetPools:                          targetPoolsPayload,
+		TargetSecurityGroup:                  toTargetSecurityGroupPayload(model),
 	}, nil
 }
 
+// toTargetSecurityGroupPayload returns the security group of the targets, or nil if it should be created automatically.
+func toTargetSecurityGroupPayload(model *Model) *loadbalancer.CreateLoadBalancerPayloadTargetSecurityGroup {
+	if utils.IsUndefined(model.SecurityGroupId) || model.SecurityGroupId.ValueString() == "" {
+		return nil
+	}
+	return &loadbalancer.CreateLoadBalancerPayloadTargetSecurityGroup{
+		Id: conversion.StringValueToPointer(model.SecurityGroupId),
+	}
+}
+
+// toUpdatePayload turns a Terraform load balancer model into an updateLoadBalancerPayload.
+// The current load balancer provides the version, which the API uses to reject concurrent changes,
+// and the labels, which aren't managed by Terraform but would be removed by the update otherwise.
+func toUpdatePayload(ctx context.Context, model *Model, current *loadbalancer.LoadBalancer) (*loadbalancer.UpdateLoadBalancerPayload, error) {
+	createPayload, err := toCreatePayload(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, fmt.Errorf("current load balancer is nil")
+	}
+
+	return &loadbalancer.UpdateLoadBalancerPayload{
+		ExternalAddress:                      createPayload.ExternalAddress,
+		DisableTargetSecurityGroupAssignment: createPayload.DisableTargetSecurityGroupAssignment,
+		Labels:                               current.Labels,
+		Listeners:                            createPayload.Listeners,
+		Name:                                 createPayload.Name,
+		PlanId:                               createPayload.PlanId,
+		Networks:                             createPayload.Networks,
+		Options:                              createPayload.Options,
+		TargetPools:                          createPayload.TargetPools,
+		TargetSecurityGroup:                  createPayload.TargetSecurityGroup,
+		Version:                              current.Version,
+	}, nil
+}
+
+// toLabelsPayload adds the provider-level default labels to the labels of the load balancer.
+// The load balancer has no labels attribute, so the default labels overwrite existing labels with the same key.
+func toLabelsPayload(labels *map[string]string, defaultLabels map[string]string) *map[string]string { //nolint:gocritic // pointer type matches the API payloads
+	if len(defaultLabels) == 0 {
+		return labels
+	}
+	merged := map[string]string{}
+	if labels != nil {
+		for k, v := range *labels {
+			merged[k] = v
+		}
+	}
+	for k, v := range defaultLabels {
+		merged[k] = v
+	}
+	return &merged
+}
+
 func toListenersPayload(ctx context.Context, model *Model) (*[]loadbalancer.Listener, error) {
 	if model.Listeners.IsNull() || model.Listeners.IsUnknown() {
 		return nil, nil
@@ -1326,6 +1593,10 @@ func toTargetsPayload(ctx context.Context, tp *targetPool) (*[]loadbalancer.Targ
 	payload := []loadbalancer.Target{}
 	for i := range targetsModel {
 		targetModel := targetsModel[i]
+		// Targets which are administratively down are drained by removing them from the target pool
+		if targetModel.AdministrativelyDown.ValueBool() {
+			continue
+		}
 		payload = append(payload, loadbalancer.Target{
 			DisplayName: conversion.StringValueToPointer(targetModel.DisplayName),
 			Ip:          conversion.StringValueToPointer(targetModel.Ip),
@@ -1378,7 +1649,7 @@ func mapFields(ctx context.Context, lb *loadbalancer.LoadBalancer, m *Model, reg
 	if err != nil {
 		return fmt.Errorf("mapping options: %w", err)
 	}
-	err = mapTargetPools(lb, m)
+	err = mapTargetPools(ctx, lb, m)
 	if err != nil {
 		return fmt.Errorf("mapping target pools: %w", err)
 	}
@@ -1631,12 +1902,33 @@ func mapACL(accessControlResp *loadbalancer.LoadbalancerOptionAccessControl, o m
 	return nil
 }
 
-func mapTargetPools(loadBalancerResp *loadbalancer.LoadBalancer, m *Model) error {
+func mapTargetPools(ctx context.Context, loadBalancerResp *loadbalancer.LoadBalancer, m *Model) error {
 	if loadBalancerResp.TargetPools == nil {
 		m.TargetPools = types.ListNull(types.ObjectType{AttrTypes: targetPoolTypes})
 		return nil
 	}
 
+	// The targets of the prior state are needed to keep the targets which are administratively down
+	priorTargets := map[string][]target{}
+	if !m.TargetPools.IsNull() && !m.TargetPools.IsUnknown() {
+		priorTargetPools := []targetPool{}
+		diags := m.TargetPools.ElementsAs(ctx, &priorTargetPools, false)
+		if diags.HasError() {
+			return fmt.Errorf("converting prior target pools: %w", core.DiagsToError(diags))
+		}
+		for i := range priorTargetPools {
+			if priorTargetPools[i].Targets.IsNull() || priorTargetPools[i].Targets.IsUnknown() {
+				continue
+			}
+			targets := []target{}
+			diags = priorTargetPools[i].Targets.ElementsAs(ctx, &targets, false)
+			if diags.HasError() {
+				return fmt.Errorf("converting prior targets: %w", core.DiagsToError(diags))
+			}
+			priorTargets[priorTargetPools[i].Name.ValueString()] = targets
+		}
+	}
+
 	targetPoolsList := []attr.Value{}
 	for i, targetPoolResp := range *loadBalancerResp.TargetPools {
 		targetPoolMap := map[string]attr.Value{
@@ -1649,7 +1941,7 @@ func mapTargetPools(loadBalancerResp *loadbalancer.LoadBalancer, m *Model) error
 			return fmt.Errorf("mapping index %d, field ActiveHealthCheck: %w", i, err)
 		}
 
-		err = mapTargets(targetPoolResp.Targets, targetPoolMap)
+		err = mapTargets(targetPoolResp.Targets, priorTargets[targetPoolResp.GetName()], targetPoolMap)
 		if err != nil {
 			return fmt.Errorf("mapping index %d, field Targets: %w", i, err)
 		}
@@ -1702,22 +1994,55 @@ func mapActiveHealthCheck(activeHealthCheckResp *loadbalancer.ActiveHealthCheck,
 	return nil
 }
 
-func mapTargets(targetsResp *[]loadbalancer.Target, tp map[string]attr.Value) error {
-	if targetsResp == nil || *targetsResp == nil {
+// mapTargets maps the targets of a target pool. The API doesn't return the targets which are administratively down,
+// so they are kept from the prior targets at their position, and the targets of the API fill the other positions in order.
+func mapTargets(targetsResp *[]loadbalancer.Target, priorTargets []target, tp map[string]attr.Value) error {
+	var respTargets []loadbalancer.Target
+	if targetsResp != nil {
+		respTargets = *targetsResp
+	}
+	respIps := map[string]bool{}
+	for i := range respTargets {
+		respIps[respTargets[i].GetIp()] = true
+	}
+	downTargets := map[int]target{}
+	for i := range priorTargets {
+		if priorTargets[i].AdministrativelyDown.ValueBool() && !respIps[priorTargets[i].Ip.ValueString()] {
+			downTargets[i] = priorTargets[i]
+		}
+	}
+	if (targetsResp == nil || *targetsResp == nil) && len(downTargets) == 0 {
 		tp["targets"] = types.ListNull(types.ObjectType{AttrTypes: targetTypes})
 		return nil
 	}
 
 	targetsList := []attr.Value{}
-	for i, targetResp := range *targetsResp {
-		targetMap := map[string]attr.Value{
-			"display_name": types.StringPointerValue(targetResp.DisplayName),
-			"ip":           types.StringPointerValue(targetResp.Ip),
+	remainingDownTargets := len(downTargets)
+	for i := 0; len(respTargets) > 0 || remainingDownTargets > 0; i++ {
+		var targetMap map[string]attr.Value
+		if downTarget, ok := downTargets[i]; ok {
+			remainingDownTargets--
+			targetMap = map[string]attr.Value{
+				"administratively_down": types.BoolValue(true),
+				"display_name":          downTarget.DisplayName,
+				"ip":                    downTarget.Ip,
+			}
+		} else if len(respTargets) > 0 {
+			targetResp := respTargets[0]
+			respTargets = respTargets[1:]
+			targetMap = map[string]attr.Value{
+				"administratively_down": types.BoolValue(false),
+				"display_name":          types.StringPointerValue(targetResp.DisplayName),
+				"ip":                    types.StringPointerValue(targetResp.Ip),
+			}
+		} else {
+			// the remaining positions before the next target which is administratively down were removed outside of Terraform
+			continue
 		}
 
 		targetTF, diags := types.ObjectValue(targetTypes, targetMap)
 		if diags.HasError() {
-			return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+			return fmt.Errorf("mapping index %d: %w", len(targetsList), core.DiagsToError(diags))
 		}
 
 		targetsList = append(targetsList, targetTF)
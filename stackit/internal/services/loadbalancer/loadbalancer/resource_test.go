@@ -43,6 +43,25 @@ func TestToCreatePayload(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"security_group_ok",
+			&Model{
+				SecurityGroupId: types.StringValue("sg-id"),
+			},
+			&loadbalancer.CreateLoadBalancerPayload{
+				Options: &loadbalancer.LoadBalancerOptions{
+					AccessControl: &loadbalancer.LoadbalancerOptionAccessControl{
+						AllowedSourceRanges: nil,
+					},
+					PrivateNetworkOnly: nil,
+					Observability:      &loadbalancer.LoadbalancerOptionObservability{},
+				},
+				TargetSecurityGroup: &loadbalancer.CreateLoadBalancerPayloadTargetSecurityGroup{
+					Id: utils.Ptr("sg-id"),
+				},
+			},
+			true,
+		},
 		{
 			"simple_values_ok",
 			&Model{
@@ -113,8 +132,9 @@ func TestToCreatePayload(t *testing.T) {
 						"target_port": types.Int64Value(80),
 						"targets": types.ListValueMust(types.ObjectType{AttrTypes: targetTypes}, []attr.Value{
 							types.ObjectValueMust(targetTypes, map[string]attr.Value{
-								"display_name": types.StringValue("display_name"),
-								"ip":           types.StringValue("ip"),
+								"administratively_down": types.BoolValue(false),
+								"display_name":          types.StringValue("display_name"),
+								"ip":                    types.StringValue("ip"),
 							}),
 						}),
 						"session_persistence": types.ObjectValueMust(sessionPersistenceTypes, map[string]attr.Value{
@@ -263,8 +283,9 @@ func TestToCreatePayload(t *testing.T) {
 						"target_port": types.Int64Value(80),
 						"targets": types.ListValueMust(types.ObjectType{AttrTypes: targetTypes}, []attr.Value{
 							types.ObjectValueMust(targetTypes, map[string]attr.Value{
-								"display_name": types.StringValue("display_name"),
-								"ip":           types.StringValue("ip"),
+								"administratively_down": types.BoolValue(false),
+								"display_name":          types.StringValue("display_name"),
+								"ip":                    types.StringValue("ip"),
 							}),
 						}),
 						"session_persistence": types.ObjectValueMust(sessionPersistenceTypes, map[string]attr.Value{
@@ -367,6 +388,160 @@ func TestToCreatePayload(t *testing.T) {
 	}
 }
 
+func TestToUpdatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		current     *loadbalancer.LoadBalancer
+		expected    *loadbalancer.UpdateLoadBalancerPayload
+		isValid     bool
+	}{
+		{
+			"default_values_ok",
+			&Model{},
+			&loadbalancer.LoadBalancer{Version: utils.Ptr("1")},
+			&loadbalancer.UpdateLoadBalancerPayload{
+				Options: &loadbalancer.LoadBalancerOptions{
+					AccessControl: &loadbalancer.LoadbalancerOptionAccessControl{
+						AllowedSourceRanges: nil,
+					},
+					PrivateNetworkOnly: nil,
+					Observability:      &loadbalancer.LoadbalancerOptionObservability{},
+				},
+				Version: utils.Ptr("1"),
+			},
+			true,
+		},
+		{
+			"security_group_ok",
+			&Model{
+				SecurityGroupId: types.StringValue("sg-id"),
+			},
+			&loadbalancer.LoadBalancer{Version: utils.Ptr("1")},
+			&loadbalancer.UpdateLoadBalancerPayload{
+				Options: &loadbalancer.LoadBalancerOptions{
+					AccessControl: &loadbalancer.LoadbalancerOptionAccessControl{
+						AllowedSourceRanges: nil,
+					},
+					PrivateNetworkOnly: nil,
+					Observability:      &loadbalancer.LoadbalancerOptionObservability{},
+				},
+				TargetSecurityGroup: &loadbalancer.CreateLoadBalancerPayloadTargetSecurityGroup{
+					Id: utils.Ptr("sg-id"),
+				},
+				Version: utils.Ptr("1"),
+			},
+			true,
+		},
+		{
+			"simple_values_ok",
+			&Model{
+				ExternalAddress: types.StringValue("external_address"),
+				Listeners: types.ListValueMust(types.ObjectType{AttrTypes: listenerTypes}, []attr.Value{
+					types.ObjectValueMust(listenerTypes, map[string]attr.Value{
+						"display_name":           types.StringValue("display_name"),
+						"port":                   types.Int64Value(443),
+						"protocol":               types.StringValue(string(loadbalancer.LISTENERPROTOCOL_TCP)),
+						"server_name_indicators": types.ListNull(types.ObjectType{AttrTypes: serverNameIndicatorTypes}),
+						"target_pool":            types.StringValue("target_pool"),
+						"tcp":                    types.ObjectNull(tcpTypes),
+						"udp":                    types.ObjectNull(udpTypes),
+					}),
+				}),
+				Name: types.StringValue("name"),
+				Networks: types.ListValueMust(types.ObjectType{AttrTypes: networkTypes}, []attr.Value{
+					types.ObjectValueMust(networkTypes, map[string]attr.Value{
+						"network_id": types.StringValue("network_id"),
+						"role":       types.StringValue(string(loadbalancer.NETWORKROLE_LISTENERS_AND_TARGETS)),
+					}),
+				}),
+				Options: types.ObjectValueMust(
+					optionsTypes,
+					map[string]attr.Value{
+						"acl": types.SetValueMust(
+							types.StringType,
+							[]attr.Value{types.StringValue("cidr")}),
+						"private_network_only": types.BoolValue(false),
+						"observability":        types.ObjectNull(observabilityTypes),
+					},
+				),
+			},
+			&loadbalancer.LoadBalancer{Version: utils.Ptr("2")},
+			&loadbalancer.UpdateLoadBalancerPayload{
+				ExternalAddress: utils.Ptr("external_address"),
+				Listeners: &[]loadbalancer.Listener{
+					{
+						DisplayName: utils.Ptr("display_name"),
+						Port:        utils.Ptr(int64(443)),
+						Protocol:    loadbalancer.LISTENERPROTOCOL_TCP.Ptr(),
+						TargetPool:  utils.Ptr("target_pool"),
+					},
+				},
+				Name: utils.Ptr("name"),
+				Networks: &[]loadbalancer.Network{
+					{
+						NetworkId: utils.Ptr("network_id"),
+						Role:      loadbalancer.NETWORKROLE_LISTENERS_AND_TARGETS.Ptr(),
+					},
+				},
+				Options: &loadbalancer.LoadBalancerOptions{
+					AccessControl: &loadbalancer.LoadbalancerOptionAccessControl{
+						AllowedSourceRanges: &[]string{"cidr"},
+					},
+					PrivateNetworkOnly: utils.Ptr(false),
+					Observability:      &loadbalancer.LoadbalancerOptionObservability{},
+				},
+				Version: utils.Ptr("2"),
+			},
+			true,
+		},
+		{
+			"labels_kept",
+			&Model{},
+			&loadbalancer.LoadBalancer{
+				Labels:  &map[string]string{"key": "value"},
+				Version: utils.Ptr("1"),
+			},
+			&loadbalancer.UpdateLoadBalancerPayload{
+				Labels: &map[string]string{"key": "value"},
+				Options: &loadbalancer.LoadBalancerOptions{
+					AccessControl: &loadbalancer.LoadbalancerOptionAccessControl{
+						AllowedSourceRanges: nil,
+					},
+					PrivateNetworkOnly: nil,
+					Observability:      &loadbalancer.LoadbalancerOptionObservability{},
+				},
+				Version: utils.Ptr("1"),
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			&loadbalancer.LoadBalancer{Version: utils.Ptr("1")},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toUpdatePayload(context.Background(), tt.input, tt.current)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
 func TestToTargetPoolUpdatePayload(t *testing.T) {
 	tests := []struct {
 		description string
@@ -394,8 +569,14 @@ func TestToTargetPoolUpdatePayload(t *testing.T) {
 				TargetPort: types.Int64Value(80),
 				Targets: types.ListValueMust(types.ObjectType{AttrTypes: targetTypes}, []attr.Value{
 					types.ObjectValueMust(targetTypes, map[string]attr.Value{
-						"display_name": types.StringValue("display_name"),
-						"ip":           types.StringValue("ip"),
+						"administratively_down": types.BoolValue(false),
+						"display_name":          types.StringValue("display_name"),
+						"ip":                    types.StringValue("ip"),
+					}),
+					types.ObjectValueMust(targetTypes, map[string]attr.Value{
+						"administratively_down": types.BoolValue(true),
+						"display_name":          types.StringValue("maintenance"),
+						"ip":                    types.StringValue("ip2"),
 					}),
 				}),
 				SessionPersistence: types.ObjectValueMust(sessionPersistenceTypes, map[string]attr.Value{
@@ -450,6 +631,111 @@ func TestToTargetPoolUpdatePayload(t *testing.T) {
 	}
 }
 
+func TestMapTargets(t *testing.T) {
+	targetValue := func(displayName, ip string, administrativelyDown bool) attr.Value {
+		return types.ObjectValueMust(targetTypes, map[string]attr.Value{
+			"administratively_down": types.BoolValue(administrativelyDown),
+			"display_name":          types.StringValue(displayName),
+			"ip":                    types.StringValue(ip),
+		})
+	}
+	priorTarget := func(displayName, ip string, administrativelyDown bool) target {
+		return target{
+			AdministrativelyDown: types.BoolValue(administrativelyDown),
+			DisplayName:          types.StringValue(displayName),
+			Ip:                   types.StringValue(ip),
+		}
+	}
+	respTarget := func(displayName, ip string) loadbalancer.Target {
+		return loadbalancer.Target{
+			DisplayName: utils.Ptr(displayName),
+			Ip:          utils.Ptr(ip),
+		}
+	}
+	tests := []struct {
+		description  string
+		input        *[]loadbalancer.Target
+		priorTargets []target
+		expected     types.List
+	}{
+		{
+			"no_targets",
+			nil,
+			nil,
+			types.ListNull(types.ObjectType{AttrTypes: targetTypes}),
+		},
+		{
+			"no_prior_targets",
+			&[]loadbalancer.Target{respTarget("a", "1.1.1.1"), respTarget("b", "2.2.2.2")},
+			nil,
+			types.ListValueMust(types.ObjectType{AttrTypes: targetTypes}, []attr.Value{
+				targetValue("a", "1.1.1.1", false),
+				targetValue("b", "2.2.2.2", false),
+			}),
+		},
+		{
+			"administratively_down_target_kept_at_position",
+			&[]loadbalancer.Target{respTarget("a", "1.1.1.1"), respTarget("c", "3.3.3.3")},
+			[]target{
+				priorTarget("a", "1.1.1.1", false),
+				priorTarget("b", "2.2.2.2", true),
+				priorTarget("c", "3.3.3.3", false),
+			},
+			types.ListValueMust(types.ObjectType{AttrTypes: targetTypes}, []attr.Value{
+				targetValue("a", "1.1.1.1", false),
+				targetValue("b", "2.2.2.2", true),
+				targetValue("c", "3.3.3.3", false),
+			}),
+		},
+		{
+			"all_targets_administratively_down",
+			nil,
+			[]target{
+				priorTarget("a", "1.1.1.1", true),
+			},
+			types.ListValueMust(types.ObjectType{AttrTypes: targetTypes}, []attr.Value{
+				targetValue("a", "1.1.1.1", true),
+			}),
+		},
+		{
+			"administratively_down_target_added_outside_terraform",
+			&[]loadbalancer.Target{respTarget("a", "1.1.1.1")},
+			[]target{
+				priorTarget("a", "1.1.1.1", true),
+			},
+			types.ListValueMust(types.ObjectType{AttrTypes: targetTypes}, []attr.Value{
+				targetValue("a", "1.1.1.1", false),
+			}),
+		},
+		{
+			"target_removed_outside_terraform",
+			&[]loadbalancer.Target{respTarget("a", "1.1.1.1")},
+			[]target{
+				priorTarget("a", "1.1.1.1", false),
+				priorTarget("b", "2.2.2.2", false),
+				priorTarget("c", "3.3.3.3", true),
+			},
+			types.ListValueMust(types.ObjectType{AttrTypes: targetTypes}, []attr.Value{
+				targetValue("a", "1.1.1.1", false),
+				targetValue("c", "3.3.3.3", true),
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			tp := map[string]attr.Value{}
+			err := mapTargets(tt.input, tt.priorTargets, tp)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(tp["targets"], tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
 func TestMapFields(t *testing.T) {
 	const testRegion = "eu01"
 	id := fmt.Sprintf("%s,%s,%s", "pid", testRegion, "name")
@@ -655,8 +941,9 @@ func TestMapFields(t *testing.T) {
 						"target_port": types.Int64Value(80),
 						"targets": types.ListValueMust(types.ObjectType{AttrTypes: targetTypes}, []attr.Value{
 							types.ObjectValueMust(targetTypes, map[string]attr.Value{
-								"display_name": types.StringValue("display_name"),
-								"ip":           types.StringValue("ip"),
+								"administratively_down": types.BoolValue(false),
+								"display_name":          types.StringValue("display_name"),
+								"ip":                    types.StringValue("ip"),
 							}),
 						}),
 						"session_persistence": types.ObjectValueMust(sessionPersistenceTypes, map[string]attr.Value{
@@ -803,8 +1090,9 @@ func TestMapFields(t *testing.T) {
 						"target_port": types.Int64Value(80),
 						"targets": types.ListValueMust(types.ObjectType{AttrTypes: targetTypes}, []attr.Value{
 							types.ObjectValueMust(targetTypes, map[string]attr.Value{
-								"display_name": types.StringValue("display_name"),
-								"ip":           types.StringValue("ip"),
+								"administratively_down": types.BoolValue(false),
+								"display_name":          types.StringValue("display_name"),
+								"ip":                    types.StringValue("ip"),
 							}),
 						}),
 						"session_persistence": types.ObjectValueMust(sessionPersistenceTypes, map[string]attr.Value{
@@ -951,3 +1239,178 @@ func Test_validateConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateProxyProtocol(t *testing.T) {
+	type listenerArgs struct {
+		Protocol   loadbalancer.ListenerProtocol
+		TargetPool string
+	}
+	tests := []struct {
+		name      string
+		listeners []listenerArgs
+		wantErr   bool
+	}{
+		{
+			name: "happy case 1: all listeners of the target pool use PROXY protocol",
+			listeners: []listenerArgs{
+				{Protocol: loadbalancer.LISTENERPROTOCOL_TCP_PROXY, TargetPool: "target_pool"},
+				{Protocol: loadbalancer.LISTENERPROTOCOL_TCP_PROXY, TargetPool: "target_pool"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "happy case 2: different target pools",
+			listeners: []listenerArgs{
+				{Protocol: loadbalancer.LISTENERPROTOCOL_TCP_PROXY, TargetPool: "target_pool"},
+				{Protocol: loadbalancer.LISTENERPROTOCOL_TCP, TargetPool: "other_target_pool"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "happy case 3: UDP listener of the same target pool",
+			listeners: []listenerArgs{
+				{Protocol: loadbalancer.LISTENERPROTOCOL_TCP_PROXY, TargetPool: "target_pool"},
+				{Protocol: loadbalancer.LISTENERPROTOCOL_UDP, TargetPool: "target_pool"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "error case 1: target pool used with and without PROXY protocol",
+			listeners: []listenerArgs{
+				{Protocol: loadbalancer.LISTENERPROTOCOL_TCP, TargetPool: "target_pool"},
+				{Protocol: loadbalancer.LISTENERPROTOCOL_TCP_PROXY, TargetPool: "target_pool"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			diags := diag.Diagnostics{}
+			listeners := []attr.Value{}
+			for _, l := range tt.listeners {
+				listeners = append(listeners, types.ObjectValueMust(listenerTypes, map[string]attr.Value{
+					"display_name":           types.StringNull(),
+					"port":                   types.Int64Value(80),
+					"protocol":               types.StringValue(string(l.Protocol)),
+					"server_name_indicators": types.ListNull(types.ObjectType{AttrTypes: serverNameIndicatorTypes}),
+					"target_pool":            types.StringValue(l.TargetPool),
+					"tcp":                    types.ObjectNull(tcpTypes),
+					"udp":                    types.ObjectNull(udpTypes),
+				}))
+			}
+			model := &Model{
+				Listeners: types.ListValueMust(types.ObjectType{AttrTypes: listenerTypes}, listeners),
+			}
+
+			validateProxyProtocol(ctx, &diags, model)
+
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("validateProxyProtocol() = %v, want %v", diags.HasError(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateDrainedTargetPools(t *testing.T) {
+	tests := []struct {
+		name                 string
+		administrativelyDown []types.Bool
+		wantErr              bool
+	}{
+		{
+			name:                 "happy case 1: no target drained",
+			administrativelyDown: []types.Bool{types.BoolNull(), types.BoolValue(false)},
+			wantErr:              false,
+		},
+		{
+			name:                 "happy case 2: one of the targets drained",
+			administrativelyDown: []types.Bool{types.BoolValue(true), types.BoolValue(false)},
+			wantErr:              false,
+		},
+		{
+			name:                 "happy case 3: drained state of a target unknown",
+			administrativelyDown: []types.Bool{types.BoolValue(true), types.BoolUnknown()},
+			wantErr:              false,
+		},
+		{
+			name:                 "error case 1: all targets drained",
+			administrativelyDown: []types.Bool{types.BoolValue(true), types.BoolValue(true)},
+			wantErr:              true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			diags := diag.Diagnostics{}
+			targets := []attr.Value{}
+			for i, administrativelyDown := range tt.administrativelyDown {
+				targets = append(targets, types.ObjectValueMust(targetTypes, map[string]attr.Value{
+					"administratively_down": administrativelyDown,
+					"display_name":          types.StringValue(fmt.Sprintf("target-%d", i)),
+					"ip":                    types.StringValue(fmt.Sprintf("10.0.0.%d", i+1)),
+				}))
+			}
+			model := &Model{
+				TargetPools: types.ListValueMust(types.ObjectType{AttrTypes: targetPoolTypes}, []attr.Value{
+					types.ObjectValueMust(targetPoolTypes, map[string]attr.Value{
+						"active_health_check": types.ObjectNull(activeHealthCheckTypes),
+						"name":                types.StringValue("target_pool"),
+						"target_port":         types.Int64Value(80),
+						"targets":             types.ListValueMust(types.ObjectType{AttrTypes: targetTypes}, targets),
+						"session_persistence": types.ObjectNull(sessionPersistenceTypes),
+					}),
+				}),
+			}
+
+			validateDrainedTargetPools(ctx, &diags, model)
+
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("validateDrainedTargetPools() = %v, want %v", diags.HasError(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestToLabelsPayload(t *testing.T) {
+	tests := []struct {
+		description   string
+		labels        *map[string]string
+		defaultLabels map[string]string
+		expected      *map[string]string
+	}{
+		{
+			"no_default_labels",
+			&map[string]string{"key": "value"},
+			nil,
+			&map[string]string{"key": "value"},
+		},
+		{
+			"no_labels",
+			nil,
+			map[string]string{"team": "platform"},
+			&map[string]string{"team": "platform"},
+		},
+		{
+			"default_labels_added",
+			&map[string]string{"key": "value", "team": "old"},
+			map[string]string{"team": "platform"},
+			&map[string]string{"key": "value", "team": "platform"},
+		},
+		{
+			"nothing",
+			nil,
+			nil,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := toLabelsPayload(tt.labels, tt.defaultLabels)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -32,9 +32,9 @@ var testConfigVarsMin = config.Variables{
 	"project_id":                        config.StringVariable(testutil.ProjectId),
 	"plan_id":                           config.StringVariable("p10"),
 	"disable_security_group_assignment": config.BoolVariable(false),
-	"network_name":                      config.StringVariable(fmt.Sprintf("tf-acc-n%s", acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
-	"server_name":                       config.StringVariable(fmt.Sprintf("tf-acc-s%s", acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
-	"loadbalancer_name":                 config.StringVariable(fmt.Sprintf("tf-acc-l%s", acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
+	"network_name":                      config.StringVariable(fmt.Sprintf("tf-acc-n%s", testutil.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
+	"server_name":                       config.StringVariable(fmt.Sprintf("tf-acc-s%s", testutil.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
+	"loadbalancer_name":                 config.StringVariable(fmt.Sprintf("tf-acc-l%s", testutil.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
 	"target_pool_name":                  config.StringVariable("example-target-pool"),
 	"target_port":                       config.StringVariable("5432"),
 	"target_display_name":               config.StringVariable("example-target"),
@@ -51,10 +51,10 @@ var testConfigVarsMax = config.Variables{
 	"project_id":                        config.StringVariable(testutil.ProjectId),
 	"plan_id":                           config.StringVariable("p10"),
 	"disable_security_group_assignment": config.BoolVariable(true),
-	"network_name":                      config.StringVariable(fmt.Sprintf("tf-acc-n%s", acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
+	"network_name":                      config.StringVariable(fmt.Sprintf("tf-acc-n%s", testutil.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
 	"network_role":                      config.StringVariable("ROLE_LISTENERS_AND_TARGETS"),
-	"server_name":                       config.StringVariable(fmt.Sprintf("tf-acc-s%s", acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
-	"loadbalancer_name":                 config.StringVariable(fmt.Sprintf("tf-acc-l%s", acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
+	"server_name":                       config.StringVariable(fmt.Sprintf("tf-acc-s%s", testutil.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
+	"loadbalancer_name":                 config.StringVariable(fmt.Sprintf("tf-acc-l%s", testutil.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
 
 	"target_display_name": config.StringVariable("example-target"),
 
@@ -84,10 +84,10 @@ var testConfigVarsMax = config.Variables{
 
 	"observability_logs_push_url":               config.StringVariable("https://logs.observability.dummy.stackit.cloud"),
 	"observability_metrics_push_url":            config.StringVariable("https://metrics.observability.dummy.stackit.cloud"),
-	"observability_credential_logs_name":        config.StringVariable(fmt.Sprintf("tf-acc-l%s", acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
+	"observability_credential_logs_name":        config.StringVariable(fmt.Sprintf("tf-acc-l%s", testutil.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
 	"observability_credential_logs_username":    config.StringVariable("obs-cred-logs-username"),
 	"observability_credential_logs_password":    config.StringVariable("obs-cred-logs-password"),
-	"observability_credential_metrics_name":     config.StringVariable(fmt.Sprintf("tf-acc-m%s", acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
+	"observability_credential_metrics_name":     config.StringVariable(fmt.Sprintf("tf-acc-m%s", testutil.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
 	"observability_credential_metrics_username": config.StringVariable("obs-cred-metrics-username"),
 	"observability_credential_metrics_password": config.StringVariable("obs-cred-metrics-password"),
 }
@@ -30,9 +30,12 @@ var (
 	_ resource.Resource                = &observabilityCredentialResource{}
 	_ resource.ResourceWithConfigure   = &observabilityCredentialResource{}
 	_ resource.ResourceWithImportState = &observabilityCredentialResource{}
+	_ resource.ResourceWithIdentity    = &observabilityCredentialResource{}
 	_ resource.ResourceWithModifyPlan  = &observabilityCredentialResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "credentials_ref"}
+
 type Model struct {
 	Id             types.String `tfsdk:"id"` // needed by TF
 	ProjectId      types.String `tfsdk:"project_id"`
@@ -59,6 +62,11 @@ func (r *observabilityCredentialResource) Metadata(_ context.Context, req resour
 	resp.TypeName = req.ProviderTypeName + "_loadbalancer_observability_credential"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *observabilityCredentialResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan.
 // Use the modifier to set the effective region in the current plan.
 func (r *observabilityCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
@@ -78,7 +86,7 @@ func (r *observabilityCredentialResource) ModifyPlan(ctx context.Context, req re
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -109,12 +117,12 @@ func (r *observabilityCredentialResource) Configure(ctx context.Context, req res
 func (r *observabilityCredentialResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	descriptions := map[string]string{
 		"main":            "Load balancer observability credential resource schema. Must have a `region` specified in the provider configuration. These contain the username and password for the observability service (e.g. Argus) where the load balancer logs/metrics will be pushed into",
-		"id":              "Terraform's internal resource ID. It is structured as \"`project_id`\",\"region\",\"`credentials_ref`\".",
+		"id":              "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`credentials_ref`\".",
 		"credentials_ref": "The credentials reference is used by the Load Balancer to define which credentials it will use.",
 		"project_id":      "STACKIT project ID to which the load balancer observability credential is associated.",
 		"display_name":    "Observability credential name.",
-		"username":        "The password for the observability service (e.g. Argus) where the logs/metrics will be pushed into.",
-		"password":        "The username for the observability service (e.g. Argus) where the logs/metrics will be pushed into.",
+		"username":        "The username for the observability service (e.g. Argus) where the logs/metrics will be pushed into.",
+		"password":        "The password for the observability service (e.g. Argus) where the logs/metrics will be pushed into.",
 		"region":          "The resource region. If not defined, the provider region is used.",
 	}
 
@@ -162,6 +170,7 @@ func (r *observabilityCredentialResource) Schema(_ context.Context, _ resource.S
 			"password": schema.StringAttribute{
 				Description: descriptions["password"],
 				Required:    true,
+				Sensitive:   true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -181,6 +190,8 @@ func (r *observabilityCredentialResource) Schema(_ context.Context, _ resource.S
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *observabilityCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -233,6 +244,8 @@ func (r *observabilityCredentialResource) Create(ctx context.Context, req resour
 
 // Read refreshes the Terraform state with the latest data.
 func (r *observabilityCredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -254,7 +267,7 @@ func (r *observabilityCredentialResource) Read(ctx context.Context, req resource
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_loadbalancer_observability_credential", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading observability credential", fmt.Sprintf("Calling API: %v", err))
@@ -317,12 +330,13 @@ func (r *observabilityCredentialResource) Delete(ctx context.Context, req resour
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,name
 func (r *observabilityCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing observability credential",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[credentials_ref]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[credentials_ref]  Got: %q", importId),
 		)
 		return
 	}
@@ -69,6 +69,7 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	}
 
 	parametersDescriptions := map[string]string{
+		"acl":                    "List of IP networks in CIDR notation which are allowed to access this instance.",
 		"sgw_acl":                "Comma separated list of IP networks in CIDR notation which are allowed to access this instance.",
 		"enable_monitoring":      "Enable monitoring.",
 		"graphite":               "If set, monitoring with Graphite will be enabled. Expects the host and port where the Graphite metrics should be sent to (host:port).",
@@ -125,6 +126,11 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			},
 			"parameters": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
+					"acl": schema.ListAttribute{
+						Description: parametersDescriptions["acl"],
+						ElementType: types.StringType,
+						Computed:    true,
+					},
 					"sgw_acl": schema.StringAttribute{
 						Description: parametersDescriptions["sgw_acl"],
 						Computed:    true,
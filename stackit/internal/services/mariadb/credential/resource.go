@@ -15,6 +15,7 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -31,8 +32,11 @@ var (
 	_ resource.Resource                = &credentialResource{}
 	_ resource.ResourceWithConfigure   = &credentialResource{}
 	_ resource.ResourceWithImportState = &credentialResource{}
+	_ resource.ResourceWithIdentity    = &credentialResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "instance_id", "credential_id"}
+
 type Model struct {
 	Id           types.String `tfsdk:"id"` // needed by TF
 	CredentialId types.String `tfsdk:"credential_id"`
@@ -47,6 +51,13 @@ type Model struct {
 	Username     types.String `tfsdk:"username"`
 }
 
+// resourceModel extends the model shared with the data source by the attributes which only apply to the resource
+type resourceModel struct {
+	Model
+	RotateWhenChanged      types.Map    `tfsdk:"rotate_when_changed"`
+	RenderKubernetesSecret types.Object `tfsdk:"render_kubernetes_secret"`
+}
+
 // NewCredentialResource is a helper function to simplify the provider implementation.
 func NewCredentialResource() resource.Resource {
 	return &credentialResource{}
@@ -54,7 +65,8 @@ func NewCredentialResource() resource.Resource {
 
 // credentialResource is the resource implementation.
 type credentialResource struct {
-	client *mariadb.APIClient
+	client       *mariadb.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -62,6 +74,11 @@ func (r *credentialResource) Metadata(_ context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_mariadb_credential"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *credentialResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *credentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
@@ -74,6 +91,7 @@ func (r *credentialResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 	r.client = apiClient
+	r.providerData = providerData
 	tflog.Info(ctx, "MariaDB credential client configured")
 }
 
@@ -156,13 +174,17 @@ func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"username": schema.StringAttribute{
 				Computed: true,
 			},
+			"rotate_when_changed":      utils.RotateWhenChangedAttribute("credential"),
+			"render_kubernetes_secret": utils.KubernetesSecretAttribute("credential"),
 		},
 	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	var model resourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -192,18 +214,23 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 	credentialId := *credentialsResp.Id
 	ctx = tflog.SetField(ctx, "credential_id", credentialId)
 
-	waitResp, err := wait.CreateCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialId).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandler(wait.CreateCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialId), r.providerData.Wait, core.WaitCreate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credential", fmt.Sprintf("Instance creation waiting: %v", err))
 		return
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, waitResp, &model)
+	err = mapFields(ctx, waitResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credential", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	err = mapKubernetesSecret(&model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credential", fmt.Sprintf("Rendering Kubernetes Secret: %v", err))
+		return
+	}
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -214,7 +241,9 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 
 // Read refreshes the Terraform state with the latest data.
 func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var model resourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -234,7 +263,7 @@ func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_mariadb_credential", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading credential", fmt.Sprintf("Calling API: %v", err))
@@ -244,11 +273,16 @@ func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 	ctx = core.LogResponse(ctx)
 
 	// Map response body to schema
-	err = mapFields(ctx, recordSetResp, &model)
+	err = mapFields(ctx, recordSetResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading credential", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	err = mapKubernetesSecret(&model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading credential", fmt.Sprintf("Rendering Kubernetes Secret: %v", err))
+		return
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
@@ -260,14 +294,44 @@ func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
-func (r *credentialResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
-	// Update shouldn't be called
-	core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating credential", "Credential can't be updated")
+// The credential itself can't be updated, only the rendered Kubernetes Secret changes.
+func (r *credentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model resourceModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var stateModel resourceModel
+	diags = req.State.Get(ctx, &stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+	ctx = tflog.SetField(ctx, "project_id", stateModel.ProjectId.ValueString())
+	ctx = tflog.SetField(ctx, "instance_id", stateModel.InstanceId.ValueString())
+	ctx = tflog.SetField(ctx, "credential_id", stateModel.CredentialId.ValueString())
+
+	// The computed attributes of the credential are unknown in the plan, but don't change
+	model.Model = stateModel.Model
+	err := mapKubernetesSecret(&model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating credential", fmt.Sprintf("Rendering Kubernetes Secret: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "MariaDB credential updated")
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model resourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -291,7 +355,7 @@ func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.DeleteCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.DeleteCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialId), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting credential", fmt.Sprintf("Instance deletion waiting: %v", err))
 		return
@@ -302,11 +366,12 @@ func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,instance_id,credential_id
 func (r *credentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing credential",
-			fmt.Sprintf("Expected import identifier with format [project_id],[instance_id],[credential_id], got %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format [project_id],[instance_id],[credential_id], got %q", importId),
 		)
 		return
 	}
@@ -373,3 +438,19 @@ func mapFields(ctx context.Context, credentialsResp *mariadb.CredentialsResponse
 	}
 	return nil
 }
+
+// mapKubernetesSecret renders the Kubernetes Secret manifest with the credential, if render_kubernetes_secret is set.
+func mapKubernetesSecret(model *resourceModel) error {
+	kubernetesSecret, err := utils.RenderKubernetesSecret(model.RenderKubernetesSecret, map[string]attr.Value{
+		"host":     model.Host,
+		"password": model.Password,
+		"port":     model.Port,
+		"uri":      model.Uri,
+		"username": model.Username,
+	})
+	if err != nil {
+		return err
+	}
+	model.RenderKubernetesSecret = kubernetesSecret
+	return nil
+}
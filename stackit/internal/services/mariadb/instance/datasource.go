@@ -69,6 +69,7 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	}
 
 	parametersDescriptions := map[string]string{
+		"acl":                    "List of IP networks in CIDR notation which are allowed to access this instance.",
 		"sgw_acl":                "Comma separated list of IP networks in CIDR notation which are allowed to access this instance.",
 		"enable_monitoring":      "Enable monitoring.",
 		"max_disk_threshold":     "The maximum disk threshold in MB. If the disk usage exceeds this threshold, the instance will be stopped.",
@@ -119,6 +120,11 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			},
 			"parameters": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
+					"acl": schema.ListAttribute{
+						Description: parametersDescriptions["acl"],
+						ElementType: types.StringType,
+						Computed:    true,
+					},
 					"sgw_acl": schema.StringAttribute{
 						Description: parametersDescriptions["sgw_acl"],
 						Computed:    true,
@@ -170,6 +176,28 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			"cf_organization_guid": schema.StringAttribute{
 				Computed: true,
 			},
+			"metrics": schema.SingleNestedAttribute{
+				Description: metricsDescriptions["metrics"],
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"disk_ephemeral_total": schema.Int64Attribute{
+						Description: metricsDescriptions["disk_ephemeral_total"],
+						Computed:    true,
+					},
+					"disk_ephemeral_used": schema.Int64Attribute{
+						Description: metricsDescriptions["disk_ephemeral_used"],
+						Computed:    true,
+					},
+					"disk_persistent_total": schema.Int64Attribute{
+						Description: metricsDescriptions["disk_persistent_total"],
+						Computed:    true,
+					},
+					"disk_persistent_used": schema.Int64Attribute{
+						Description: metricsDescriptions["disk_persistent_used"],
+						Computed:    true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -221,6 +249,7 @@ func (r *instanceDataSource) Read(ctx context.Context, req datasource.ReadReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading instance", fmt.Sprintf("Loading service plan details: %v", err))
 		return
 	}
+	loadMetrics(ctx, r.client, &model)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &model)
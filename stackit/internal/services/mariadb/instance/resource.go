@@ -10,6 +10,7 @@ import (
 
 	mariadbUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mariadb/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -35,8 +36,11 @@ var (
 	_ resource.Resource                = &instanceResource{}
 	_ resource.ResourceWithConfigure   = &instanceResource{}
 	_ resource.ResourceWithImportState = &instanceResource{}
+	_ resource.ResourceWithIdentity    = &instanceResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "instance_id"}
+
 type Model struct {
 	Id                 types.String `tfsdk:"id"` // needed by TF
 	InstanceId         types.String `tfsdk:"instance_id"`
@@ -51,10 +55,18 @@ type Model struct {
 	Version            types.String `tfsdk:"version"`
 	PlanName           types.String `tfsdk:"plan_name"`
 	PlanId             types.String `tfsdk:"plan_id"`
+	Metrics            types.Object `tfsdk:"metrics"`
+}
+
+// resourceModel extends the model shared with the data source by the attributes which only apply to the resource
+type resourceModel struct {
+	Model
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
 }
 
 // Struct corresponding to DataSourceModel.Parameters
 type parametersModel struct {
+	ACL                  types.List   `tfsdk:"acl"`
 	SgwAcl               types.String `tfsdk:"sgw_acl"`
 	EnableMonitoring     types.Bool   `tfsdk:"enable_monitoring"`
 	Graphite             types.String `tfsdk:"graphite"`
@@ -67,6 +79,7 @@ type parametersModel struct {
 
 // Types corresponding to parametersModel
 var parametersTypes = map[string]attr.Type{
+	"acl":                    basetypes.ListType{ElemType: types.StringType},
 	"sgw_acl":                basetypes.StringType{},
 	"enable_monitoring":      basetypes.BoolType{},
 	"graphite":               basetypes.StringType{},
@@ -77,6 +90,23 @@ var parametersTypes = map[string]attr.Type{
 	"syslog":                 basetypes.ListType{ElemType: types.StringType},
 }
 
+// Types corresponding to Model.Metrics
+var metricsTypes = map[string]attr.Type{
+	"disk_ephemeral_total":  basetypes.Int64Type{},
+	"disk_ephemeral_used":   basetypes.Int64Type{},
+	"disk_persistent_total": basetypes.Int64Type{},
+	"disk_persistent_used":  basetypes.Int64Type{},
+}
+
+// metricsDescriptions are shared by the resource and the data source.
+var metricsDescriptions = map[string]string{
+	"metrics":               "Storage metrics of the instance, refreshed on every read. Not set, if the metrics aren't available, e.g. while the instance is being created.",
+	"disk_ephemeral_total":  "Total ephemeral disk space of the instance.",
+	"disk_ephemeral_used":   "Used ephemeral disk space of the instance.",
+	"disk_persistent_total": "Total persistent disk space of the instance.",
+	"disk_persistent_used":  "Used persistent disk space of the instance.",
+}
+
 // NewInstanceResource is a helper function to simplify the provider implementation.
 func NewInstanceResource() resource.Resource {
 	return &instanceResource{}
@@ -84,7 +114,8 @@ func NewInstanceResource() resource.Resource {
 
 // instanceResource is the resource implementation.
 type instanceResource struct {
-	client *mariadb.APIClient
+	client       *mariadb.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -92,6 +123,11 @@ func (r *instanceResource) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_mariadb_instance"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *instanceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *instanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
@@ -104,6 +140,7 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 	r.client = apiClient
+	r.providerData = providerData
 	tflog.Info(ctx, "MariaDB instance client configured")
 }
 
@@ -122,7 +159,8 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 
 	parametersDescriptions := map[string]string{
-		"sgw_acl":                "Comma separated list of IP networks in CIDR notation which are allowed to access this instance.",
+		"acl":                    "List of IP networks in CIDR notation which are allowed to access this instance. Can be used instead of `sgw_acl`.",
+		"sgw_acl":                "Comma separated list of IP networks in CIDR notation which are allowed to access this instance. This field is deprecated, use `acl` instead.",
 		"graphite":               "Graphite server URL (host and port). If set, monitoring with Graphite will be enabled.",
 		"enable_monitoring":      "Enable monitoring.",
 		"max_disk_threshold":     "The maximum disk threshold in MB. If the disk usage exceeds this threshold, the instance will be stopped.",
@@ -169,7 +207,7 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: descriptions["name"],
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					utils.RequiresReplaceIfRenameRejected(),
 					stringplanmodifier.UseStateForUnknown(),
 				},
 				Validators: []validator.String{
@@ -191,10 +229,21 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			"parameters": schema.SingleNestedAttribute{
 				Description: descriptions["parameters"],
 				Attributes: map[string]schema.Attribute{
-					"sgw_acl": schema.StringAttribute{
-						Description: parametersDescriptions["sgw_acl"],
+					"acl": schema.ListAttribute{
+						Description: parametersDescriptions["acl"],
+						ElementType: types.StringType,
 						Optional:    true,
 						Computed:    true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(validate.CIDR()),
+							listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("sgw_acl")),
+						},
+					},
+					"sgw_acl": schema.StringAttribute{
+						Description:        parametersDescriptions["sgw_acl"],
+						DeprecationMessage: "Use `acl` instead.",
+						Optional:           true,
+						Computed:           true,
 					},
 					"enable_monitoring": schema.BoolAttribute{
 						Description: parametersDescriptions["enable_monitoring"],
@@ -270,13 +319,38 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"deletion_protection": utils.DeletionProtectionAttribute("MariaDB instance"),
+			"metrics": schema.SingleNestedAttribute{
+				Description: metricsDescriptions["metrics"],
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"disk_ephemeral_total": schema.Int64Attribute{
+						Description: metricsDescriptions["disk_ephemeral_total"],
+						Computed:    true,
+					},
+					"disk_ephemeral_used": schema.Int64Attribute{
+						Description: metricsDescriptions["disk_ephemeral_used"],
+						Computed:    true,
+					},
+					"disk_persistent_total": schema.Int64Attribute{
+						Description: metricsDescriptions["disk_persistent_total"],
+						Computed:    true,
+					},
+					"disk_persistent_used": schema.Int64Attribute{
+						Description: metricsDescriptions["disk_persistent_used"],
+						Computed:    true,
+					},
+				},
+			},
 		},
 	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *instanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	var model resourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -298,14 +372,14 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 		}
 	}
 
-	err := r.loadPlanId(ctx, &model)
+	err := r.loadPlanId(ctx, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Loading service plan: %v", err))
 		return
 	}
 
 	// Generate API request body from model
-	payload, err := toCreatePayload(&model, parameters)
+	payload, err := toCreatePayload(&model.Model, parameters)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -321,18 +395,19 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 
 	instanceId := *createResp.InstanceId
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
-	waitResp, err := wait.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandler(wait.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId), r.providerData.Wait, core.WaitCreate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
 		return
 	}
 
 	// Map response body to schema
-	err = mapFields(waitResp, &model)
+	err = mapFields(waitResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	loadMetrics(ctx, r.client, &model.Model)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, model)
@@ -345,7 +420,9 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 
 // Read refreshes the Terraform state with the latest data.
 func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var model resourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -363,7 +440,7 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && (oapiErr.StatusCode == http.StatusNotFound || oapiErr.StatusCode == http.StatusGone) {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_mariadb_instance", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading instance", fmt.Sprintf("Calling API: %v", err))
@@ -373,18 +450,19 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 	ctx = core.LogResponse(ctx)
 
 	// Map response body to schema
-	err = mapFields(instanceResp, &model)
+	err = mapFields(instanceResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading instance", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
 
 	// Compute and store values not present in the API response
-	err = loadPlanNameAndVersion(ctx, r.client, &model)
+	err = loadPlanNameAndVersion(ctx, r.client, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading instance", fmt.Sprintf("Loading service plan details: %v", err))
 		return
 	}
+	loadMetrics(ctx, r.client, &model.Model)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
@@ -397,7 +475,9 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *instanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var model resourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -411,6 +491,14 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
 
+	var stateName types.String
+	diags = req.State.GetAttribute(ctx, path.Root("name"), &stateName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	nameChanged := !model.Name.Equal(stateName)
+
 	var parameters *parametersModel
 	if !(model.Parameters.IsNull() || model.Parameters.IsUnknown()) {
 		parameters = &parametersModel{}
@@ -421,14 +509,14 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 	}
 
-	err := r.loadPlanId(ctx, &model)
+	err := r.loadPlanId(ctx, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Loading service plan: %v", err))
 		return
 	}
 
 	// Generate API request body from model
-	payload, err := toUpdatePayload(&model, parameters)
+	payload, err := toUpdatePayload(&model.Model, parameters, stateName)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -436,24 +524,25 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	// Update existing instance
 	err = r.client.PartialUpdateInstance(ctx, projectId, instanceId).PartialUpdateInstancePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Calling API: %v", err))
+		utils.LogUpdateErrorWithRenameFallback(ctx, &resp.Diagnostics, resp.Private, err, nameChanged, "Error updating instance")
 		return
 	}
 
 	ctx = core.LogResponse(ctx)
 
-	waitResp, err := wait.PartialUpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandler(wait.PartialUpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId), r.providerData.Wait, core.WaitUpdate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
 		return
 	}
 
 	// Map response body to schema
-	err = mapFields(waitResp, &model)
+	err = mapFields(waitResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	loadMetrics(ctx, r.client, &model.Model)
 
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -466,7 +555,7 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from state
-	var model Model
+	var model resourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -480,6 +569,10 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
 
+	if utils.BlockDeletionIfProtected(ctx, req.State, "MariaDB instance", &resp.Diagnostics) {
+		return
+	}
+
 	// Delete existing instance
 	err := r.client.DeleteInstance(ctx, projectId, instanceId).Execute()
 	if err != nil {
@@ -489,7 +582,7 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
 		return
@@ -500,12 +593,13 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,instance_id
 func (r *instanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing instance",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[instance_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[instance_id]  Got: %q", importId),
 		)
 		return
 	}
@@ -642,6 +736,10 @@ func mapParameters(params map[string]interface{}) (types.Object, error) {
 		attributes[attribute] = value
 	}
 
+	// The API only returns the comma separated sgw_acl, the ACL is derived from it
+	sgwAcl, _ := attributes["sgw_acl"].(types.String)
+	attributes["acl"] = conversion.CommaSeparatedToStringList(sgwAcl)
+
 	output, diags := types.ObjectValue(parametersTypes, attributes)
 	if diags.HasError() {
 		return types.ObjectNull(parametersTypes), fmt.Errorf("failed to create object: %w", core.DiagsToError(diags))
@@ -664,7 +762,8 @@ func toCreatePayload(model *Model, parameters *parametersModel) (*mariadb.Create
 	}, nil
 }
 
-func toUpdatePayload(model *Model, parameters *parametersModel) (*mariadb.PartialUpdateInstancePayload, error) {
+// toUpdatePayload only contains the name if it differs from the name in the state.
+func toUpdatePayload(model *Model, parameters *parametersModel, stateName types.String) (*mariadb.PartialUpdateInstancePayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
 	}
@@ -672,10 +771,15 @@ func toUpdatePayload(model *Model, parameters *parametersModel) (*mariadb.Partia
 	if err != nil {
 		return nil, fmt.Errorf("convert parameters: %w", err)
 	}
-	return &mariadb.PartialUpdateInstancePayload{
+	payload := &mariadb.PartialUpdateInstancePayload{
 		PlanId:     conversion.StringValueToPointer(model.PlanId),
 		Parameters: payloadParams,
-	}, nil
+	}
+	// Only send the name if it changed, so updates of other fields don't fail if the instance can't be renamed
+	if !model.Name.Equal(stateName) {
+		payload.InstanceName = conversion.StringValueToPointer(model.Name)
+	}
+	return payload, nil
 }
 
 func toInstanceParams(parameters *parametersModel) (*mariadb.InstanceParameters, error) {
@@ -685,6 +789,13 @@ func toInstanceParams(parameters *parametersModel) (*mariadb.InstanceParameters,
 	payloadParams := &mariadb.InstanceParameters{}
 
 	payloadParams.SgwAcl = conversion.StringValueToPointer(parameters.SgwAcl)
+	acl, err := conversion.StringListToCommaSeparatedPointer(parameters.ACL)
+	if err != nil {
+		return nil, fmt.Errorf("convert acl: %w", err)
+	}
+	if acl != nil {
+		payloadParams.SgwAcl = acl
+	}
 	payloadParams.EnableMonitoring = conversion.BoolValueToPointer(parameters.EnableMonitoring)
 	payloadParams.Graphite = conversion.StringValueToPointer(parameters.Graphite)
 	payloadParams.MaxDiskThreshold = conversion.Int64ValueToPointer(parameters.MaxDiskThreshold)
@@ -758,3 +869,39 @@ func loadPlanNameAndVersion(ctx context.Context, client *mariadb.APIClient, mode
 
 	return fmt.Errorf("couldn't find plan_name and version for plan_id '%s'", planId)
 }
+
+// loadMetrics sets the storage metrics of the instance.
+// The metrics are informational only, so failing to load them doesn't fail the operation, but leaves them unset.
+func loadMetrics(ctx context.Context, client *mariadb.APIClient, model *Model) {
+	metricsResp, err := client.GetMetrics(ctx, model.InstanceId.ValueString(), model.ProjectId.ValueString()).Execute()
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Loading MariaDB instance metrics: %v", err))
+		model.Metrics = types.ObjectNull(metricsTypes)
+		return
+	}
+
+	metrics, err := mapMetrics(metricsResp)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Processing MariaDB instance metrics: %v", err))
+		model.Metrics = types.ObjectNull(metricsTypes)
+		return
+	}
+	model.Metrics = metrics
+}
+
+func mapMetrics(metricsResp *mariadb.GetMetricsResponse) (types.Object, error) {
+	if metricsResp == nil {
+		return types.ObjectNull(metricsTypes), nil
+	}
+
+	metrics, diags := types.ObjectValue(metricsTypes, map[string]attr.Value{
+		"disk_ephemeral_total":  types.Int64PointerValue(metricsResp.DiskEphemeralTotal),
+		"disk_ephemeral_used":   types.Int64PointerValue(metricsResp.DiskEphemeralUsed),
+		"disk_persistent_total": types.Int64PointerValue(metricsResp.DiskPersistentTotal),
+		"disk_persistent_used":  types.Int64PointerValue(metricsResp.DiskPersistentUsed),
+	})
+	if diags.HasError() {
+		return types.ObjectNull(metricsTypes), core.DiagsToError(diags)
+	}
+	return metrics, nil
+}
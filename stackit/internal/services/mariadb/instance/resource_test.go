@@ -13,7 +13,10 @@ import (
 )
 
 var fixtureModelParameters = types.ObjectValueMust(parametersTypes, map[string]attr.Value{
-	"sgw_acl":                types.StringValue("acl"),
+	"sgw_acl": types.StringValue("acl"),
+	"acl": types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("acl"),
+	}),
 	"enable_monitoring":      types.BoolValue(true),
 	"graphite":               types.StringValue("graphite"),
 	"max_disk_threshold":     types.Int64Value(10),
@@ -28,6 +31,7 @@ var fixtureModelParameters = types.ObjectValueMust(parametersTypes, map[string]a
 
 var fixtureNullModelParameters = types.ObjectValueMust(parametersTypes, map[string]attr.Value{
 	"sgw_acl":                types.StringNull(),
+	"acl":                    types.ListNull(types.StringType),
 	"enable_monitoring":      types.BoolNull(),
 	"graphite":               types.StringNull(),
 	"max_disk_threshold":     types.Int64Null(),
@@ -48,6 +52,22 @@ var fixtureInstanceParameters = mariadb.InstanceParameters{
 	Syslog:               &[]string{"syslog", "syslog2"},
 }
 
+func fixtureACLModelParameters() types.Object {
+	attributes := fixtureModelParameters.Attributes()
+	attributes["acl"] = types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("10.0.0.0/8"),
+		types.StringValue("192.168.0.0/24"),
+	})
+	attributes["sgw_acl"] = types.StringUnknown()
+	return types.ObjectValueMust(parametersTypes, attributes)
+}
+
+func fixtureACLInstanceParameters() *mariadb.InstanceParameters {
+	parameters := fixtureInstanceParameters
+	parameters.SgwAcl = utils.Ptr("10.0.0.0/8,192.168.0.0/24")
+	return &parameters
+}
+
 func TestMapFields(t *testing.T) {
 	tests := []struct {
 		description string
@@ -166,6 +186,58 @@ func TestMapFields(t *testing.T) {
 	}
 }
 
+func TestMapMetrics(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *mariadb.GetMetricsResponse
+		expected    types.Object
+	}{
+		{
+			"default_values",
+			&mariadb.GetMetricsResponse{},
+			types.ObjectValueMust(metricsTypes, map[string]attr.Value{
+				"disk_ephemeral_total":  types.Int64Null(),
+				"disk_ephemeral_used":   types.Int64Null(),
+				"disk_persistent_total": types.Int64Null(),
+				"disk_persistent_used":  types.Int64Null(),
+			}),
+		},
+		{
+			"simple_values",
+			&mariadb.GetMetricsResponse{
+				DiskEphemeralTotal:  utils.Ptr(int64(100)),
+				DiskEphemeralUsed:   utils.Ptr(int64(10)),
+				DiskPersistentTotal: utils.Ptr(int64(200)),
+				DiskPersistentUsed:  utils.Ptr(int64(20)),
+				CpuLoadPercent:      utils.Ptr(float64(50)),
+			},
+			types.ObjectValueMust(metricsTypes, map[string]attr.Value{
+				"disk_ephemeral_total":  types.Int64Value(100),
+				"disk_ephemeral_used":   types.Int64Value(10),
+				"disk_persistent_total": types.Int64Value(200),
+				"disk_persistent_used":  types.Int64Value(20),
+			}),
+		},
+		{
+			"nil_response",
+			nil,
+			types.ObjectNull(metricsTypes),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := mapMetrics(tt.input)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
 func TestToCreatePayload(t *testing.T) {
 	tests := []struct {
 		description string
@@ -193,6 +265,16 @@ func TestToCreatePayload(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"acl",
+			&Model{
+				Parameters: fixtureACLModelParameters(),
+			},
+			&mariadb.CreateInstancePayload{
+				Parameters: fixtureACLInstanceParameters(),
+			},
+			true,
+		},
 		{
 			"null_fields_and_int_conversions",
 			&Model{
@@ -321,7 +403,11 @@ func TestToUpdatePayload(t *testing.T) {
 					}
 				}
 			}
-			output, err := toUpdatePayload(tt.input, parameters)
+			stateName := types.StringNull()
+			if tt.input != nil {
+				stateName = tt.input.Name
+			}
+			output, err := toUpdatePayload(tt.input, parameters, stateName)
 			if !tt.isValid && err == nil {
 				t.Fatalf("Should have failed")
 			}
@@ -337,3 +423,48 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestToUpdatePayloadName(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		stateName   types.String
+		expected    *mariadb.PartialUpdateInstancePayload
+	}{
+		{
+			"name_changed",
+			&Model{
+				Name:   types.StringValue("new-name"),
+				PlanId: types.StringValue("plan"),
+			},
+			types.StringValue("name"),
+			&mariadb.PartialUpdateInstancePayload{
+				InstanceName: utils.Ptr("new-name"),
+				PlanId:       utils.Ptr("plan"),
+			},
+		},
+		{
+			"name_unchanged",
+			&Model{
+				Name:   types.StringValue("name"),
+				PlanId: types.StringValue("plan"),
+			},
+			types.StringValue("name"),
+			&mariadb.PartialUpdateInstancePayload{
+				PlanId: utils.Ptr("plan"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toUpdatePayload(tt.input, nil, tt.stateName)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
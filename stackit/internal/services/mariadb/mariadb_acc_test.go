@@ -27,19 +27,19 @@ var resourceMaxConfig string
 
 var testConfigVarsMin = config.Variables{
 	"project_id": config.StringVariable(testutil.ProjectId),
-	"name":       config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
+	"name":       config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
 	"plan_name":  config.StringVariable("stackit-mariadb-1.4.10-single"),
 	"db_version": config.StringVariable("10.6"),
 }
 
 var testConfigVarsMax = config.Variables{
 	"project_id":                       config.StringVariable(testutil.ProjectId),
-	"name":                             config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
+	"name":                             config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
 	"plan_name":                        config.StringVariable("stackit-mariadb-1.4.10-single"),
 	"db_version":                       config.StringVariable("10.11"),
 	"observability_instance_plan_name": config.StringVariable("Observability-Monitoring-Basic-EU01"),
 	"parameters_enable_monitoring":     config.BoolVariable(true),
-	"parameters_graphite":              config.StringVariable(fmt.Sprintf("%s.graphite.stackit.cloud:2003", acctest.RandStringFromCharSet(7, acctest.CharSetAlpha))),
+	"parameters_graphite":              config.StringVariable(fmt.Sprintf("%s.graphite.stackit.cloud:2003", testutil.RandStringFromCharSet(7, acctest.CharSetAlpha))),
 	"parameters_max_disk_threshold":    config.IntegerVariable(75),
 	"parameters_metrics_frequency":     config.IntegerVariable(15),
 	"parameters_metrics_prefix":        config.StringVariable("acc-test"),
@@ -0,0 +1,281 @@
+package mariadb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	mariadbUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mariadb/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/stackitcloud/stackit-sdk-go/services/mariadb"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &plansDataSource{}
+)
+
+// planNameRegex matches plan names like "stackit-mariadb-1.4.10-single", which encode
+// the number of CPUs, the RAM in GB, the disk size in GB and the replication mode.
+var planNameRegex = regexp.MustCompile(`-(\d+)\.(\d+)\.(\d+)-(single|replica)$`)
+
+// Number of nodes of a plan, depending on its replication mode.
+var replicasByMode = map[string]int64{
+	"single":  1,
+	"replica": 3,
+}
+
+type Model struct {
+	Id        types.String `tfsdk:"id"` // needed by TF
+	ProjectId types.String `tfsdk:"project_id"`
+	Plans     types.List   `tfsdk:"plans"`
+}
+
+var planTypes = map[string]attr.Type{
+	"plan_id":     types.StringType,
+	"name":        types.StringType,
+	"description": types.StringType,
+	"version":     types.StringType,
+	"cpu":         types.Int64Type,
+	"ram":         types.Int64Type,
+	"disk_size":   types.Int64Type,
+	"replicas":    types.Int64Type,
+	"free":        types.BoolType,
+}
+
+// NewPlansDataSource is a helper function to simplify the provider implementation.
+func NewPlansDataSource() datasource.DataSource {
+	return &plansDataSource{}
+}
+
+// plansDataSource is the data source implementation.
+type plansDataSource struct {
+	client *mariadb.APIClient
+}
+
+// Metadata returns the data source type name.
+func (r *plansDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mariadb_plans"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *plansDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := mariadbUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "MariaDB plans client configured")
+}
+
+// Schema defines the schema for the data source.
+func (r *plansDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":        "MariaDB plans data source schema. Lists all plans of all MariaDB versions, so a plan can be selected by its requirements. Must have a `region` specified in the provider configuration.",
+		"id":          "Terraform's internal data source. identifier. It is structured as \"`project_id`\".",
+		"project_id":  "STACKIT Project ID for which the plans are listed.",
+		"plans":       "List of all available plans.",
+		"plan_id":     "The plan ID.",
+		"name":        "The plan name. Can be used as `plan_name` of a `stackit_mariadb_instance`.",
+		"description": "The plan description.",
+		"version":     "The service version the plan belongs to. Can be used as `version` of a `stackit_mariadb_instance`.",
+		"cpu":         "Number of CPUs. Derived from the plan name, not set if the plan name doesn't follow the usual naming scheme.",
+		"ram":         "RAM size in GB. Derived from the plan name, not set if the plan name doesn't follow the usual naming scheme.",
+		"disk_size":   "Disk size in GB. Derived from the plan name, not set if the plan name doesn't follow the usual naming scheme.",
+		"replicas":    "Number of nodes (`1` for single plans, `3` for replica plans). Derived from the plan name, not set if the plan name doesn't follow the usual naming scheme.",
+		"free":        "Whether the plan is free of charge.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"plans": schema.ListNestedAttribute{
+				Description: descriptions["plans"],
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"plan_id": schema.StringAttribute{
+							Description: descriptions["plan_id"],
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: descriptions["name"],
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: descriptions["description"],
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: descriptions["version"],
+							Computed:    true,
+						},
+						"cpu": schema.Int64Attribute{
+							Description: descriptions["cpu"],
+							Computed:    true,
+						},
+						"ram": schema.Int64Attribute{
+							Description: descriptions["ram"],
+							Computed:    true,
+						},
+						"disk_size": schema.Int64Attribute{
+							Description: descriptions["disk_size"],
+							Computed:    true,
+						},
+						"replicas": schema.Int64Attribute{
+							Description: descriptions["replicas"],
+							Computed:    true,
+						},
+						"free": schema.BoolAttribute{
+							Description: descriptions["free"],
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *plansDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	offeringsResp, err := r.client.ListOfferings(ctx, projectId).Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading plans",
+			fmt.Sprintf("Unable to list MariaDB offerings for project %q.", projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapFields(offeringsResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading plans", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "MariaDB plans read")
+}
+
+func mapFields(offeringsResp *mariadb.ListOfferingsResponse, model *Model) error {
+	if offeringsResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString())
+
+	plans := []attr.Value{}
+	if offeringsResp.Offerings != nil {
+		for _, offering := range *offeringsResp.Offerings {
+			if offering.Plans == nil {
+				continue
+			}
+			for _, plan := range *offering.Plans {
+				if plan.Id == nil {
+					return fmt.Errorf("plan of version %q has no ID", offering.GetVersion())
+				}
+
+				cpu, ram, diskSize, replicas := parsePlanName(plan.GetName())
+				planObject, diags := types.ObjectValue(planTypes, map[string]attr.Value{
+					"plan_id":     types.StringPointerValue(plan.Id),
+					"name":        types.StringPointerValue(plan.Name),
+					"description": types.StringPointerValue(plan.Description),
+					"version":     types.StringPointerValue(offering.Version),
+					"cpu":         cpu,
+					"ram":         ram,
+					"disk_size":   diskSize,
+					"replicas":    replicas,
+					"free":        types.BoolPointerValue(plan.Free),
+				})
+				if diags.HasError() {
+					return core.DiagsToError(diags)
+				}
+				plans = append(plans, planObject)
+			}
+		}
+	}
+
+	plansTF, diags := types.ListValue(types.ObjectType{AttrTypes: planTypes}, plans)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.Plans = plansTF
+	return nil
+}
+
+// parsePlanName extracts the number of CPUs, the RAM, the disk size and the number of replicas from a plan name.
+// All values are null if the plan name doesn't follow the naming scheme.
+func parsePlanName(name string) (cpu, ram, diskSize, replicas types.Int64) {
+	matches := planNameRegex.FindStringSubmatch(name)
+	if matches == nil {
+		return types.Int64Null(), types.Int64Null(), types.Int64Null(), types.Int64Null()
+	}
+
+	values := make([]int64, 3)
+	for i := range values {
+		value, err := strconv.ParseInt(matches[i+1], 10, 64)
+		if err != nil {
+			return types.Int64Null(), types.Int64Null(), types.Int64Null(), types.Int64Null()
+		}
+		values[i] = value
+	}
+	return types.Int64Value(values[0]), types.Int64Value(values[1]), types.Int64Value(values[2]), types.Int64Value(replicasByMode[matches[4]])
+}
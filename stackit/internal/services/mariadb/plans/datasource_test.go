@@ -0,0 +1,221 @@
+package mariadb
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/mariadb"
+)
+
+func TestMapFields(t *testing.T) {
+	planObjectType := types.ObjectType{AttrTypes: planTypes}
+
+	tests := []struct {
+		description string
+		input       *mariadb.ListOfferingsResponse
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_ok",
+			&mariadb.ListOfferingsResponse{
+				Offerings: &[]mariadb.Offering{
+					{
+						Version: utils.Ptr("10.11"),
+						Plans: &[]mariadb.Plan{
+							{
+								Id:          utils.Ptr("pid-1"),
+								Name:        utils.Ptr("stackit-mariadb-1.4.10-single"),
+								Description: utils.Ptr("single node"),
+								Free:        utils.Ptr(false),
+							},
+							{
+								Id:          utils.Ptr("pid-2"),
+								Name:        utils.Ptr("stackit-mariadb-2.8.20-replica"),
+								Description: utils.Ptr("replicated"),
+								Free:        utils.Ptr(false),
+							},
+						},
+					},
+					{
+						Version: utils.Ptr("11.4"),
+						Plans: &[]mariadb.Plan{
+							{
+								Id:   utils.Ptr("pid-3"),
+								Name: utils.Ptr("custom-plan"),
+								Free: utils.Ptr(true),
+							},
+						},
+					},
+				},
+			},
+			Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Plans: types.ListValueMust(planObjectType, []attr.Value{
+					types.ObjectValueMust(planTypes, map[string]attr.Value{
+						"plan_id":     types.StringValue("pid-1"),
+						"name":        types.StringValue("stackit-mariadb-1.4.10-single"),
+						"description": types.StringValue("single node"),
+						"version":     types.StringValue("10.11"),
+						"cpu":         types.Int64Value(1),
+						"ram":         types.Int64Value(4),
+						"disk_size":   types.Int64Value(10),
+						"replicas":    types.Int64Value(1),
+						"free":        types.BoolValue(false),
+					}),
+					types.ObjectValueMust(planTypes, map[string]attr.Value{
+						"plan_id":     types.StringValue("pid-2"),
+						"name":        types.StringValue("stackit-mariadb-2.8.20-replica"),
+						"description": types.StringValue("replicated"),
+						"version":     types.StringValue("10.11"),
+						"cpu":         types.Int64Value(2),
+						"ram":         types.Int64Value(8),
+						"disk_size":   types.Int64Value(20),
+						"replicas":    types.Int64Value(3),
+						"free":        types.BoolValue(false),
+					}),
+					types.ObjectValueMust(planTypes, map[string]attr.Value{
+						"plan_id":     types.StringValue("pid-3"),
+						"name":        types.StringValue("custom-plan"),
+						"description": types.StringNull(),
+						"version":     types.StringValue("11.4"),
+						"cpu":         types.Int64Null(),
+						"ram":         types.Int64Null(),
+						"disk_size":   types.Int64Null(),
+						"replicas":    types.Int64Null(),
+						"free":        types.BoolValue(true),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"no_offerings",
+			&mariadb.ListOfferingsResponse{
+				Offerings: &[]mariadb.Offering{},
+			},
+			Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Plans:     types.ListValueMust(planObjectType, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"missing_plan_id",
+			&mariadb.ListOfferingsResponse{
+				Offerings: &[]mariadb.Offering{
+					{
+						Version: utils.Ptr("10.11"),
+						Plans: &[]mariadb.Plan{
+							{
+								Name: utils.Ptr("stackit-mariadb-1.4.10-single"),
+							},
+						},
+					},
+				},
+			},
+			Model{},
+			false,
+		},
+		{
+			"nil_response",
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := Model{
+				ProjectId: types.StringValue("pid"),
+			}
+			err := mapFields(tt.input, &model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestParsePlanName(t *testing.T) {
+	tests := []struct {
+		description      string
+		name             string
+		expectedCpu      types.Int64
+		expectedRam      types.Int64
+		expectedDisk     types.Int64
+		expectedReplicas types.Int64
+	}{
+		{
+			"single",
+			"stackit-mariadb-1.4.10-single",
+			types.Int64Value(1),
+			types.Int64Value(4),
+			types.Int64Value(10),
+			types.Int64Value(1),
+		},
+		{
+			"replica",
+			"stackit-mariadb-4.16.80-replica",
+			types.Int64Value(4),
+			types.Int64Value(16),
+			types.Int64Value(80),
+			types.Int64Value(3),
+		},
+		{
+			"unknown_scheme",
+			"stackit-mariadb-large",
+			types.Int64Null(),
+			types.Int64Null(),
+			types.Int64Null(),
+			types.Int64Null(),
+		},
+		{
+			"unknown_mode",
+			"stackit-mariadb-1.4.10-cluster",
+			types.Int64Null(),
+			types.Int64Null(),
+			types.Int64Null(),
+			types.Int64Null(),
+		},
+		{
+			"empty",
+			"",
+			types.Int64Null(),
+			types.Int64Null(),
+			types.Int64Null(),
+			types.Int64Null(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			cpu, ram, disk, replicas := parsePlanName(tt.name)
+			if !cpu.Equal(tt.expectedCpu) {
+				t.Fatalf("Expected cpu %v, got %v", tt.expectedCpu, cpu)
+			}
+			if !ram.Equal(tt.expectedRam) {
+				t.Fatalf("Expected ram %v, got %v", tt.expectedRam, ram)
+			}
+			if !disk.Equal(tt.expectedDisk) {
+				t.Fatalf("Expected disk size %v, got %v", tt.expectedDisk, disk)
+			}
+			if !replicas.Equal(tt.expectedReplicas) {
+				t.Fatalf("Expected replicas %v, got %v", tt.expectedReplicas, replicas)
+			}
+		})
+	}
+}
@@ -21,7 +21,9 @@ func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags
 	} else {
 		apiClientConfigOptions = append(apiClientConfigOptions, config.WithRegion(providerData.GetRegion()))
 	}
-	apiClient, err := mariadb.NewAPIClient(apiClientConfigOptions...)
+	apiClient, err := core.CachedClient(providerData.Clients, "mariadb", providerData.GetRegion(), providerData.MariaDBCustomEndpoint, func() (*mariadb.APIClient, error) {
+		return mariadb.NewAPIClient(apiClientConfigOptions...)
+	})
 	if err != nil {
 		core.LogAndAddError(ctx, diags, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the resource configuration", err))
 		return nil
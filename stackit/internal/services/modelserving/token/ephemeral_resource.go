@@ -0,0 +1,295 @@
+package token
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/modelserving"
+	"github.com/stackitcloud/stackit-sdk-go/services/modelserving/wait"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	modelservingUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/modelserving/utils"
+	serviceenablementUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serviceenablement/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &tokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &tokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &tokenEphemeralResource{}
+)
+
+// privateDataKey is the key of the private data, which identifies the token to delete when the ephemeral resource is closed.
+const privateDataKey = "token"
+
+type EphemeralModel struct {
+	ProjectId   types.String `tfsdk:"project_id"`
+	Region      types.String `tfsdk:"region"`
+	TokenId     types.String `tfsdk:"token_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	ValidUntil  types.String `tfsdk:"valid_until"`
+	TTLDuration types.String `tfsdk:"ttl_duration"`
+	Content     types.String `tfsdk:"content"`
+}
+
+// ephemeralPrivateData identifies the token created by the ephemeral resource.
+type ephemeralPrivateData struct {
+	ProjectId string `json:"project_id"`
+	Region    string `json:"region"`
+	TokenId   string `json:"token_id"`
+}
+
+// NewTokenEphemeralResource is a helper function to simplify the provider implementation.
+func NewTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &tokenEphemeralResource{}
+}
+
+// tokenEphemeralResource is the ephemeral resource implementation.
+type tokenEphemeralResource struct {
+	client       *modelserving.APIClient
+	providerData core.ProviderData
+	modelServing *core.RequiredService
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *tokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_modelserving_token"
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *tokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	ephemeralProviderData, ok := conversion.ParseEphemeralProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	e.providerData = ephemeralProviderData.ProviderData
+
+	apiClient := modelservingUtils.ConfigureClient(ctx, &e.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	modelServing := serviceenablementUtils.ConfigureRequiredService(ctx, &e.providerData, utils.ModelServingServiceId, "AI model serving", true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	e.client = apiClient
+	e.modelServing = modelServing
+	tflog.Info(ctx, "Model-Serving auth token client configured")
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *tokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Ephemeral resource that creates a short-lived AI model serving auth token. " +
+			"A new token is created each time the resource is evaluated and deleted again at the end of the Terraform operation, " +
+			"so the token content is never persisted in the Terraform state. " +
+			"Use the `stackit_modelserving_token` resource instead, if the token must outlive the Terraform operation.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the AI model serving auth token is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "Region to which the AI model serving auth token is associated. If not defined, the provider region is used",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the AI model serving auth token.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the AI model serving auth token.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2000),
+				},
+			},
+			"ttl_duration": schema.StringAttribute{
+				Description: "The TTL duration of the AI model serving auth token. E.g. 30d,24h,5h30m40s,5h,5h30m,30m,30s. " +
+					"The token is deleted at the end of the Terraform operation, even if the TTL hasn't expired yet.",
+				Optional: true,
+				Validators: []validator.String{
+					validate.TTLDurationString(),
+				},
+			},
+			"token_id": schema.StringAttribute{
+				Description: "The AI model serving auth token ID.",
+				Computed:    true,
+			},
+			"content": schema.StringAttribute{
+				Description: "Content of the AI model serving auth token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"valid_until": schema.StringAttribute{
+				Description: "The time until the AI model serving auth token is valid.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Open creates the token and returns its content.
+func (e *tokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model EphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := e.providerData.GetRegionWithOverride(model.Region)
+
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	// If AI model serving is not enabled, it's enabled and the token creation is retried
+	createTokenResp, err := core.CallWithRequiredService(ctx, e.modelServing, projectId, region, func() (*modelserving.CreateTokenResponse, error) {
+		return e.client.CreateToken(ctx, region, projectId).
+			CreateTokenPayload(toEphemeralCreatePayload(&model)).
+			Execute()
+	})
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating AI model serving auth token", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	if createTokenResp == nil || createTokenResp.Token == nil || createTokenResp.Token.Id == nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating AI model serving auth token", "API response is empty")
+		return
+	}
+	tokenId := *createTokenResp.Token.Id
+	ctx = tflog.SetField(ctx, "token_id", tokenId)
+
+	// Store the token ID before waiting, so the token is deleted even if waiting fails
+	privateData, err := json.Marshal(ephemeralPrivateData{
+		ProjectId: projectId,
+		Region:    region,
+		TokenId:   tokenId,
+	})
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating AI model serving auth token", fmt.Sprintf("Encoding private data: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateDataKey, privateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err = core.ConfigureWaitHandler(wait.CreateModelServingWaitHandler(ctx, e.client, region, projectId, tokenId), e.providerData.Wait, core.WaitCreate).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating AI model serving auth token", fmt.Sprintf("Waiting for token to be active: %v", err))
+		return
+	}
+
+	err = mapEphemeralCreateResponse(createTokenResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating AI model serving auth token", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Model-Serving ephemeral auth token created")
+}
+
+// Close deletes the token created by Open.
+func (e *tokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateData, diags := req.Private.GetKey(ctx, privateDataKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateData == nil {
+		return
+	}
+
+	var token ephemeralPrivateData
+	err := json.Unmarshal(privateData, &token)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting AI model serving auth token", fmt.Sprintf("Decoding private data: %v", err))
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	ctx = tflog.SetField(ctx, "project_id", token.ProjectId)
+	ctx = tflog.SetField(ctx, "region", token.Region)
+	ctx = tflog.SetField(ctx, "token_id", token.TokenId)
+
+	_, err = e.client.DeleteToken(ctx, token.Region, token.ProjectId, token.TokenId).Execute()
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting AI model serving auth token", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	tflog.Info(ctx, "Model-Serving ephemeral auth token deleted")
+}
+
+func mapEphemeralCreateResponse(tokenCreateResp *modelserving.CreateTokenResponse, model *EphemeralModel, region string) error {
+	if tokenCreateResp == nil || tokenCreateResp.Token == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	token := tokenCreateResp.Token
+
+	if token.Id == nil {
+		return fmt.Errorf("token id not present")
+	}
+
+	validUntil := types.StringNull()
+	if token.ValidUntil != nil {
+		validUntil = types.StringValue(token.ValidUntil.Format(time.RFC3339))
+	}
+
+	model.Region = types.StringValue(region)
+	model.TokenId = types.StringPointerValue(token.Id)
+	model.Name = types.StringPointerValue(token.Name)
+	model.ValidUntil = validUntil
+	model.Content = types.StringPointerValue(token.Content)
+	model.Description = conversion.NormalizedStringPointerValue(model.Description, token.Description)
+
+	return nil
+}
+
+func toEphemeralCreatePayload(model *EphemeralModel) modelserving.CreateTokenPayload {
+	return modelserving.CreateTokenPayload{
+		Name:        conversion.StringValueToPointer(model.Name),
+		Description: conversion.StringValueToPointer(model.Description),
+		TtlDuration: conversion.StringValueToPointer(model.TTLDuration),
+	}
+}
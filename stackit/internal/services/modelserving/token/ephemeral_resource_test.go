@@ -0,0 +1,152 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/modelserving"
+)
+
+func TestMapEphemeralCreateTokenFields(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		state       *EphemeralModel
+		input       *modelserving.CreateTokenResponse
+		expected    EphemeralModel
+		isValid     bool
+	}{
+		{
+			description: "should error when response is nil",
+			state:       &EphemeralModel{},
+			input:       nil,
+			isValid:     false,
+		},
+		{
+			description: "should error when token is nil in response",
+			state:       &EphemeralModel{},
+			input:       &modelserving.CreateTokenResponse{Token: nil},
+			isValid:     false,
+		},
+		{
+			description: "should error when token id is nil in response",
+			state:       &EphemeralModel{},
+			input: &modelserving.CreateTokenResponse{
+				Token: &modelserving.TokenCreated{},
+			},
+			isValid: false,
+		},
+		{
+			description: "should error when state is nil",
+			state:       nil,
+			input: &modelserving.CreateTokenResponse{
+				Token: &modelserving.TokenCreated{
+					Id: utils.Ptr("tid"),
+				},
+			},
+			isValid: false,
+		},
+		{
+			description: "should map fields correctly",
+			state: &EphemeralModel{
+				ProjectId:   types.StringValue("pid"),
+				TTLDuration: types.StringValue("1h"),
+			},
+			input: &modelserving.CreateTokenResponse{
+				Token: &modelserving.TokenCreated{
+					Id: utils.Ptr("tid"),
+					ValidUntil: utils.Ptr(
+						time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC),
+					),
+					Name:        utils.Ptr("name"),
+					Description: utils.Ptr("desc"),
+					Content:     utils.Ptr("content"),
+				},
+			},
+			expected: EphemeralModel{
+				ProjectId:   types.StringValue("pid"),
+				Region:      types.StringValue("eu01"),
+				TokenId:     types.StringValue("tid"),
+				Name:        types.StringValue("name"),
+				Description: types.StringValue("desc"),
+				ValidUntil:  types.StringValue("2099-01-01T00:00:00Z"),
+				TTLDuration: types.StringValue("1h"),
+				Content:     types.StringValue("content"),
+			},
+			isValid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			err := mapEphemeralCreateResponse(tt.input, tt.state, "eu01")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToEphemeralCreatePayload(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		input       *EphemeralModel
+		expected    modelserving.CreateTokenPayload
+	}{
+		{
+			description: "should map all fields",
+			input: &EphemeralModel{
+				Name:        types.StringValue("name"),
+				Description: types.StringValue("desc"),
+				TTLDuration: types.StringValue("1h"),
+			},
+			expected: modelserving.CreateTokenPayload{
+				Name:        utils.Ptr("name"),
+				Description: utils.Ptr("desc"),
+				TtlDuration: utils.Ptr("1h"),
+			},
+		},
+		{
+			description: "should omit unset fields",
+			input: &EphemeralModel{
+				Name:        types.StringValue("name"),
+				Description: types.StringNull(),
+				TTLDuration: types.StringNull(),
+			},
+			expected: modelserving.CreateTokenPayload{
+				Name: utils.Ptr("name"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			output := toEphemeralCreatePayload(tt.input)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
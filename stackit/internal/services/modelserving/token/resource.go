@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	modelservingUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/modelserving/utils"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -23,8 +25,6 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 	"github.com/stackitcloud/stackit-sdk-go/services/modelserving"
 	"github.com/stackitcloud/stackit-sdk-go/services/modelserving/wait"
-	"github.com/stackitcloud/stackit-sdk-go/services/serviceenablement"
-	serviceEnablementWait "github.com/stackitcloud/stackit-sdk-go/services/serviceenablement/wait"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
@@ -33,11 +33,15 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource               = &tokenResource{}
-	_ resource.ResourceWithConfigure  = &tokenResource{}
-	_ resource.ResourceWithModifyPlan = &tokenResource{}
+	_ resource.Resource                = &tokenResource{}
+	_ resource.ResourceWithConfigure   = &tokenResource{}
+	_ resource.ResourceWithModifyPlan  = &tokenResource{}
+	_ resource.ResourceWithImportState = &tokenResource{}
+	_ resource.ResourceWithIdentity    = &tokenResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "token_id"}
+
 const (
 	inactiveState = "inactive"
 )
@@ -55,7 +59,11 @@ type Model struct {
 	State       types.String `tfsdk:"state"`
 	ValidUntil  types.String `tfsdk:"valid_until"`
 	TTLDuration types.String `tfsdk:"ttl_duration"`
-	Token       types.String `tfsdk:"token"`
+	// ExpirationWarningThreshold is the remaining validity below which a warning is shown during Read
+	ExpirationWarningThreshold types.String `tfsdk:"expiration_warning_threshold"`
+	Token                      types.String `tfsdk:"token"`
+	// ContentAvailable is false for imported tokens, whose content can't be recovered
+	ContentAvailable types.Bool `tfsdk:"content_available"`
 	// RotateWhenChanged is a map of arbitrary key/value pairs that will force
 	// recreation of the token when they change, enabling token rotation based on
 	// external conditions such as a rotating timestamp. Changing this forces a new
@@ -70,9 +78,9 @@ func NewTokenResource() resource.Resource {
 
 // tokenResource is the resource implementation.
 type tokenResource struct {
-	client                  *modelserving.APIClient
-	providerData            core.ProviderData
-	serviceEnablementClient *serviceenablement.APIClient
+	client       *modelserving.APIClient
+	providerData core.ProviderData
+	modelServing *core.RequiredService
 }
 
 // Metadata returns the resource type name.
@@ -80,6 +88,11 @@ func (r *tokenResource) Metadata(_ context.Context, req resource.MetadataRequest
 	resp.TypeName = req.ProviderTypeName + "_modelserving_token"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *tokenResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *tokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	var ok bool
@@ -92,12 +105,12 @@ func (r *tokenResource) Configure(ctx context.Context, req resource.ConfigureReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	serviceEnablementClient := serviceenablementUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	modelServing := serviceenablementUtils.ConfigureRequiredService(ctx, &r.providerData, utils.ModelServingServiceId, "AI model serving", true, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	r.client = apiClient
-	r.serviceEnablementClient = serviceEnablementClient
+	r.modelServing = modelServing
 	tflog.Info(ctx, "Model-Serving auth token client configured")
 }
 
@@ -125,13 +138,29 @@ func (r *tokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 		ctx,
 		configModel.Region,
 		&planModel.Region,
-		r.providerData.GetRegion(),
+		r.providerData.GetConfiguredRegion(),
 		resp,
 	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// A token can't be moved to another region, AdaptRegion replaces it by a new token in the new region.
+	// Without create_before_destroy, the old token is deleted first and clients using it fail until they are switched.
+	if !req.State.Raw.IsNull() {
+		var stateModel Model
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if regionChanged(stateModel.Region, planModel.Region) {
+			core.LogAndAddWarning(ctx, &resp.Diagnostics, "AI model serving auth token is moved to another region",
+				fmt.Sprintf("The token in region %q is replaced by a new token in region %q. "+
+					"Set \"create_before_destroy = true\" in the lifecycle block of the resource, if not already set, so the new token is created before the old one is deleted.",
+					stateModel.Region.ValueString(), planModel.Region.ValueString()))
+		}
+	}
+
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -150,6 +179,9 @@ func (r *tokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the AI model serving auth token is associated.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.String{
 					validate.UUID(),
 					validate.NoSeparator(),
@@ -159,7 +191,7 @@ func (r *tokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional: true,
 				// must be computed to allow for storing the override value from the provider
 				Computed:    true,
-				Description: "Region to which the AI model serving auth token is associated. If not defined, the provider region is used",
+				Description: "Region to which the AI model serving auth token is associated. If not defined, the provider region is used. Changing the region replaces the token by a new token in the new region.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -173,16 +205,17 @@ func (r *tokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"ttl_duration": schema.StringAttribute{
-				Description: "The TTL duration of the AI model serving auth token. E.g. 5h30m40s,5h,5h30m,30m,30s",
+				Description: "The TTL duration of the AI model serving auth token. E.g. 30d,24h,5h30m40s,5h,5h30m,30m,30s",
 				Required:    false,
 				Optional:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					validate.ValidDurationString(),
+					validate.TTLDurationString(),
 				},
 			},
+			"expiration_warning_threshold": utils.ExpirationWarningThresholdAttribute("AI model serving auth token", validate.TTLDurationString()),
 			"rotate_when_changed": schema.MapAttribute{
 				Description: "A map of arbitrary key/value pairs that will force " +
 					"recreation of the token when they change, enabling token rotation " +
@@ -219,6 +252,13 @@ func (r *tokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 				Sensitive:   true,
 			},
+			"content_available": schema.BoolAttribute{
+				Description: "Whether `token` contains the content of the AI model serving auth token. The content is only returned when the token is created, so it's `false` for imported tokens.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"valid_until": schema.StringAttribute{
 				Description: "The time until the AI model serving auth token is valid.",
 				Computed:    true,
@@ -229,6 +269,8 @@ func (r *tokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -245,40 +287,6 @@ func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest,
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "region", region)
 
-	// If AI model serving is not enabled, enable it
-	err := r.serviceEnablementClient.EnableServiceRegional(ctx, region, projectId, utils.ModelServingServiceId).
-		Execute()
-	if err != nil {
-		var oapiErr *oapierror.GenericOpenAPIError
-		if errors.As(err, &oapiErr) {
-			if oapiErr.StatusCode == http.StatusNotFound {
-				core.LogAndAddError(ctx, &resp.Diagnostics, "Error enabling AI model serving",
-					fmt.Sprintf("Service not available in region %s \n%v", region, err),
-				)
-				return
-			}
-		}
-		core.LogAndAddError(
-			ctx,
-			&resp.Diagnostics,
-			"Error enabling AI model serving",
-			fmt.Sprintf("Error enabling AI model serving: %v", err),
-		)
-		return
-	}
-
-	_, err = serviceEnablementWait.EnableServiceWaitHandler(ctx, r.serviceEnablementClient, region, projectId, utils.ModelServingServiceId).
-		WaitWithContext(ctx)
-	if err != nil {
-		core.LogAndAddError(
-			ctx,
-			&resp.Diagnostics,
-			"Error enabling AI model serving",
-			fmt.Sprintf("Error enabling AI model serving: %v", err),
-		)
-		return
-	}
-
 	// Generate API request body from model
 	payload, err := toCreatePayload(&model)
 	if err != nil {
@@ -287,9 +295,12 @@ func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Create new AI model serving auth token
-	createTokenResp, err := r.client.CreateToken(ctx, region, projectId).
-		CreateTokenPayload(*payload).
-		Execute()
+	// If AI model serving is not enabled, it's enabled and the token creation is retried
+	createTokenResp, err := core.CallWithRequiredService(ctx, r.modelServing, projectId, region, func() (*modelserving.CreateTokenResponse, error) {
+		return r.client.CreateToken(ctx, region, projectId).
+			CreateTokenPayload(*payload).
+			Execute()
+	})
 	if err != nil {
 		core.LogAndAddError(
 			ctx,
@@ -302,7 +313,7 @@ func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	ctx = core.LogResponse(ctx)
 
-	waitResp, err := wait.CreateModelServingWaitHandler(ctx, r.client, region, projectId, *createTokenResp.Token.Id).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandler(wait.CreateModelServingWaitHandler(ctx, r.client, region, projectId, *createTokenResp.Token.Id), r.providerData.Wait, core.WaitCreate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating AI model serving auth token", fmt.Sprintf("Waiting for token to be active: %v", err))
 		return
@@ -327,6 +338,8 @@ func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest,
 
 // Read refreshes the Terraform state with the latest data.
 func (r *tokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -351,7 +364,7 @@ func (r *tokenResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		if errors.As(err, &oapiErr) {
 			if oapiErr.StatusCode == http.StatusNotFound {
 				// Remove the resource from the state so Terraform will recreate it
-				resp.State.RemoveResource(ctx)
+				core.RemoveFromStateWithWarning(ctx, resp, "stackit_modelserving_token", model.Id.ValueString())
 				return
 			}
 		}
@@ -376,6 +389,8 @@ func (r *tokenResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	utils.WarnIfExpiring(ctx, &resp.Diagnostics, "AI model serving auth token", model.ExpirationWarningThreshold, model.ValidUntil)
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -388,6 +403,8 @@ func (r *tokenResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *tokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -458,7 +475,7 @@ func (r *tokenResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	waitResp, err := wait.UpdateModelServingWaitHandler(ctx, r.client, region, projectId, tokenId).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandler(wait.UpdateModelServingWaitHandler(ctx, r.client, region, projectId, tokenId), r.providerData.Wait, core.WaitUpdate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating AI model serving auth token", fmt.Sprintf("Waiting for token to be updated: %v", err))
 		return
@@ -519,7 +536,7 @@ func (r *tokenResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.DeleteModelServingWaitHandler(ctx, r.client, region, projectId, tokenId).
+	_, err = core.ConfigureWaitHandler(wait.DeleteModelServingWaitHandler(ctx, r.client, region, projectId, tokenId), r.providerData.Wait, core.WaitDelete).
 		WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting AI model serving auth token", fmt.Sprintf("Waiting for token to be deleted: %v", err))
@@ -529,6 +546,71 @@ func (r *tokenResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	tflog.Info(ctx, "Model-Serving auth token deleted")
 }
 
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,region,token_id
+func (r *tokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing AI model serving auth token",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[token_id]  Got: %q", importId),
+		)
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := idParts[0]
+	region := idParts[1]
+	tokenId := idParts[2]
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "token_id", tokenId)
+
+	// The metadata is fetched right away, so a token which doesn't exist or has expired fails the import
+	getTokenResp, err := r.client.GetToken(ctx, region, projectId, tokenId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing AI model serving auth token", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	if getTokenResp != nil && getTokenResp.Token != nil && getTokenResp.Token.State != nil &&
+		*getTokenResp.Token.State == inactiveState {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing AI model serving auth token", "AI model serving auth token has expired")
+		return
+	}
+
+	model, err := mapImportResponse(getTokenResp, projectId, region, tokenId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing AI model serving auth token", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags := resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	core.LogAndAddWarning(ctx, &resp.Diagnostics, "AI model serving auth token content not available",
+		"The content of an AI model serving auth token is only returned when the token is created, so it can't be recovered by an import. "+
+			"`token` stays empty and `content_available` is `false`. Recreate the token, e.g. by changing `rotate_when_changed`, if you need its content.",
+	)
+	tflog.Info(ctx, "Model-Serving auth token state imported")
+}
+
+// regionChanged returns whether the region of an existing token is changed in the plan.
+func regionChanged(stateRegion, planRegion types.String) bool {
+	if utils.IsUndefined(stateRegion) || utils.IsUndefined(planRegion) {
+		return false
+	}
+	return stateRegion.ValueString() != planRegion.ValueString()
+}
+
 func mapCreateResponse(tokenCreateResp *modelserving.CreateTokenResponse, waitResp *modelserving.GetTokenResponse, model *Model, region string) error {
 	if tokenCreateResp == nil || tokenCreateResp.Token == nil {
 		return fmt.Errorf("response input is nil")
@@ -558,7 +640,8 @@ func mapCreateResponse(tokenCreateResp *modelserving.CreateTokenResponse, waitRe
 	model.State = types.StringValue(string(waitResp.Token.GetState()))
 	model.ValidUntil = validUntil
 	model.Token = types.StringPointerValue(token.Content)
-	model.Description = types.StringPointerValue(token.Description)
+	model.ContentAvailable = types.BoolValue(token.Content != nil)
+	model.Description = conversion.NormalizedStringPointerValue(model.Description, token.Description)
 
 	return nil
 }
@@ -586,11 +669,34 @@ func mapGetResponse(tokenGetResp *modelserving.GetTokenResponse, model *Model) e
 	model.Name = types.StringPointerValue(tokenGetResp.Token.Name)
 	model.State = types.StringValue(string(tokenGetResp.Token.GetState()))
 	model.ValidUntil = validUntil
-	model.Description = types.StringPointerValue(tokenGetResp.Token.Description)
+	model.Description = conversion.NormalizedStringPointerValue(model.Description, tokenGetResp.Token.Description)
+	// Tokens created before content_available was added have the content in the state, but no value for it
+	if model.ContentAvailable.IsNull() && !model.Token.IsNull() {
+		model.ContentAvailable = types.BoolValue(true)
+	}
 
 	return nil
 }
 
+func mapImportResponse(tokenGetResp *modelserving.GetTokenResponse, projectId, region, tokenId string) (*Model, error) {
+	model := &Model{
+		ProjectId:                  types.StringValue(projectId),
+		Region:                     types.StringValue(region),
+		TokenId:                    types.StringValue(tokenId),
+		Description:                types.StringNull(),
+		TTLDuration:                types.StringNull(),
+		ExpirationWarningThreshold: types.StringNull(),
+		Token:                      types.StringNull(),
+		ContentAvailable:           types.BoolValue(false),
+		RotateWhenChanged:          types.MapNull(types.StringType),
+	}
+	err := mapGetResponse(tokenGetResp, model)
+	if err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
 func toCreatePayload(model *Model) (*modelserving.CreateTokenPayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
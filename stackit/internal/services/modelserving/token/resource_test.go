@@ -77,6 +77,72 @@ func TestMapGetTokenFields(t *testing.T) {
 			},
 			isValid: true,
 		},
+		{
+			description: "should set content available for tokens with content in state",
+			state: &Model{
+				Id:                types.StringValue("pid,eu01,tid"),
+				ProjectId:         types.StringValue("pid"),
+				TokenId:           types.StringValue("tid"),
+				Region:            types.StringValue("eu01"),
+				Token:             types.StringValue("content"),
+				ContentAvailable:  types.BoolNull(),
+				RotateWhenChanged: types.MapNull(types.StringType),
+			},
+			input: &modelserving.GetTokenResponse{
+				Token: &modelserving.Token{
+					Id:    utils.Ptr("tid"),
+					State: modelserving.TOKENSTATE_ACTIVE.Ptr(),
+					Name:  utils.Ptr("name"),
+				},
+			},
+			expected: Model{
+				Id:                types.StringValue("pid,eu01,tid"),
+				ProjectId:         types.StringValue("pid"),
+				Region:            types.StringValue("eu01"),
+				TokenId:           types.StringValue("tid"),
+				Name:              types.StringValue("name"),
+				Description:       types.StringNull(),
+				State:             types.StringValue(string(modelserving.TOKENSTATE_ACTIVE)),
+				ValidUntil:        types.StringNull(),
+				Token:             types.StringValue("content"),
+				ContentAvailable:  types.BoolValue(true),
+				RotateWhenChanged: types.MapNull(types.StringType),
+			},
+			isValid: true,
+		},
+		{
+			description: "should keep content available of imported tokens",
+			state: &Model{
+				Id:                types.StringValue("pid,eu01,tid"),
+				ProjectId:         types.StringValue("pid"),
+				TokenId:           types.StringValue("tid"),
+				Region:            types.StringValue("eu01"),
+				Token:             types.StringNull(),
+				ContentAvailable:  types.BoolValue(false),
+				RotateWhenChanged: types.MapNull(types.StringType),
+			},
+			input: &modelserving.GetTokenResponse{
+				Token: &modelserving.Token{
+					Id:    utils.Ptr("tid"),
+					State: modelserving.TOKENSTATE_ACTIVE.Ptr(),
+					Name:  utils.Ptr("name"),
+				},
+			},
+			expected: Model{
+				Id:                types.StringValue("pid,eu01,tid"),
+				ProjectId:         types.StringValue("pid"),
+				Region:            types.StringValue("eu01"),
+				TokenId:           types.StringValue("tid"),
+				Name:              types.StringValue("name"),
+				Description:       types.StringNull(),
+				State:             types.StringValue(string(modelserving.TOKENSTATE_ACTIVE)),
+				ValidUntil:        types.StringNull(),
+				Token:             types.StringNull(),
+				ContentAvailable:  types.BoolValue(false),
+				RotateWhenChanged: types.MapNull(types.StringType),
+			},
+			isValid: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -200,6 +266,7 @@ func TestMapCreateTokenFields(t *testing.T) {
 				State:             types.StringValue(string(modelserving.TOKENSTATE_ACTIVE)),
 				ValidUntil:        types.StringValue("2099-01-01T00:00:00Z"),
 				Token:             types.StringValue("content"),
+				ContentAvailable:  types.BoolValue(true),
 				RotateWhenChanged: types.MapNull(types.StringType),
 			},
 			isValid: true,
@@ -234,6 +301,74 @@ func TestMapCreateTokenFields(t *testing.T) {
 	}
 }
 
+func TestMapImportResponse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		input       *modelserving.GetTokenResponse
+		expected    *Model
+		isValid     bool
+	}{
+		{
+			description: "should error when response is nil",
+			input:       nil,
+			isValid:     false,
+		},
+		{
+			description: "should map fields without content",
+			input: &modelserving.GetTokenResponse{
+				Token: &modelserving.Token{
+					Id: utils.Ptr("tid"),
+					ValidUntil: utils.Ptr(
+						time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC),
+					),
+					State:       modelserving.TOKENSTATE_ACTIVE.Ptr(),
+					Name:        utils.Ptr("name"),
+					Description: utils.Ptr("desc"),
+				},
+			},
+			expected: &Model{
+				Id:                types.StringValue("pid,eu01,tid"),
+				ProjectId:         types.StringValue("pid"),
+				Region:            types.StringValue("eu01"),
+				TokenId:           types.StringValue("tid"),
+				Name:              types.StringValue("name"),
+				Description:       types.StringValue("desc"),
+				State:             types.StringValue(string(modelserving.TOKENSTATE_ACTIVE)),
+				ValidUntil:        types.StringValue("2099-01-01T00:00:00Z"),
+				TTLDuration:       types.StringNull(),
+				Token:             types.StringNull(),
+				ContentAvailable:  types.BoolValue(false),
+				RotateWhenChanged: types.MapNull(types.StringType),
+			},
+			isValid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := mapImportResponse(tt.input, "pid", "eu01", "tid")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
 func TestToCreatePayload(t *testing.T) {
 	t.Parallel()
 
@@ -339,3 +474,44 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestRegionChanged(t *testing.T) {
+	tests := []struct {
+		description string
+		stateRegion types.String
+		planRegion  types.String
+		expected    bool
+	}{
+		{
+			"same_region",
+			types.StringValue("eu01"),
+			types.StringValue("eu01"),
+			false,
+		},
+		{
+			"region_changed",
+			types.StringValue("eu01"),
+			types.StringValue("eu02"),
+			true,
+		},
+		{
+			"new_token",
+			types.StringNull(),
+			types.StringValue("eu01"),
+			false,
+		},
+		{
+			"unknown_region",
+			types.StringValue("eu01"),
+			types.StringUnknown(),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if got := regionChanged(tt.stateRegion, tt.planRegion); got != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
@@ -21,7 +21,7 @@ import (
 // Instance resource data
 var instanceResource = map[string]string{
 	"project_id":                      testutil.ProjectId,
-	"name":                            fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum)),
+	"name":                            fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(7, acctest.CharSetAlphaNum)),
 	"acl":                             "192.168.0.0/16",
 	"flavor_cpu":                      "2",
 	"flavor_ram":                      "4",
@@ -44,7 +44,7 @@ var instanceResource = map[string]string{
 
 // User resource data
 var userResource = map[string]string{
-	"username":   fmt.Sprintf("tf-acc-user-%s", acctest.RandStringFromCharSet(7, acctest.CharSetAlpha)),
+	"username":   fmt.Sprintf("tf-acc-user-%s", testutil.RandStringFromCharSet(7, acctest.CharSetAlpha)),
 	"role":       "read",
 	"database":   "default",
 	"project_id": instanceResource["project_id"],
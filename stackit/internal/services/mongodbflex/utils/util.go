@@ -20,7 +20,9 @@ func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags
 		apiClientConfigOptions = append(apiClientConfigOptions, config.WithEndpoint(providerData.MongoDBFlexCustomEndpoint))
 	}
 
-	apiClient, err := mongodbflex.NewAPIClient(apiClientConfigOptions...)
+	apiClient, err := core.CachedClient(providerData.Clients, "mongodbflex", "", providerData.MongoDBFlexCustomEndpoint, func() (*mongodbflex.APIClient, error) {
+		return mongodbflex.NewAPIClient(apiClientConfigOptions...)
+	})
 	if err != nil {
 		core.LogAndAddError(ctx, diags, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the resource configuration", err))
 		return nil
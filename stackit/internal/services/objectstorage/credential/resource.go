@@ -31,9 +31,12 @@ var (
 	_ resource.Resource                = &credentialResource{}
 	_ resource.ResourceWithConfigure   = &credentialResource{}
 	_ resource.ResourceWithImportState = &credentialResource{}
+	_ resource.ResourceWithIdentity    = &credentialResource{}
 	_ resource.ResourceWithModifyPlan  = &credentialResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "credentials_group_id", "credential_id"}
+
 type Model struct {
 	Id                  types.String `tfsdk:"id"` // needed by TF
 	CredentialId        types.String `tfsdk:"credential_id"`
@@ -44,6 +47,8 @@ type Model struct {
 	SecretAccessKey     types.String `tfsdk:"secret_access_key"`
 	ExpirationTimestamp types.String `tfsdk:"expiration_timestamp"`
 	Region              types.String `tfsdk:"region"`
+	// ExpirationWarningThreshold is the remaining validity below which a warning is shown during Read
+	ExpirationWarningThreshold types.String `tfsdk:"expiration_warning_threshold"`
 }
 
 // NewCredentialResource is a helper function to simplify the provider implementation.
@@ -88,7 +93,7 @@ func (r *credentialResource) modifyPlanRegion(ctx context.Context, req *resource
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -132,6 +137,11 @@ func (r *credentialResource) Metadata(_ context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_objectstorage_credential"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *credentialResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *credentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	var ok bool
@@ -227,6 +237,7 @@ func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"expiration_warning_threshold": utils.ExpirationWarningThresholdAttribute("credential", validate.TTLDurationString()),
 			"region": schema.StringAttribute{
 				Optional: true,
 				// must be computed to allow for storing the override value from the provider
@@ -242,6 +253,8 @@ func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -325,6 +338,8 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 
 // Read refreshes the Terraform state with the latest data.
 func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -353,7 +368,7 @@ func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 	ctx = core.LogResponse(ctx)
 
 	if !found {
-		resp.State.RemoveResource(ctx)
+		core.RemoveFromStateWithWarning(ctx, resp, "stackit_objectstorage_credential", model.Id.ValueString())
 		return
 	}
 	var (
@@ -379,6 +394,8 @@ func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 		}
 	}
 
+	utils.WarnIfExpiring(ctx, &resp.Diagnostics, "credential", model.ExpirationWarningThreshold, model.ExpirationTimestamp)
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -436,11 +453,12 @@ func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,credentials_group_id,credential_id
 func (r *credentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing credential",
-			fmt.Sprintf("Expected import identifier with format [project_id],[region],[credentials_group_id],[credential_id], got %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format [project_id],[region],[credentials_group_id],[credential_id], got %q", importId),
 		)
 		return
 	}
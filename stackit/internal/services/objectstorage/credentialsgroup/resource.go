@@ -31,9 +31,12 @@ var (
 	_ resource.Resource                = &credentialsGroupResource{}
 	_ resource.ResourceWithConfigure   = &credentialsGroupResource{}
 	_ resource.ResourceWithImportState = &credentialsGroupResource{}
+	_ resource.ResourceWithIdentity    = &credentialsGroupResource{}
 	_ resource.ResourceWithModifyPlan  = &credentialsGroupResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "credentials_group_id"}
+
 type Model struct {
 	Id                 types.String `tfsdk:"id"` // needed by TF
 	CredentialsGroupId types.String `tfsdk:"credentials_group_id"`
@@ -73,7 +76,7 @@ func (r *credentialsGroupResource) ModifyPlan(ctx context.Context, req resource.
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -89,6 +92,11 @@ func (r *credentialsGroupResource) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_objectstorage_credentials_group"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *credentialsGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *credentialsGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	var ok bool
@@ -108,7 +116,7 @@ func (r *credentialsGroupResource) Configure(ctx context.Context, req resource.C
 // Schema defines the schema for the resource.
 func (r *credentialsGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	descriptions := map[string]string{
-		"main":                 "ObjectStorage credentials group resource schema. Must have a `region` specified in the provider configuration. If you are creating `credentialsgroup` and `bucket` resources simultaneously, please include the `depends_on` field so that they are created sequentially. This prevents errors from concurrent calls to the service enablement that is done in the background.",
+		"main":                 "ObjectStorage credentials group resource schema. Must have a `region` specified in the provider configuration. If you are creating `credentialsgroup` and `bucket` resources simultaneously, please include the `depends_on` field so that they are created sequentially. This prevents errors from concurrent calls to the service enablement that is done in the background. Alternatively, let them depend on a `stackit_objectstorage_project`.",
 		"id":                   "Terraform's internal data source identifier. It is structured as \"`project_id`,`region`,`credentials_group_id`\".",
 		"credentials_group_id": "The credentials group ID",
 		"name":                 "The credentials group's display name.",
@@ -170,6 +178,8 @@ func (r *credentialsGroupResource) Schema(_ context.Context, _ resource.SchemaRe
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *credentialsGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -223,6 +233,8 @@ func (r *credentialsGroupResource) Create(ctx context.Context, req resource.Crea
 
 // Read refreshes the Terraform state with the latest data.
 func (r *credentialsGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -249,7 +261,7 @@ func (r *credentialsGroupResource) Read(ctx context.Context, req resource.ReadRe
 	ctx = core.LogResponse(ctx)
 
 	if !found {
-		resp.State.RemoveResource(ctx)
+		core.RemoveFromStateWithWarning(ctx, resp, "stackit_objectstorage_credentials_group", model.Id.ValueString())
 		return
 	}
 	// update the region manually
@@ -303,11 +315,12 @@ func (r *credentialsGroupResource) Delete(ctx context.Context, req resource.Dele
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id, credentials_group_id
 func (r *credentialsGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing credentialsGroup",
-			fmt.Sprintf("Expected import identifier with format [project_id],[region],[credentials_group_id], got %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format [project_id],[region],[credentials_group_id], got %q", importId),
 		)
 		return
 	}
@@ -26,8 +26,8 @@ var resourceMinConfig string
 
 var testConfigVarsMin = config.Variables{
 	"project_id":                           config.StringVariable(testutil.ProjectId),
-	"objectstorage_bucket_name":            config.StringVariable(fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(20, acctest.CharSetAlpha))),
-	"objectstorage_credentials_group_name": config.StringVariable(fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(20, acctest.CharSetAlpha))),
+	"objectstorage_bucket_name":            config.StringVariable(fmt.Sprintf("tf-acc-test-%s", testutil.RandStringFromCharSet(20, acctest.CharSetAlpha))),
+	"objectstorage_credentials_group_name": config.StringVariable(fmt.Sprintf("tf-acc-test-%s", testutil.RandStringFromCharSet(20, acctest.CharSetAlpha))),
 	"expiration_timestamp":                 config.StringVariable(fmt.Sprintf("%d-01-02T03:04:05Z", time.Now().Year()+1)),
 }
 
@@ -0,0 +1,426 @@
+package objectstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/objectstorage"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	objectstorageUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/objectstorage/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &projectResource{}
+	_ resource.ResourceWithConfigure   = &projectResource{}
+	_ resource.ResourceWithImportState = &projectResource{}
+	_ resource.ResourceWithIdentity    = &projectResource{}
+	_ resource.ResourceWithModifyPlan  = &projectResource{}
+)
+
+var resourceIdentity = utils.Identity{"project_id", "region"}
+
+type Model struct {
+	Id               types.String `tfsdk:"id"` // needed by TF
+	ProjectId        types.String `tfsdk:"project_id"`
+	Region           types.String `tfsdk:"region"`
+	Scope            types.String `tfsdk:"scope"`
+	S3Endpoint       types.String `tfsdk:"s3_endpoint"`
+	DisableOnDestroy types.Bool   `tfsdk:"disable_on_destroy"`
+}
+
+// NewProjectResource is a helper function to simplify the provider implementation.
+func NewProjectResource() resource.Resource {
+	return &projectResource{}
+}
+
+// projectResource is the resource implementation.
+type projectResource struct {
+	client       *objectstorage.APIClient
+	providerData core.ProviderData
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *projectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectstorage_project"
+}
+
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *projectResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := objectstorageUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "ObjectStorage project client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main": "ObjectStorage project resource schema. Enables object storage for a project in a region, so that buckets and credentials groups can depend on it, " +
+			"and provides the S3 endpoint of the region.",
+		"id":                 "Terraform's internal resource identifier. It is structured as \"`project_id`,`region`\".",
+		"project_id":         "STACKIT Project ID in which object storage is enabled.",
+		"region":             "The resource region. If not defined, the provider region is used.",
+		"scope":              "The scope of the project, e.g. `PUBLIC`.",
+		"s3_endpoint":        "The S3 endpoint of the region, e.g. for the `s3` endpoint of the AWS provider. The API doesn't return it, so it's derived from the naming convention `https://object.storage.<region>.onstackit.cloud`. If `objectstorage_custom_endpoint` is set in the provider, it's taken from the URL of a bucket in the project instead, and is null if the project has no buckets.",
+		"disable_on_destroy": "If set to `true`, object storage is disabled in the project when the resource is destroyed. This fails if the project still has buckets. Defaults to `false`.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		MarkdownDescription: fmt.Sprintf("%s\n\n~> By default, object storage is **not** disabled during a `terraform destroy`, since buckets and credentials groups, which aren't managed by Terraform, might still use it. Set `disable_on_destroy` to disable it.",
+			descriptions["main"]),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: descriptions["region"],
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope": schema.StringAttribute{
+				Description: descriptions["scope"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"s3_endpoint": schema.StringAttribute{
+				Description: descriptions["s3_endpoint"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"disable_on_destroy": schema.BoolAttribute{
+				Description: descriptions["disable_on_destroy"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	// From the object storage OAS: Creation will also be successful if the project is already enabled, but will not create a duplicate
+	status, err := r.client.EnableServiceExecute(ctx, projectId, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error enabling object storage", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	endpoint, err := r.s3Endpoint(ctx, projectId, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error enabling object storage", fmt.Sprintf("Getting S3 endpoint: %v", err))
+		return
+	}
+
+	err = mapFields(status, &model, region, endpoint)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error enabling object storage", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "ObjectStorage project enabled")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	status, err := r.client.GetServiceStatusExecute(ctx, projectId, region)
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
+		// A project disabled outside of Terraform is enabled again on the next apply
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_objectstorage_project", model.Id.ValueString())
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading object storage project", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	endpoint, err := r.s3Endpoint(ctx, projectId, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading object storage project", fmt.Sprintf("Getting S3 endpoint: %v", err))
+		return
+	}
+
+	err = mapFields(status, &model, region, endpoint)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading object storage project", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "ObjectStorage project read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	// Only disable_on_destroy can be updated, it isn't known to the API
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var stateModel Model
+	diags = req.State.Get(ctx, &stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateModel.DisableOnDestroy = model.DisableOnDestroy
+
+	diags = resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "ObjectStorage project updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	if !model.DisableOnDestroy.ValueBool() {
+		tflog.Info(ctx, "ObjectStorage project removed from state, object storage is still enabled")
+		return
+	}
+
+	_, err := r.client.DisableServiceExecute(ctx, projectId, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error disabling object storage", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+	tflog.Info(ctx, "ObjectStorage project disabled")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,region
+func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing object storage project",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region]  Got: %q", importId),
+		)
+		return
+	}
+
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id": idParts[0],
+		"region":     idParts[1],
+	})
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("disable_on_destroy"), false)...)
+	tflog.Info(ctx, "ObjectStorage project state imported")
+}
+
+// s3Endpoint returns the S3 endpoint of object storage in the region. The API doesn't return it, so it's derived
+// from the naming convention. With a custom API endpoint the convention doesn't necessarily hold, so it's taken
+// from the URL of a bucket in the project instead. It's empty if the endpoint can't be determined.
+func (r *projectResource) s3Endpoint(ctx context.Context, projectId, region string) (string, error) {
+	if r.providerData.ObjectStorageCustomEndpoint == "" {
+		return fmt.Sprintf("https://object.storage.%s.onstackit.cloud", region), nil
+	}
+	bucketsResp, err := r.client.ListBucketsExecute(ctx, projectId, region)
+	if err != nil {
+		return "", fmt.Errorf("listing buckets: %w", err)
+	}
+	return s3EndpointFromBuckets(bucketsResp)
+}
+
+// s3EndpointFromBuckets returns the scheme and host of the path style URL of the first bucket.
+func s3EndpointFromBuckets(bucketsResp *objectstorage.ListBucketsResponse) (string, error) {
+	if bucketsResp == nil || bucketsResp.Buckets == nil || len(*bucketsResp.Buckets) == 0 {
+		return "", nil
+	}
+	bucket := (*bucketsResp.Buckets)[0]
+	if bucket.UrlPathStyle == nil {
+		return "", fmt.Errorf("bucket %q has no URL", bucket.GetName())
+	}
+	bucketURL, err := url.Parse(*bucket.UrlPathStyle)
+	if err != nil {
+		return "", fmt.Errorf("parsing URL of bucket %q: %w", bucket.GetName(), err)
+	}
+	if bucketURL.Scheme == "" || bucketURL.Host == "" {
+		return "", fmt.Errorf("URL of bucket %q is not absolute: %q", bucket.GetName(), *bucket.UrlPathStyle)
+	}
+	return fmt.Sprintf("%s://%s", bucketURL.Scheme, bucketURL.Host), nil
+}
+
+func mapFields(status *objectstorage.ProjectStatus, model *Model, region, endpoint string) error {
+	if status == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	var projectId string
+	if model.ProjectId.ValueString() != "" {
+		projectId = model.ProjectId.ValueString()
+	} else if status.Project != nil {
+		projectId = *status.Project
+	} else {
+		return fmt.Errorf("project id not present")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(projectId, region)
+	model.ProjectId = types.StringValue(projectId)
+	model.Region = types.StringValue(region)
+	model.Scope = types.StringNull()
+	if status.Scope != nil {
+		model.Scope = types.StringValue(string(*status.Scope))
+	}
+	model.S3Endpoint = types.StringNull()
+	if endpoint != "" {
+		model.S3Endpoint = types.StringValue(endpoint)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package objectstorage
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/objectstorage"
+)
+
+func TestMapFields(t *testing.T) {
+	const testRegion = "eu01"
+	tests := []struct {
+		description string
+		state       Model
+		input       *objectstorage.ProjectStatus
+		endpoint    string
+		expected    Model
+		isValid     bool
+	}{
+		{
+			description: "default_values",
+			state: Model{
+				ProjectId: types.StringValue("pid"),
+			},
+			input: &objectstorage.ProjectStatus{},
+			expected: Model{
+				Id:         types.StringValue("pid,eu01"),
+				ProjectId:  types.StringValue("pid"),
+				Region:     types.StringValue(testRegion),
+				Scope:      types.StringNull(),
+				S3Endpoint: types.StringNull(),
+			},
+			isValid: true,
+		},
+		{
+			description: "simple_values",
+			state: Model{
+				ProjectId:        types.StringValue("pid"),
+				DisableOnDestroy: types.BoolValue(true),
+			},
+			input: &objectstorage.ProjectStatus{
+				Project: utils.Ptr("pid"),
+				Scope:   objectstorage.PROJECTSCOPE_PUBLIC.Ptr(),
+			},
+			endpoint: "https://object.storage.eu01.onstackit.cloud",
+			expected: Model{
+				Id:               types.StringValue("pid,eu01"),
+				ProjectId:        types.StringValue("pid"),
+				Region:           types.StringValue(testRegion),
+				Scope:            types.StringValue("PUBLIC"),
+				S3Endpoint:       types.StringValue("https://object.storage.eu01.onstackit.cloud"),
+				DisableOnDestroy: types.BoolValue(true),
+			},
+			isValid: true,
+		},
+		{
+			description: "response_nil_fail",
+			state: Model{
+				ProjectId: types.StringValue("pid"),
+			},
+			input:   nil,
+			isValid: false,
+		},
+		{
+			description: "no_project_id",
+			state:       Model{},
+			input:       &objectstorage.ProjectStatus{},
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, &tt.state, testRegion, tt.endpoint)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestS3EndpointFromBuckets(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *objectstorage.ListBucketsResponse
+		expected    string
+		isValid     bool
+	}{
+		{
+			description: "bucket",
+			input: &objectstorage.ListBucketsResponse{
+				Buckets: &[]objectstorage.Bucket{
+					{
+						Name:         utils.Ptr("bucket"),
+						UrlPathStyle: utils.Ptr("https://object.storage.eu01.onstackit.cloud/bucket"),
+					},
+				},
+			},
+			expected: "https://object.storage.eu01.onstackit.cloud",
+			isValid:  true,
+		},
+		{
+			description: "no_buckets",
+			input: &objectstorage.ListBucketsResponse{
+				Buckets: &[]objectstorage.Bucket{},
+			},
+			expected: "",
+			isValid:  true,
+		},
+		{
+			description: "nil_response",
+			input:       nil,
+			expected:    "",
+			isValid:     true,
+		},
+		{
+			description: "no_url",
+			input: &objectstorage.ListBucketsResponse{
+				Buckets: &[]objectstorage.Bucket{
+					{
+						Name: utils.Ptr("bucket"),
+					},
+				},
+			},
+			isValid: false,
+		},
+		{
+			description: "relative_url",
+			input: &objectstorage.ListBucketsResponse{
+				Buckets: &[]objectstorage.Bucket{
+					{
+						Name:         utils.Ptr("bucket"),
+						UrlPathStyle: utils.Ptr("bucket"),
+					},
+				},
+			},
+			isValid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := s3EndpointFromBuckets(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid && output != tt.expected {
+				t.Fatalf("Output %q does not match expected %q", output, tt.expected)
+			}
+		})
+	}
+}
@@ -36,8 +36,11 @@ var (
 	_ resource.Resource                = &logAlertGroupResource{}
 	_ resource.ResourceWithConfigure   = &logAlertGroupResource{}
 	_ resource.ResourceWithImportState = &logAlertGroupResource{}
+	_ resource.ResourceWithIdentity    = &logAlertGroupResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "instance_id", "name"}
+
 type Model struct {
 	Id         types.String `tfsdk:"id"`
 	ProjectId  types.String `tfsdk:"project_id"`
@@ -92,6 +95,11 @@ func (l *logAlertGroupResource) Metadata(_ context.Context, req resource.Metadat
 	resp.TypeName = req.ProviderTypeName + "_observability_logalertgroup"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (l *logAlertGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (l *logAlertGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
@@ -231,6 +239,8 @@ func (l *logAlertGroupResource) Schema(_ context.Context, _ resource.SchemaReque
 
 // Create creates the resource and sets the initial Terraform state.
 func (l *logAlertGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -286,6 +296,8 @@ func (l *logAlertGroupResource) Create(ctx context.Context, req resource.CreateR
 
 // Read refreshes the Terraform state with the latest data.
 func (l *logAlertGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -307,7 +319,7 @@ func (l *logAlertGroupResource) Read(ctx context.Context, req resource.ReadReque
 		var oapiErr *oapierror.GenericOpenAPIError
 		ok := errors.As(err, &oapiErr)
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_observability_logalertgroup", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading log alert group", fmt.Sprintf("Calling API: %v", err))
@@ -368,12 +380,13 @@ func (l *logAlertGroupResource) Delete(ctx context.Context, req resource.DeleteR
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,instance_id,name
 func (l *logAlertGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing scrape config",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[instance_id],[name]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[instance_id],[name]  Got: %q", importId),
 		)
 		return
 	}
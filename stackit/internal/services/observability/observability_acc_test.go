@@ -36,30 +36,30 @@ var logalertgroup_expression_updated = "sum(rate({namespace=\"example\"} |= \"An
 
 var testConfigVarsMin = config.Variables{
 	"project_id":                config.StringVariable(testutil.ProjectId),
-	"alertgroup_name":           config.StringVariable(fmt.Sprintf("tf-acc-ag%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"alertgroup_name":           config.StringVariable(fmt.Sprintf("tf-acc-ag%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 	"alert_rule_name":           config.StringVariable("alert1"),
 	"alert_rule_expression":     config.StringVariable(alert_rule_expression),
-	"instance_name":             config.StringVariable(fmt.Sprintf("tf-acc-i%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"instance_name":             config.StringVariable(fmt.Sprintf("tf-acc-i%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 	"plan_name":                 config.StringVariable("Observability-Medium-EU01"),
-	"logalertgroup_name":        config.StringVariable(fmt.Sprintf("tf-acc-lag%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"logalertgroup_name":        config.StringVariable(fmt.Sprintf("tf-acc-lag%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 	"logalertgroup_alert":       config.StringVariable("alert1"),
 	"logalertgroup_expression":  config.StringVariable(logalertgroup_expression),
-	"scrapeconfig_name":         config.StringVariable(fmt.Sprintf("tf-acc-sc%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"scrapeconfig_name":         config.StringVariable(fmt.Sprintf("tf-acc-sc%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 	"scrapeconfig_metrics_path": config.StringVariable("/metrics"),
 	"scrapeconfig_targets_url":  config.StringVariable("www.y97xyrrocx2gsxx.de"),
 }
 
 var testConfigVarsMax = config.Variables{
 	"project_id":                 config.StringVariable(testutil.ProjectId),
-	"alertgroup_name":            config.StringVariable(fmt.Sprintf("tf-acc-ag%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"alertgroup_name":            config.StringVariable(fmt.Sprintf("tf-acc-ag%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 	"alert_rule_name":            config.StringVariable("alert1"),
 	"alert_rule_expression":      config.StringVariable(alert_rule_expression),
-	"instance_name":              config.StringVariable(fmt.Sprintf("tf-acc-i%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"instance_name":              config.StringVariable(fmt.Sprintf("tf-acc-i%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 	"plan_name":                  config.StringVariable("Observability-Medium-EU01"),
-	"logalertgroup_name":         config.StringVariable(fmt.Sprintf("tf-acc-lag%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"logalertgroup_name":         config.StringVariable(fmt.Sprintf("tf-acc-lag%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 	"logalertgroup_alert":        config.StringVariable("alert1"),
 	"logalertgroup_expression":   config.StringVariable(logalertgroup_expression),
-	"scrapeconfig_name":          config.StringVariable(fmt.Sprintf("tf-acc-sc%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"scrapeconfig_name":          config.StringVariable(fmt.Sprintf("tf-acc-sc%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
 	"scrapeconfig_metrics_path":  config.StringVariable("/metrics"),
 	"scrapeconfig_targets_url_1": config.StringVariable("www.y97xyrrocx2gsxx.de"),
 	"scrapeconfig_targets_url_2": config.StringVariable("f6zkn8gzeigwanh.de"),
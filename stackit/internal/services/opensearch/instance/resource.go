@@ -11,6 +11,7 @@ import (
 
 	opensearchUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/opensearch/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -36,8 +37,11 @@ var (
 	_ resource.Resource                = &instanceResource{}
 	_ resource.ResourceWithConfigure   = &instanceResource{}
 	_ resource.ResourceWithImportState = &instanceResource{}
+	_ resource.ResourceWithIdentity    = &instanceResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "instance_id"}
+
 type Model struct {
 	Id                 types.String `tfsdk:"id"` // needed by TF
 	InstanceId         types.String `tfsdk:"instance_id"`
@@ -56,6 +60,7 @@ type Model struct {
 
 // Struct corresponding to DataSourceModel.Parameters
 type parametersModel struct {
+	ACL                  types.List   `tfsdk:"acl"`
 	SgwAcl               types.String `tfsdk:"sgw_acl"`
 	EnableMonitoring     types.Bool   `tfsdk:"enable_monitoring"`
 	Graphite             types.String `tfsdk:"graphite"`
@@ -74,6 +79,7 @@ type parametersModel struct {
 
 // Types corresponding to parametersModel
 var parametersTypes = map[string]attr.Type{
+	"acl":                    basetypes.ListType{ElemType: types.StringType},
 	"sgw_acl":                basetypes.StringType{},
 	"enable_monitoring":      basetypes.BoolType{},
 	"graphite":               basetypes.StringType{},
@@ -97,7 +103,8 @@ func NewInstanceResource() resource.Resource {
 
 // instanceResource is the resource implementation.
 type instanceResource struct {
-	client *opensearch.APIClient
+	client       *opensearch.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -105,6 +112,11 @@ func (r *instanceResource) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_opensearch_instance"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *instanceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *instanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
@@ -117,6 +129,7 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 	r.client = apiClient
+	r.providerData = providerData
 	tflog.Info(ctx, "OpenSearch instance client configured")
 }
 
@@ -135,7 +148,8 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 
 	parametersDescriptions := map[string]string{
-		"sgw_acl":                "Comma separated list of IP networks in CIDR notation which are allowed to access this instance.",
+		"acl":                    "List of IP networks in CIDR notation which are allowed to access this instance. Can be used instead of `sgw_acl`.",
+		"sgw_acl":                "Comma separated list of IP networks in CIDR notation which are allowed to access this instance. This field is deprecated, use `acl` instead.",
 		"enable_monitoring":      "Enable monitoring.",
 		"graphite":               "If set, monitoring with Graphite will be enabled. Expects the host and port where the Graphite metrics should be sent to (host:port).",
 		"max_disk_threshold":     "The maximum disk threshold in MB. If the disk usage exceeds this threshold, the instance will be stopped.",
@@ -188,7 +202,7 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: descriptions["name"],
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					utils.RequiresReplaceIfRenameRejected(),
 					stringplanmodifier.UseStateForUnknown(),
 				},
 				Validators: []validator.String{
@@ -210,10 +224,21 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			"parameters": schema.SingleNestedAttribute{
 				Description: descriptions["parameters"],
 				Attributes: map[string]schema.Attribute{
-					"sgw_acl": schema.StringAttribute{
-						Description: parametersDescriptions["sgw_acl"],
+					"acl": schema.ListAttribute{
+						Description: parametersDescriptions["acl"],
+						ElementType: types.StringType,
 						Optional:    true,
 						Computed:    true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(validate.CIDR()),
+							listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("sgw_acl")),
+						},
+					},
+					"sgw_acl": schema.StringAttribute{
+						Description:        parametersDescriptions["sgw_acl"],
+						DeprecationMessage: "Use `acl` instead.",
+						Optional:           true,
+						Computed:           true,
 					},
 					"enable_monitoring": schema.BoolAttribute{
 						Description: parametersDescriptions["enable_monitoring"],
@@ -324,6 +349,8 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *instanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -369,7 +396,7 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 
 	instanceId := *createResp.InstanceId
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
-	waitResp, err := wait.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandler(wait.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId), r.providerData.Wait, core.WaitCreate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
 		return
@@ -393,6 +420,8 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 
 // Read refreshes the Terraform state with the latest data.
 func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -411,7 +440,7 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && (oapiErr.StatusCode == http.StatusNotFound || oapiErr.StatusCode == http.StatusGone) {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_opensearch_instance", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading instance", fmt.Sprintf("Calling API: %v", err))
@@ -445,6 +474,8 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *instanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -459,6 +490,14 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
 
+	var stateName types.String
+	diags = req.State.GetAttribute(ctx, path.Root("name"), &stateName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	nameChanged := !model.Name.Equal(stateName)
+
 	var parameters *parametersModel
 	if !(model.Parameters.IsNull() || model.Parameters.IsUnknown()) {
 		parameters = &parametersModel{}
@@ -476,7 +515,7 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	// Generate API request body from model
-	payload, err := toUpdatePayload(&model, parameters)
+	payload, err := toUpdatePayload(&model, parameters, stateName)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -484,10 +523,10 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	// Update existing instance
 	err = r.client.PartialUpdateInstance(ctx, projectId, instanceId).PartialUpdateInstancePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Calling API: %v", err))
+		utils.LogUpdateErrorWithRenameFallback(ctx, &resp.Diagnostics, resp.Private, err, nameChanged, "Error updating instance")
 		return
 	}
-	waitResp, err := wait.PartialUpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandler(wait.PartialUpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId), r.providerData.Wait, core.WaitUpdate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
 		return
@@ -536,7 +575,7 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
 		return
@@ -547,12 +586,13 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,instance_id
 func (r *instanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing instance",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[instance_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[instance_id]  Got: %q", importId),
 		)
 		return
 	}
@@ -704,6 +744,10 @@ func mapParameters(params map[string]interface{}) (types.Object, error) {
 		attributes[attribute] = value
 	}
 
+	// The API only returns the comma separated sgw_acl, the ACL is derived from it
+	sgwAcl, _ := attributes["sgw_acl"].(types.String)
+	attributes["acl"] = conversion.CommaSeparatedToStringList(sgwAcl)
+
 	output, diags := types.ObjectValue(parametersTypes, attributes)
 	if diags.HasError() {
 		return types.ObjectNull(parametersTypes), fmt.Errorf("failed to create object: %w", core.DiagsToError(diags))
@@ -726,7 +770,8 @@ func toCreatePayload(model *Model, parameters *parametersModel) (*opensearch.Cre
 	}, nil
 }
 
-func toUpdatePayload(model *Model, parameters *parametersModel) (*opensearch.PartialUpdateInstancePayload, error) {
+// toUpdatePayload only contains the name if it differs from the name in the state.
+func toUpdatePayload(model *Model, parameters *parametersModel, stateName types.String) (*opensearch.PartialUpdateInstancePayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
 	}
@@ -734,10 +779,15 @@ func toUpdatePayload(model *Model, parameters *parametersModel) (*opensearch.Par
 	if err != nil {
 		return nil, fmt.Errorf("convert parameters: %w", err)
 	}
-	return &opensearch.PartialUpdateInstancePayload{
+	payload := &opensearch.PartialUpdateInstancePayload{
 		Parameters: payloadParams,
 		PlanId:     conversion.StringValueToPointer(model.PlanId),
-	}, nil
+	}
+	// Only send the name if it changed, so updates of other fields don't fail if the instance can't be renamed
+	if !model.Name.Equal(stateName) {
+		payload.InstanceName = conversion.StringValueToPointer(model.Name)
+	}
+	return payload, nil
 }
 
 func toInstanceParams(parameters *parametersModel) (*opensearch.InstanceParameters, error) {
@@ -747,6 +797,13 @@ func toInstanceParams(parameters *parametersModel) (*opensearch.InstanceParamete
 	payloadParams := &opensearch.InstanceParameters{}
 
 	payloadParams.SgwAcl = conversion.StringValueToPointer(parameters.SgwAcl)
+	acl, err := conversion.StringListToCommaSeparatedPointer(parameters.ACL)
+	if err != nil {
+		return nil, fmt.Errorf("convert acl: %w", err)
+	}
+	if acl != nil {
+		payloadParams.SgwAcl = acl
+	}
 	payloadParams.EnableMonitoring = conversion.BoolValueToPointer(parameters.EnableMonitoring)
 	payloadParams.Graphite = conversion.StringValueToPointer(parameters.Graphite)
 	payloadParams.JavaGarbageCollector = opensearch.InstanceParametersGetJavaGarbageCollectorAttributeType(conversion.StringValueToPointer(parameters.JavaGarbageCollector))
@@ -757,7 +814,6 @@ func toInstanceParams(parameters *parametersModel) (*opensearch.InstanceParamete
 	payloadParams.MetricsPrefix = conversion.StringValueToPointer(parameters.MetricsPrefix)
 	payloadParams.MonitoringInstanceId = conversion.StringValueToPointer(parameters.MonitoringInstanceId)
 
-	var err error
 	payloadParams.Plugins, err = conversion.StringListToPointer(parameters.Plugins)
 	if err != nil {
 		return nil, fmt.Errorf("convert plugins: %w", err)
@@ -13,7 +13,10 @@ import (
 )
 
 var fixtureModelParameters = types.ObjectValueMust(parametersTypes, map[string]attr.Value{
-	"sgw_acl":                types.StringValue("acl"),
+	"sgw_acl": types.StringValue("acl"),
+	"acl": types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("acl"),
+	}),
 	"enable_monitoring":      types.BoolValue(true),
 	"graphite":               types.StringValue("graphite"),
 	"java_garbage_collector": types.StringValue(string(opensearch.INSTANCEPARAMETERSJAVA_GARBAGE_COLLECTOR_USE_G1_GC)),
@@ -43,6 +46,7 @@ var fixtureModelParameters = types.ObjectValueMust(parametersTypes, map[string]a
 
 var fixtureNullModelParameters = types.ObjectValueMust(parametersTypes, map[string]attr.Value{
 	"sgw_acl":                types.StringNull(),
+	"acl":                    types.ListNull(types.StringType),
 	"enable_monitoring":      types.BoolNull(),
 	"graphite":               types.StringNull(),
 	"java_garbage_collector": types.StringNull(),
@@ -75,6 +79,22 @@ var fixtureInstanceParameters = opensearch.InstanceParameters{
 	TlsProtocols:         &[]string{"TLSv1.2", "TLSv1.3"},
 }
 
+func fixtureACLModelParameters() types.Object {
+	attributes := fixtureModelParameters.Attributes()
+	attributes["acl"] = types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("10.0.0.0/8"),
+		types.StringValue("192.168.0.0/24"),
+	})
+	attributes["sgw_acl"] = types.StringUnknown()
+	return types.ObjectValueMust(parametersTypes, attributes)
+}
+
+func fixtureACLInstanceParameters() *opensearch.InstanceParameters {
+	parameters := fixtureInstanceParameters
+	parameters.SgwAcl = utils.Ptr("10.0.0.0/8,192.168.0.0/24")
+	return &parameters
+}
+
 func TestMapFields(t *testing.T) {
 	tests := []struct {
 		description string
@@ -227,6 +247,16 @@ func TestToCreatePayload(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"acl",
+			&Model{
+				Parameters: fixtureACLModelParameters(),
+			},
+			&opensearch.CreateInstancePayload{
+				Parameters: fixtureACLInstanceParameters(),
+			},
+			true,
+		},
 		{
 			"null_fields_and_int_conversions",
 			&Model{
@@ -355,7 +385,11 @@ func TestToUpdatePayload(t *testing.T) {
 					}
 				}
 			}
-			output, err := toUpdatePayload(tt.input, parameters)
+			stateName := types.StringNull()
+			if tt.input != nil {
+				stateName = tt.input.Name
+			}
+			output, err := toUpdatePayload(tt.input, parameters, stateName)
 			if !tt.isValid && err == nil {
 				t.Fatalf("Should have failed")
 			}
@@ -371,3 +405,48 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestToUpdatePayloadName(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		stateName   types.String
+		expected    *opensearch.PartialUpdateInstancePayload
+	}{
+		{
+			"name_changed",
+			&Model{
+				Name:   types.StringValue("new-name"),
+				PlanId: types.StringValue("plan"),
+			},
+			types.StringValue("name"),
+			&opensearch.PartialUpdateInstancePayload{
+				InstanceName: utils.Ptr("new-name"),
+				PlanId:       utils.Ptr("plan"),
+			},
+		},
+		{
+			"name_unchanged",
+			&Model{
+				Name:   types.StringValue("name"),
+				PlanId: types.StringValue("plan"),
+			},
+			types.StringValue("name"),
+			&opensearch.PartialUpdateInstancePayload{
+				PlanId: utils.Ptr("plan"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toUpdatePayload(tt.input, nil, tt.stateName)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
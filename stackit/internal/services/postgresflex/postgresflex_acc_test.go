@@ -21,7 +21,7 @@ import (
 // Instance resource data
 var instanceResource = map[string]string{
 	"project_id":              testutil.ProjectId,
-	"name":                    fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum)),
+	"name":                    fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(7, acctest.CharSetAlphaNum)),
 	"acl":                     "192.168.0.0/16",
 	"backup_schedule":         "00 16 * * *",
 	"backup_schedule_updated": "00 12 * * *",
@@ -37,14 +37,14 @@ var instanceResource = map[string]string{
 
 // User resource data
 var userResource = map[string]string{
-	"username":   fmt.Sprintf("tfaccuser%s", acctest.RandStringFromCharSet(4, acctest.CharSetAlpha)),
+	"username":   fmt.Sprintf("tfaccuser%s", testutil.RandStringFromCharSet(4, acctest.CharSetAlpha)),
 	"role":       "createdb",
 	"project_id": instanceResource["project_id"],
 }
 
 // Database resource data
 var databaseResource = map[string]string{
-	"name": fmt.Sprintf("tfaccdb%s", acctest.RandStringFromCharSet(4, acctest.CharSetAlphaNum)),
+	"name": fmt.Sprintf("tfaccdb%s", testutil.RandStringFromCharSet(4, acctest.CharSetAlphaNum)),
 }
 
 func configResources(backupSchedule string, region *string) string {
@@ -10,6 +10,7 @@ import (
 
 	rabbitmqUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/rabbitmq/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -35,8 +36,11 @@ var (
 	_ resource.Resource                = &instanceResource{}
 	_ resource.ResourceWithConfigure   = &instanceResource{}
 	_ resource.ResourceWithImportState = &instanceResource{}
+	_ resource.ResourceWithIdentity    = &instanceResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "instance_id"}
+
 type Model struct {
 	Id                 types.String `tfsdk:"id"` // needed by TF
 	InstanceId         types.String `tfsdk:"instance_id"`
@@ -55,6 +59,7 @@ type Model struct {
 
 // Struct corresponding to DataSourceModel.Parameters
 type parametersModel struct {
+	ACL                  types.List   `tfsdk:"acl"`
 	SgwAcl               types.String `tfsdk:"sgw_acl"`
 	ConsumerTimeout      types.Int64  `tfsdk:"consumer_timeout"`
 	EnableMonitoring     types.Bool   `tfsdk:"enable_monitoring"`
@@ -72,6 +77,7 @@ type parametersModel struct {
 
 // Types corresponding to parametersModel
 var parametersTypes = map[string]attr.Type{
+	"acl":                    basetypes.ListType{ElemType: types.StringType},
 	"sgw_acl":                basetypes.StringType{},
 	"consumer_timeout":       basetypes.Int64Type{},
 	"enable_monitoring":      basetypes.BoolType{},
@@ -94,7 +100,8 @@ func NewInstanceResource() resource.Resource {
 
 // instanceResource is the resource implementation.
 type instanceResource struct {
-	client *rabbitmq.APIClient
+	client       *rabbitmq.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -102,6 +109,11 @@ func (r *instanceResource) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_rabbitmq_instance"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *instanceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *instanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
@@ -114,6 +126,7 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 	r.client = apiClient
+	r.providerData = providerData
 	tflog.Info(ctx, "RabbitMQ instance client configured")
 }
 
@@ -132,7 +145,8 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 
 	parametersDescriptions := map[string]string{
-		"sgw_acl":                "Comma separated list of IP networks in CIDR notation which are allowed to access this instance.",
+		"acl":                    "List of IP networks in CIDR notation which are allowed to access this instance. Can be used instead of `sgw_acl`.",
+		"sgw_acl":                "Comma separated list of IP networks in CIDR notation which are allowed to access this instance. This field is deprecated, use `acl` instead.",
 		"consumer_timeout":       "The timeout in milliseconds for the consumer.",
 		"enable_monitoring":      "Enable monitoring.",
 		"graphite":               "Graphite server URL (host and port). If set, monitoring with Graphite will be enabled.",
@@ -184,7 +198,7 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: descriptions["name"],
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					utils.RequiresReplaceIfRenameRejected(),
 					stringplanmodifier.UseStateForUnknown(),
 				},
 				Validators: []validator.String{
@@ -206,10 +220,21 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			"parameters": schema.SingleNestedAttribute{
 				Description: descriptions["parameters"],
 				Attributes: map[string]schema.Attribute{
-					"sgw_acl": schema.StringAttribute{
-						Description: parametersDescriptions["sgw_acl"],
+					"acl": schema.ListAttribute{
+						Description: parametersDescriptions["acl"],
+						ElementType: types.StringType,
 						Optional:    true,
 						Computed:    true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(validate.CIDR()),
+							listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("sgw_acl")),
+						},
+					},
+					"sgw_acl": schema.StringAttribute{
+						Description:        parametersDescriptions["sgw_acl"],
+						DeprecationMessage: "Use `acl` instead.",
+						Optional:           true,
+						Computed:           true,
 					},
 					"consumer_timeout": schema.Int64Attribute{
 						Description: parametersDescriptions["consumer_timeout"],
@@ -319,6 +344,8 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *instanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -364,7 +391,7 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 
 	instanceId := *createResp.InstanceId
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
-	waitResp, err := wait.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandler(wait.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId), r.providerData.Wait, core.WaitCreate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
 		return
@@ -388,6 +415,8 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 
 // Read refreshes the Terraform state with the latest data.
 func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -406,7 +435,7 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && (oapiErr.StatusCode == http.StatusNotFound || oapiErr.StatusCode == http.StatusGone) {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_rabbitmq_instance", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading instance", fmt.Sprintf("Calling API: %v", err))
@@ -440,6 +469,8 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *instanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -454,6 +485,14 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
 
+	var stateName types.String
+	diags = req.State.GetAttribute(ctx, path.Root("name"), &stateName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	nameChanged := !model.Name.Equal(stateName)
+
 	var parameters *parametersModel
 	if !(model.Parameters.IsNull() || model.Parameters.IsUnknown()) {
 		parameters = &parametersModel{}
@@ -471,7 +510,7 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	// Generate API request body from model
-	payload, err := toUpdatePayload(&model, parameters)
+	payload, err := toUpdatePayload(&model, parameters, stateName)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -479,13 +518,13 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	// Update existing instance
 	err = r.client.PartialUpdateInstance(ctx, projectId, instanceId).PartialUpdateInstancePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Calling API: %v", err))
+		utils.LogUpdateErrorWithRenameFallback(ctx, &resp.Diagnostics, resp.Private, err, nameChanged, "Error updating instance")
 		return
 	}
 
 	ctx = core.LogResponse(ctx)
 
-	waitResp, err := wait.PartialUpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandler(wait.PartialUpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId), r.providerData.Wait, core.WaitUpdate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
 		return
@@ -532,7 +571,7 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
 		return
@@ -543,12 +582,13 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,instance_id
 func (r *instanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing instance",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[instance_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[instance_id]  Got: %q", importId),
 		)
 		return
 	}
@@ -695,6 +735,10 @@ func mapParameters(params map[string]interface{}) (types.Object, error) {
 		attributes[attribute] = value
 	}
 
+	// The API only returns the comma separated sgw_acl, the ACL is derived from it
+	sgwAcl, _ := attributes["sgw_acl"].(types.String)
+	attributes["acl"] = conversion.CommaSeparatedToStringList(sgwAcl)
+
 	output, diags := types.ObjectValue(parametersTypes, attributes)
 	if diags.HasError() {
 		return types.ObjectNull(parametersTypes), fmt.Errorf("failed to create object: %w", core.DiagsToError(diags))
@@ -719,7 +763,8 @@ func toCreatePayload(model *Model, parameters *parametersModel) (*rabbitmq.Creat
 	}, nil
 }
 
-func toUpdatePayload(model *Model, parameters *parametersModel) (*rabbitmq.PartialUpdateInstancePayload, error) {
+// toUpdatePayload only contains the name if it differs from the name in the state.
+func toUpdatePayload(model *Model, parameters *parametersModel, stateName types.String) (*rabbitmq.PartialUpdateInstancePayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
 	}
@@ -729,10 +774,15 @@ func toUpdatePayload(model *Model, parameters *parametersModel) (*rabbitmq.Parti
 		return nil, fmt.Errorf("converting parameters: %w", err)
 	}
 
-	return &rabbitmq.PartialUpdateInstancePayload{
+	payload := &rabbitmq.PartialUpdateInstancePayload{
 		Parameters: payloadParams,
 		PlanId:     conversion.StringValueToPointer(model.PlanId),
-	}, nil
+	}
+	// Only send the name if it changed, so updates of other fields don't fail if the instance can't be renamed
+	if !model.Name.Equal(stateName) {
+		payload.InstanceName = conversion.StringValueToPointer(model.Name)
+	}
+	return payload, nil
 }
 
 func toInstanceParams(parameters *parametersModel) (*rabbitmq.InstanceParameters, error) {
@@ -742,6 +792,13 @@ func toInstanceParams(parameters *parametersModel) (*rabbitmq.InstanceParameters
 	payloadParams := &rabbitmq.InstanceParameters{}
 
 	payloadParams.SgwAcl = conversion.StringValueToPointer(parameters.SgwAcl)
+	acl, err := conversion.StringListToCommaSeparatedPointer(parameters.ACL)
+	if err != nil {
+		return nil, fmt.Errorf("convert acl: %w", err)
+	}
+	if acl != nil {
+		payloadParams.SgwAcl = acl
+	}
 	payloadParams.ConsumerTimeout = conversion.Int64ValueToPointer(parameters.ConsumerTimeout)
 	payloadParams.EnableMonitoring = conversion.BoolValueToPointer(parameters.EnableMonitoring)
 	payloadParams.Graphite = conversion.StringValueToPointer(parameters.Graphite)
@@ -751,7 +808,6 @@ func toInstanceParams(parameters *parametersModel) (*rabbitmq.InstanceParameters
 	payloadParams.MonitoringInstanceId = conversion.StringValueToPointer(parameters.MonitoringInstanceId)
 	payloadParams.TlsProtocols = rabbitmq.InstanceParametersGetTlsProtocolsAttributeType(conversion.StringValueToPointer(parameters.TlsProtocols))
 
-	var err error
 	payloadParams.Plugins, err = conversion.StringListToPointer(parameters.Plugins)
 	if err != nil {
 		return nil, fmt.Errorf("converting plugins: %w", err)
@@ -69,6 +69,7 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	}
 
 	parametersDescriptions := map[string]string{
+		"acl":                     "List of IP networks in CIDR notation which are allowed to access this instance.",
 		"sgw_acl":                 "Comma separated list of IP networks in CIDR notation which are allowed to access this instance.",
 		"down_after_milliseconds": "The number of milliseconds after which the instance is considered down.",
 		"enable_monitoring":       "Enable monitoring.",
@@ -134,6 +135,11 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			},
 			"parameters": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
+					"acl": schema.ListAttribute{
+						Description: parametersDescriptions["acl"],
+						ElementType: types.StringType,
+						Computed:    true,
+					},
 					"sgw_acl": schema.StringAttribute{
 						Description: parametersDescriptions["sgw_acl"],
 						Computed:    true,
@@ -13,7 +13,10 @@ import (
 )
 
 var fixtureModelParameters = types.ObjectValueMust(parametersTypes, map[string]attr.Value{
-	"sgw_acl":                 types.StringValue("acl"),
+	"sgw_acl": types.StringValue("acl"),
+	"acl": types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("acl"),
+	}),
 	"down_after_milliseconds": types.Int64Value(10),
 	"enable_monitoring":       types.BoolValue(true),
 	"failover_timeout":        types.Int64Value(10),
@@ -68,6 +71,22 @@ var fixtureInstanceParameters = redis.InstanceParameters{
 	TlsProtocols:          redis.INSTANCEPARAMETERSTLS_PROTOCOLS__2.Ptr(),
 }
 
+func fixtureACLModelParameters() types.Object {
+	attributes := fixtureModelParameters.Attributes()
+	attributes["acl"] = types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("10.0.0.0/8"),
+		types.StringValue("192.168.0.0/24"),
+	})
+	attributes["sgw_acl"] = types.StringUnknown()
+	return types.ObjectValueMust(parametersTypes, attributes)
+}
+
+func fixtureACLInstanceParameters() *redis.InstanceParameters {
+	parameters := fixtureInstanceParameters
+	parameters.SgwAcl = utils.Ptr("10.0.0.0/8,192.168.0.0/24")
+	return &parameters
+}
+
 func TestMapFields(t *testing.T) {
 	tests := []struct {
 		description string
@@ -227,6 +246,16 @@ func TestToCreatePayload(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"acl",
+			&Model{
+				Parameters: fixtureACLModelParameters(),
+			},
+			&redis.CreateInstancePayload{
+				Parameters: fixtureACLInstanceParameters(),
+			},
+			true,
+		},
 		{
 			"null_fields_and_int_conversions",
 			&Model{
@@ -355,7 +384,11 @@ func TestToUpdatePayload(t *testing.T) {
 					}
 				}
 			}
-			output, err := toUpdatePayload(tt.input, parameters)
+			stateName := types.StringNull()
+			if tt.input != nil {
+				stateName = tt.input.Name
+			}
+			output, err := toUpdatePayload(tt.input, parameters, stateName)
 			if !tt.isValid && err == nil {
 				t.Fatalf("Should have failed")
 			}
@@ -371,3 +404,48 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestToUpdatePayloadName(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		stateName   types.String
+		expected    *redis.PartialUpdateInstancePayload
+	}{
+		{
+			"name_changed",
+			&Model{
+				Name:   types.StringValue("new-name"),
+				PlanId: types.StringValue("plan"),
+			},
+			types.StringValue("name"),
+			&redis.PartialUpdateInstancePayload{
+				InstanceName: utils.Ptr("new-name"),
+				PlanId:       utils.Ptr("plan"),
+			},
+		},
+		{
+			"name_unchanged",
+			&Model{
+				Name:   types.StringValue("name"),
+				PlanId: types.StringValue("plan"),
+			},
+			types.StringValue("name"),
+			&redis.PartialUpdateInstancePayload{
+				PlanId: utils.Ptr("plan"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toUpdatePayload(tt.input, nil, tt.stateName)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	resourcemanagerUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/resourcemanager/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
@@ -36,8 +37,11 @@ var (
 	_ resource.Resource                = &folderResource{}
 	_ resource.ResourceWithConfigure   = &folderResource{}
 	_ resource.ResourceWithImportState = &folderResource{}
+	_ resource.ResourceWithIdentity    = &folderResource{}
 )
 
+var resourceIdentity = utils.Identity{"container_id"}
+
 const (
 	projectOwnerRole = "owner"
 )
@@ -65,7 +69,8 @@ func NewFolderResource() resource.Resource {
 
 // folderResource is the resource implementation.
 type folderResource struct {
-	client *resourcemanager.APIClient
+	client       *resourcemanager.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -73,6 +78,11 @@ func (r *folderResource) Metadata(_ context.Context, req resource.MetadataReques
 	resp.TypeName = req.ProviderTypeName + "_resourcemanager_folder"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *folderResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *folderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
@@ -85,6 +95,7 @@ func (r *folderResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 	r.client = apiClient
+	r.providerData = providerData
 	tflog.Info(ctx, "Resource Manager client configured")
 }
 
@@ -183,6 +194,8 @@ func (r *folderResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *folderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	tflog.Info(ctx, "creating folder")
 	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
@@ -190,6 +203,7 @@ func (r *folderResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 
 	ctx = core.InitProviderContext(ctx)
 
@@ -204,6 +218,7 @@ func (r *folderResource) Create(ctx context.Context, req resource.CreateRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating folder", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
+	payload.Labels = r.providerData.MergeDefaultStringLabels(payload.Labels)
 
 	folderCreateResp, err := r.client.CreateFolder(ctx).CreateFolderPayload(*payload).Execute()
 	if err != nil {
@@ -232,6 +247,7 @@ func (r *folderResource) Create(ctx context.Context, req resource.CreateRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "API response processing error", err.Error())
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 	tflog.Info(ctx, "Folder created")
@@ -239,12 +255,15 @@ func (r *folderResource) Create(ctx context.Context, req resource.CreateRequest,
 
 // Read refreshes the Terraform state with the latest data.
 func (r *folderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model ResourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 
 	ctx = core.InitProviderContext(ctx)
 
@@ -257,7 +276,7 @@ func (r *folderResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && oapiErr.StatusCode == http.StatusForbidden {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_resourcemanager_folder", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading folder", fmt.Sprintf("Calling API: %v", err))
@@ -271,6 +290,7 @@ func (r *folderResource) Read(ctx context.Context, req resource.ReadRequest, res
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading folder", fmt.Sprintf("Processing API response: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 
 	// Set refreshed model
 	diags = resp.State.Set(ctx, model)
@@ -283,6 +303,8 @@ func (r *folderResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *folderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	// Retrieve values from plan
 	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
@@ -290,6 +312,7 @@ func (r *folderResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	priorLabels := model.Labels
 
 	ctx = core.InitProviderContext(ctx)
 
@@ -302,6 +325,7 @@ func (r *folderResource) Update(ctx context.Context, req resource.UpdateRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating folder", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
+	payload.Labels = r.providerData.MergeDefaultStringLabels(payload.Labels)
 	// Update existing folder
 	_, err = r.client.PartialUpdateFolder(ctx, containerId).PartialUpdateFolderPayload(*payload).Execute()
 	if err != nil {
@@ -323,6 +347,7 @@ func (r *folderResource) Update(ctx context.Context, req resource.UpdateRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating folder", fmt.Sprintf("Processing API response: %v", err))
 		return
 	}
+	model.Labels = r.providerData.RemoveDefaultLabels(model.Labels, priorLabels)
 
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -367,18 +392,19 @@ func (r *folderResource) Delete(ctx context.Context, req resource.DeleteRequest,
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: container_id
 func (r *folderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 	if len(idParts) != 1 || idParts[0] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing folder",
-			fmt.Sprintf("Expected import identifier with format: [container_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [container_id]  Got: %q", importId),
 		)
 		return
 	}
 
-	ctx = tflog.SetField(ctx, "container_id", req.ID)
+	ctx = tflog.SetField(ctx, "container_id", importId)
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("container_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("container_id"), importId)...)
 	tflog.Info(ctx, "Resource Manager folder state imported")
 }
 
@@ -413,8 +439,12 @@ func mapFolderFields(
 
 	var err error
 	var tfLabels basetypes.MapValue
-	if folderGetResponse.Labels != nil && len(*folderGetResponse.Labels) > 0 {
-		tfLabels, err = conversion.ToTerraformStringMap(ctx, *folderGetResponse.Labels)
+	var respLabels map[string]string
+	if folderGetResponse.Labels != nil {
+		respLabels = utils.RemoveSystemLabels(*folderGetResponse.Labels, model.Labels, resourcemanagerUtils.SystemLabelPrefixes)
+	}
+	if len(respLabels) != 0 {
+		tfLabels, err = conversion.ToTerraformStringMap(ctx, respLabels)
 		if err != nil {
 			return fmt.Errorf("converting to StringValue map: %w", err)
 		}
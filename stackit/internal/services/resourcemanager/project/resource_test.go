@@ -115,6 +115,33 @@ func TestMapProjectFields(t *testing.T) {
 			},
 			isValid: true,
 		},
+		{
+			description:           "system_labels_removed",
+			uuidContainerParentId: false,
+			projectResp: &resourcemanager.GetProjectResponse{
+				ContainerId: utils.Ptr("cid"),
+				ProjectId:   utils.Ptr("pid"),
+				Labels: &map[string]string{
+					"label1":               "ref1",
+					"stackit.cloud/system": "value",
+				},
+				CreationTime: &createTime,
+				UpdateTime:   &updateTime,
+			},
+			expected: Model{
+				Id:                types.StringValue("cid"),
+				ContainerId:       types.StringValue("cid"),
+				ProjectId:         types.StringValue("pid"),
+				ContainerParentId: types.StringNull(),
+				Name:              types.StringNull(),
+				CreationTime:      types.StringValue(createTime.Format(time.RFC3339)),
+				UpdateTime:        types.StringValue(updateTime.Format(time.RFC3339)),
+			},
+			expectedLabels: &map[string]string{
+				"label1": "ref1",
+			},
+			isValid: true,
+		},
 		{
 			description:           "response_nil_fail",
 			uuidContainerParentId: false,
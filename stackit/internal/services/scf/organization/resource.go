@@ -33,9 +33,12 @@ var (
 	_ resource.Resource                = &scfOrganizationResource{}
 	_ resource.ResourceWithConfigure   = &scfOrganizationResource{}
 	_ resource.ResourceWithImportState = &scfOrganizationResource{}
+	_ resource.ResourceWithIdentity    = &scfOrganizationResource{}
 	_ resource.ResourceWithModifyPlan  = &scfOrganizationResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "org_id"}
+
 type Model struct {
 	Id         types.String `tfsdk:"id"` // Required by Terraform
 	CreateAt   types.String `tfsdk:"created_at"`
@@ -95,6 +98,11 @@ func (s *scfOrganizationResource) Metadata(_ context.Context, request resource.M
 	response.TypeName = request.ProviderTypeName + "_scf_organization"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (s *scfOrganizationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan.
 // Use the modifier to set the effective region in the current plan.
 func (r *scfOrganizationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
@@ -114,7 +122,7 @@ func (r *scfOrganizationResource) ModifyPlan(ctx context.Context, req resource.M
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -224,6 +232,8 @@ func (s *scfOrganizationResource) Schema(_ context.Context, _ resource.SchemaReq
 }
 
 func (s *scfOrganizationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, response.Identity, &response.Diagnostics, &response.State)
+
 	// Retrieve the planned values for the resource.
 	var model Model
 	diags := request.Plan.Get(ctx, &model)
@@ -311,6 +321,8 @@ func (s *scfOrganizationResource) Create(ctx context.Context, request resource.C
 
 // Read refreshes the Terraform state with the latest scf organization data.
 func (s *scfOrganizationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, response.Identity, &response.Diagnostics, &response.State, &request.State)
+
 	// Retrieve the current state of the resource.
 	var model Model
 	diags := request.State.Get(ctx, &model)
@@ -335,7 +347,7 @@ func (s *scfOrganizationResource) Read(ctx context.Context, request resource.Rea
 		var oapiErr *oapierror.GenericOpenAPIError
 		ok := errors.As(err, &oapiErr)
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			response.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, response, "stackit_scf_organization", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &response.Diagnostics, "Error reading scf organization", fmt.Sprintf("Calling API: %v", err))
@@ -358,6 +370,8 @@ func (s *scfOrganizationResource) Read(ctx context.Context, request resource.Rea
 
 // Update attempts to update the resource.
 func (s *scfOrganizationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, response.Identity, &response.Diagnostics, &response.State, &request.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := request.Plan.Get(ctx, &model)
@@ -459,7 +473,7 @@ func (s *scfOrganizationResource) Delete(ctx context.Context, request resource.D
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = wait.DeleteOrganizationWaitHandler(ctx, s.client, projectId, model.Region.ValueString(), orgId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.DeleteOrganizationWaitHandler(ctx, s.client, projectId, model.Region.ValueString(), orgId), s.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &response.Diagnostics, "Error waiting for scf org deletion", fmt.Sprintf("SCFOrganization deleting waiting: %v", err))
 		return
@@ -469,14 +483,15 @@ func (s *scfOrganizationResource) Delete(ctx context.Context, request resource.D
 }
 
 func (s *scfOrganizationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	importId := resourceIdentity.ImportID(ctx, request, &response.Diagnostics)
 	// Split the import identifier to extract project ID and email.
-	idParts := strings.Split(request.ID, core.Separator)
+	idParts := strings.Split(importId, core.Separator)
 
 	// Ensure the import identifier format is correct.
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &response.Diagnostics,
 			"Error importing scf organization",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[org_id]  Got: %q", request.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[org_id]  Got: %q", importId),
 		)
 		return
 	}
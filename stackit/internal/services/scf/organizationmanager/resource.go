@@ -31,9 +31,12 @@ var (
 	_ resource.Resource                = &scfOrganizationManagerResource{}
 	_ resource.ResourceWithConfigure   = &scfOrganizationManagerResource{}
 	_ resource.ResourceWithImportState = &scfOrganizationManagerResource{}
+	_ resource.ResourceWithIdentity    = &scfOrganizationManagerResource{}
 	_ resource.ResourceWithModifyPlan  = &scfOrganizationManagerResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "org_id", "user_id"}
+
 type Model struct {
 	Id         types.String `tfsdk:"id"` // Required by Terraform
 	Region     types.String `tfsdk:"region"`
@@ -91,6 +94,11 @@ func (s *scfOrganizationManagerResource) Metadata(_ context.Context, request res
 	response.TypeName = request.ProviderTypeName + "_scf_organization_manager"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (s *scfOrganizationManagerResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan.
 // Use the modifier to set the effective region in the current plan.
 func (r *scfOrganizationManagerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
@@ -110,7 +118,7 @@ func (r *scfOrganizationManagerResource) ModifyPlan(ctx context.Context, req res
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -206,6 +214,8 @@ func (s *scfOrganizationManagerResource) Schema(_ context.Context, _ resource.Sc
 }
 
 func (s *scfOrganizationManagerResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, response.Identity, &response.Diagnostics, &response.State)
+
 	// Retrieve the planned values for the resource.
 	var model Model
 	diags := request.Plan.Get(ctx, &model)
@@ -255,6 +265,8 @@ func (s *scfOrganizationManagerResource) Create(ctx context.Context, request res
 }
 
 func (s *scfOrganizationManagerResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, response.Identity, &response.Diagnostics, &response.State, &request.State)
+
 	// Retrieve the current state of the resource.
 	var model Model
 	diags := request.State.Get(ctx, &model)
@@ -279,8 +291,7 @@ func (s *scfOrganizationManagerResource) Read(ctx context.Context, request resou
 		var oapiErr *oapierror.GenericOpenAPIError
 		ok := errors.As(err, &oapiErr)
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			core.LogAndAddWarning(ctx, &response.Diagnostics, "SCF Organization manager not found", "SCF Organization manager not found, remove from state")
-			response.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, response, "stackit_scf_organization_manager", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &response.Diagnostics, "Error reading scf organization manager", fmt.Sprintf("Calling API: %v", err))
@@ -343,14 +354,15 @@ func (s *scfOrganizationManagerResource) Delete(ctx context.Context, request res
 }
 
 func (s *scfOrganizationManagerResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) { // nolint:gocritic // function signature required by Terraform
+	importId := resourceIdentity.ImportID(ctx, request, &response.Diagnostics)
 	// Split the import identifier to extract project ID, region org ID and user ID.
-	idParts := strings.Split(request.ID, core.Separator)
+	idParts := strings.Split(importId, core.Separator)
 
 	// Ensure the import identifier format is correct.
 	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
 		core.LogAndAddError(ctx, &response.Diagnostics,
 			"Error importing scf organization manager",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[org_id],[user_id]  Got: %q", request.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[org_id],[user_id]  Got: %q", importId),
 		)
 		return
 	}
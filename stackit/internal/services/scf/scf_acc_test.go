@@ -27,7 +27,7 @@ var resourceMin string
 //go:embed testdata/resource-max.tf
 var resourceMax string
 
-var randName = acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+var randName = testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
 var nameMin = fmt.Sprintf("scf-min-%s-org", randName)
 var nameMinUpdated = fmt.Sprintf("scf-min-%s-upd-org", randName)
 var nameMax = fmt.Sprintf("scf-max-%s-org", randName)
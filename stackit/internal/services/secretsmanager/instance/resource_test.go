@@ -177,6 +177,55 @@ func TestToCreatePayload(t *testing.T) {
 	}
 }
 
+func TestToUpdatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *secretsmanager.UpdateInstancePayload
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&Model{},
+			&secretsmanager.UpdateInstancePayload{},
+			true,
+		},
+		{
+			"simple_values",
+			&Model{
+				Name: types.StringValue("name"),
+			},
+			&secretsmanager.UpdateInstancePayload{
+				Name: utils.Ptr("name"),
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toUpdatePayload(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
 func TestUpdateACLs(t *testing.T) {
 	// This is the response used when getting all ACLs currently, across all tests
 	getAllACLsResp := secretsmanager.ListACLsResponse{
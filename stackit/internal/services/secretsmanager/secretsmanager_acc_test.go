@@ -30,15 +30,15 @@ var (
 
 var testConfigVarsMin = config.Variables{
 	"project_id":       config.StringVariable(testutil.ProjectId),
-	"instance_name":    config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
-	"user_description": config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"instance_name":    config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"user_description": config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
 	"write_enabled":    config.BoolVariable(true),
 }
 
 var testConfigVarsMax = config.Variables{
 	"project_id":       config.StringVariable(testutil.ProjectId),
-	"instance_name":    config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
-	"user_description": config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"instance_name":    config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"user_description": config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
 	"acl1":             config.StringVariable("10.100.0.0/24"),
 	"acl2":             config.StringVariable("10.100.1.0/24"),
 	"write_enabled":    config.BoolVariable(true),
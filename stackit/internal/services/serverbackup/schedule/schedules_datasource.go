@@ -79,7 +79,7 @@ func (r *schedulesDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 		MarkdownDescription: features.AddBetaDescription("Server backup schedules datasource schema. Must have a `region` specified in the provider configuration.", core.Datasource),
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Terraform's internal data source identifier. It is structured as \"`project_id`,`server_id`\".",
+				Description: "Terraform's internal data source identifier. It is structured as \"`project_id`,`region`,`server_id`\".",
 				Computed:    true,
 			},
 			"project_id": schema.StringAttribute{
@@ -32,20 +32,20 @@ var (
 var testConfigVarsMin = config.Variables{
 	"project_id":       config.StringVariable(testutil.ProjectId),
 	"server_id":        config.StringVariable(testutil.ServerId),
-	"schedule_name":    config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"schedule_name":    config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
 	"rrule":            config.StringVariable("DTSTART;TZID=Europe/Sofia:20200803T023000 RRULE:FREQ=DAILY;INTERVAL=1"),
 	"enabled":          config.BoolVariable(true),
-	"backup_name":      config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"backup_name":      config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
 	"retention_period": config.IntegerVariable(14),
 }
 
 var testConfigVarsMax = config.Variables{
 	"project_id":       config.StringVariable(testutil.ProjectId),
 	"server_id":        config.StringVariable(testutil.ServerId),
-	"schedule_name":    config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"schedule_name":    config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
 	"rrule":            config.StringVariable("DTSTART;TZID=Europe/Sofia:20200803T023000 RRULE:FREQ=DAILY;INTERVAL=1"),
 	"enabled":          config.BoolVariable(true),
-	"backup_name":      config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"backup_name":      config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
 	"retention_period": config.IntegerVariable(14),
 	"region":           config.StringVariable("eu01"),
 }
@@ -32,8 +32,8 @@ var (
 
 var testConfigVarsMin = config.Variables{
 	"project_id":         config.StringVariable(testutil.ProjectId),
-	"server_name":        config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
-	"schedule_name":      config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"server_name":        config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"schedule_name":      config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
 	"rrule":              config.StringVariable("DTSTART;TZID=Europe/Sofia:20200803T023000 RRULE:FREQ=DAILY;INTERVAL=1"),
 	"enabled":            config.BoolVariable(true),
 	"maintenance_window": config.IntegerVariable(1),
@@ -42,8 +42,8 @@ var testConfigVarsMin = config.Variables{
 
 var testConfigVarsMax = config.Variables{
 	"project_id":         config.StringVariable(testutil.ProjectId),
-	"server_name":        config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
-	"schedule_name":      config.StringVariable("tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"server_name":        config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
+	"schedule_name":      config.StringVariable("tf-acc-" + testutil.RandStringFromCharSet(8, acctest.CharSetAlpha)),
 	"rrule":              config.StringVariable("DTSTART;TZID=Europe/Sofia:20200803T023000 RRULE:FREQ=DAILY;INTERVAL=1"),
 	"enabled":            config.BoolVariable(true),
 	"maintenance_window": config.IntegerVariable(1),
@@ -31,8 +31,11 @@ var (
 	_ resource.Resource                = &serviceAccountResource{}
 	_ resource.ResourceWithConfigure   = &serviceAccountResource{}
 	_ resource.ResourceWithImportState = &serviceAccountResource{}
+	_ resource.ResourceWithIdentity    = &serviceAccountResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "email"}
+
 // Model represents the schema for the service account resource.
 type Model struct {
 	Id        types.String `tfsdk:"id"`         // Required by Terraform
@@ -71,6 +74,11 @@ func (r *serviceAccountResource) Metadata(_ context.Context, req resource.Metada
 	resp.TypeName = req.ProviderTypeName + "_service_account"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *serviceAccountResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Schema defines the schema for the resource.
 func (r *serviceAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	descriptions := map[string]string{
@@ -120,6 +128,8 @@ func (r *serviceAccountResource) Schema(_ context.Context, _ resource.SchemaRequ
 
 // Create creates the resource and sets the initial Terraform state for service accounts.
 func (r *serviceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve the planned values for the resource.
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -174,6 +184,8 @@ func (r *serviceAccountResource) Create(ctx context.Context, req resource.Create
 
 // Read refreshes the Terraform state with the latest service account data.
 func (r *serviceAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	// Retrieve the current state of the resource.
 	var model Model
 	diags := req.State.Get(ctx, &model)
@@ -217,7 +229,7 @@ func (r *serviceAccountResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// If no matching service account is found, remove the resource from the state.
-	resp.State.RemoveResource(ctx)
+	core.RemoveFromStateWithWarning(ctx, resp, "stackit_service_account", model.Id.ValueString())
 }
 
 // Update attempts to update the resource. In this case, service accounts cannot be updated.
@@ -264,14 +276,15 @@ func (r *serviceAccountResource) Delete(ctx context.Context, req resource.Delete
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,email
 func (r *serviceAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
 	// Split the import identifier to extract project ID and email.
-	idParts := strings.Split(req.ID, core.Separator)
+	idParts := strings.Split(importId, core.Separator)
 
 	// Ensure the import identifier format is correct.
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing service account",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[email]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[email]  Got: %q", importId),
 		)
 		return
 	}
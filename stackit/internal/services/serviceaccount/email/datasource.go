@@ -0,0 +1,168 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/serviceaccount"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	serviceaccountUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serviceaccount/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &projectServiceAccountEmailDataSource{}
+)
+
+type Model struct {
+	Id        types.String `tfsdk:"id"` // needed by TF
+	ProjectId types.String `tfsdk:"project_id"`
+	Emails    types.List   `tfsdk:"emails"`
+}
+
+// NewProjectServiceAccountEmailDataSource is a helper function to simplify the provider implementation.
+func NewProjectServiceAccountEmailDataSource() datasource.DataSource {
+	return &projectServiceAccountEmailDataSource{}
+}
+
+// projectServiceAccountEmailDataSource is the data source implementation.
+type projectServiceAccountEmailDataSource struct {
+	client *serviceaccount.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *projectServiceAccountEmailDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_service_account_email"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *projectServiceAccountEmailDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := serviceaccountUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "Service Account client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *projectServiceAccountEmailDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Emails of the service accounts of a project. Use them to grant the service accounts access to other resources, without hardcoding their emails."
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`project_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the service accounts are associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"emails": schema.ListAttribute{
+				Description: "Emails of the service accounts of the project, sorted alphabetically.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectServiceAccountEmailDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	listSaResp, err := d.client.ListServiceAccounts(ctx, projectId).Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading service account emails",
+			fmt.Sprintf("Service accounts of project %q cannot be listed.", projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapFields(listSaResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading service account emails", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Service account emails read")
+}
+
+func mapFields(listSaResp *serviceaccount.ListServiceAccountsResponse, model *Model) error {
+	if listSaResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	emails := []string{}
+	for _, serviceAccount := range listSaResp.GetItems() {
+		if serviceAccount.Email == nil {
+			continue
+		}
+		emails = append(emails, *serviceAccount.Email)
+	}
+	sort.Strings(emails)
+
+	emailsTF := make([]attr.Value, 0, len(emails))
+	for _, email := range emails {
+		emailsTF = append(emailsTF, types.StringValue(email))
+	}
+	emailsList, diags := types.ListValue(types.StringType, emailsTF)
+	if diags.HasError() {
+		return fmt.Errorf("mapping emails: %w", core.DiagsToError(diags))
+	}
+
+	model.Id = types.StringValue(model.ProjectId.ValueString())
+	model.Emails = emailsList
+	return nil
+}
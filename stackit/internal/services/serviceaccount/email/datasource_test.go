@@ -0,0 +1,76 @@
+package email
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/serviceaccount"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *serviceaccount.ListServiceAccountsResponse
+		expected    Model
+		isValid     bool
+	}{
+		{
+			description: "default_values",
+			input:       &serviceaccount.ListServiceAccountsResponse{},
+			expected: Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Emails:    types.ListValueMust(types.StringType, []attr.Value{}),
+			},
+			isValid: true,
+		},
+		{
+			description: "sorted_emails",
+			input: &serviceaccount.ListServiceAccountsResponse{
+				Items: &[]serviceaccount.ServiceAccount{
+					{Email: utils.Ptr("sa02-xxxxxxx@sa.stackit.cloud")},
+					{Email: nil},
+					{Email: utils.Ptr("sa01-xxxxxxx@sa.stackit.cloud")},
+				},
+			},
+			expected: Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Emails: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("sa01-xxxxxxx@sa.stackit.cloud"),
+					types.StringValue("sa02-xxxxxxx@sa.stackit.cloud"),
+				}),
+			},
+			isValid: true,
+		},
+		{
+			description: "nil_response",
+			input:       nil,
+			expected:    Model{},
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				ProjectId: tt.expected.ProjectId,
+			}
+			err := mapFields(tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
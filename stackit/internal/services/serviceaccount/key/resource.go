@@ -229,7 +229,7 @@ func (r *serviceAccountKeyResource) Read(ctx context.Context, req resource.ReadR
 		ok := errors.As(err, &oapiErr)
 		// due to security purposes, attempting to get access key for a non-existent Service Account will return 403.
 		if ok && oapiErr.StatusCode == http.StatusNotFound || oapiErr.StatusCode == http.StatusForbidden || oapiErr.StatusCode == http.StatusBadRequest {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_service_account_key", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading service account key", fmt.Sprintf("Calling API: %v", err))
@@ -27,6 +27,7 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/services/serviceaccount"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
@@ -36,6 +37,18 @@ var (
 	_ resource.ResourceWithConfigure = &serviceAccountTokenResource{}
 )
 
+var deprecation = &features.Deprecation{
+	RemovalDate:    "December 17, 2025",
+	Replacement:    "stackit_service_account_key",
+	MigrationGuide: "https://docs.stackit.cloud/platform/access-and-identity/service-accounts/migrate-flows/",
+	MigrationSnippet: `
+resource "stackit_service_account_key" "sa_key" {
+  project_id            = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  service_account_email = stackit_service_account.sa.email
+  ttl_days              = 90
+}`,
+}
+
 // Model represents the schema for the service account token resource in Terraform.
 type Model struct {
 	Id                  types.String `tfsdk:"id"`
@@ -67,6 +80,8 @@ func (r *serviceAccountTokenResource) Configure(ctx context.Context, req resourc
 		return
 	}
 
+	features.CheckDeprecation(ctx, &resp.Diagnostics, "stackit_service_account_access_token", core.Resource, deprecation)
+
 	apiClient := serviceaccountUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -85,7 +100,6 @@ func (r *serviceAccountTokenResource) Schema(_ context.Context, _ resource.Schem
 	descriptions := map[string]string{
 		"id":                    "Terraform's internal resource identifier. It is structured as \"`project_id`,`service_account_email`,`access_token_id`\".",
 		"main":                  "Service account access token schema.",
-		"deprecation_message":   "This resource is scheduled for deprecation and will be removed on December 17, 2025. To ensure a smooth transition, please refer to our migration guide at https://docs.stackit.cloud/platform/access-and-identity/service-accounts/migrate-flows/ for detailed instructions and recommendations.",
 		"project_id":            "STACKIT project ID associated with the service account token.",
 		"service_account_email": "Email address linked to the service account.",
 		"ttl_days":              "Specifies the token's validity duration in days. If unspecified, defaults to 90 days.",
@@ -95,9 +109,10 @@ func (r *serviceAccountTokenResource) Schema(_ context.Context, _ resource.Schem
 		"active":                "Indicate whether the token is currently active or inactive",
 		"created_at":            "Timestamp indicating when the access token was created.",
 		"valid_until":           "Estimated expiration timestamp of the access token. For precise validity, check the JWT details.",
+		"deprecation_message":   deprecation.Message("stackit_service_account_access_token", core.Resource),
 	}
 	resp.Schema = schema.Schema{
-		MarkdownDescription: fmt.Sprintf("%s\n\n!> %s\n%s", descriptions["main"], descriptions["deprecation_message"], markdownDescription),
+		MarkdownDescription: fmt.Sprintf("%s\n%s", features.AddDeprecationDescription(descriptions["main"], "stackit_service_account_access_token", core.Resource, deprecation), markdownDescription),
 		Description:         descriptions["main"],
 		DeprecationMessage:  descriptions["deprecation_message"],
 		Attributes: map[string]schema.Attribute{
@@ -243,7 +258,7 @@ func (r *serviceAccountTokenResource) Read(ctx context.Context, req resource.Rea
 		ok := errors.As(err, &oapiErr) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		// due to security purposes, attempting to list access tokens for a non-existent Service Account will return 403.
 		if ok && oapiErr.StatusCode == http.StatusNotFound || oapiErr.StatusCode == http.StatusForbidden {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_service_account_access_token", model.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading service account tokens", fmt.Sprintf("Error calling API: %v", err))
@@ -261,7 +276,7 @@ func (r *serviceAccountTokenResource) Read(ctx context.Context, req resource.Rea
 
 		if !*saTokens[i].Active {
 			tflog.Info(ctx, fmt.Sprintf("Service account access token with id %s is not active", model.AccessTokenId.ValueString()))
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_service_account_access_token", model.Id.ValueString())
 			return
 		}
 
@@ -278,7 +293,7 @@ func (r *serviceAccountTokenResource) Read(ctx context.Context, req resource.Rea
 	}
 	// If no matching service account access token is found, remove the resource from the state.
 	tflog.Info(ctx, fmt.Sprintf("Service account access token with id %s not found", model.AccessTokenId.ValueString()))
-	resp.State.RemoveResource(ctx)
+	core.RemoveFromStateWithWarning(ctx, resp, "stackit_service_account_access_token", model.Id.ValueString())
 }
 
 // Update attempts to update the resource. In this case, service account token cannot be updated.
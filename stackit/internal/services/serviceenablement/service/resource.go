@@ -0,0 +1,399 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/serviceenablement"
+	"github.com/stackitcloud/stackit-sdk-go/services/serviceenablement/wait"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	serviceEnablementUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serviceenablement/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &serviceEnablementResource{}
+	_ resource.ResourceWithConfigure   = &serviceEnablementResource{}
+	_ resource.ResourceWithImportState = &serviceEnablementResource{}
+	_ resource.ResourceWithIdentity    = &serviceEnablementResource{}
+	_ resource.ResourceWithModifyPlan  = &serviceEnablementResource{}
+)
+
+var resourceIdentity = utils.Identity{"project_id", "region", "service_id"}
+
+type Model struct {
+	Id               types.String `tfsdk:"id"` // needed by TF
+	ProjectId        types.String `tfsdk:"project_id"`
+	Region           types.String `tfsdk:"region"`
+	ServiceId        types.String `tfsdk:"service_id"`
+	State            types.String `tfsdk:"state"`
+	DisableOnDestroy types.Bool   `tfsdk:"disable_on_destroy"`
+}
+
+// NewServiceEnablementResource is a helper function to simplify the provider implementation.
+func NewServiceEnablementResource() resource.Resource {
+	return &serviceEnablementResource{}
+}
+
+// serviceEnablementResource is the resource implementation.
+type serviceEnablementResource struct {
+	client       *serviceenablement.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *serviceEnablementResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_enablement"
+}
+
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *serviceEnablementResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *serviceEnablementResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.client = serviceEnablementUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Service Enablement client configured")
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *serviceEnablementResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *serviceEnablementResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := fmt.Sprintf("Service enablement resource schema. Enables a STACKIT service in a project, so that other resources can depend on it. %s", core.ResourceRegionFallbackDocstring)
+
+	resp.Schema = schema.Schema{
+		Description:         description,
+		MarkdownDescription: fmt.Sprintf("%s\n\n~> By default, the service is **not** disabled during a `terraform destroy`, since other resources, which aren't managed by Terraform, might still use it. Set `disable_on_destroy` to disable it.", description),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`service_id`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID in which the service is enabled.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: "The resource region. If not defined, the provider region is used.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Description: "ID of the service to enable, e.g. `cloud.stackit.ske` or `cloud.stackit.model-serving`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					validate.NoSeparator(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the service in the project.",
+				Computed:    true,
+			},
+			"disable_on_destroy": schema.BoolAttribute{
+				Description: "If set to `true`, the service is disabled in the project when the resource is destroyed. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *serviceEnablementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	serviceId := model.ServiceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "service_id", serviceId)
+
+	// Enabling is skipped if the service is already enabled
+	err := core.NewRequiredService(r.client, serviceId, fmt.Sprintf("service %q", serviceId), true, r.providerData.Wait).Enable(ctx, projectId, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error enabling service", err.Error())
+		return
+	}
+
+	status, err := r.client.GetServiceStatusRegionalExecute(ctx, region, projectId, serviceId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error enabling service", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	err = mapFields(status, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error enabling service", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Service enabled")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *serviceEnablementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	serviceId := model.ServiceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "service_id", serviceId)
+
+	status, err := r.client.GetServiceStatusRegionalExecute(ctx, region, projectId, serviceId)
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_service_enablement", model.Id.ValueString())
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading service enablement", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	// A service disabled outside of Terraform is enabled again on the next apply
+	if isDisabled(status) {
+		core.RemoveFromStateWithWarning(ctx, resp, "stackit_service_enablement", model.Id.ValueString())
+		return
+	}
+
+	err = mapFields(status, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading service enablement", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Service enablement read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *serviceEnablementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
+	// Only disable_on_destroy can be updated, it isn't known to the API
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var stateModel Model
+	diags = req.State.Get(ctx, &stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateModel.DisableOnDestroy = model.DisableOnDestroy
+
+	diags = resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Service enablement updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *serviceEnablementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.InitProviderContext(ctx)
+
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	serviceId := model.ServiceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "service_id", serviceId)
+
+	if !model.DisableOnDestroy.ValueBool() {
+		tflog.Info(ctx, "Service enablement removed from state, the service is still enabled")
+		return
+	}
+
+	err := r.client.DisableServiceRegionalExecute(ctx, region, projectId, serviceId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error disabling service", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	ctx = core.LogResponse(ctx)
+
+	_, err = core.ConfigureWaitHandler(wait.DisableServiceWaitHandler(ctx, r.client, region, projectId, serviceId), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error disabling service", fmt.Sprintf("Service disabling waiting: %v", err))
+		return
+	}
+	tflog.Info(ctx, "Service disabled")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,region,service_id
+func (r *serviceEnablementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing service enablement",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[service_id]  Got: %q", importId),
+		)
+		return
+	}
+
+	ctx = utils.SetAndLogStateFields(ctx, &resp.Diagnostics, &resp.State, map[string]any{
+		"project_id": idParts[0],
+		"region":     idParts[1],
+		"service_id": idParts[2],
+	})
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("disable_on_destroy"), false)...)
+	tflog.Info(ctx, "Service enablement state imported")
+}
+
+func isDisabled(status *serviceenablement.ServiceStatus) bool {
+	if status == nil || status.State == nil {
+		return false
+	}
+	return *status.State == serviceenablement.SERVICESTATUSSTATE_DISABLED || *status.State == serviceenablement.SERVICESTATUSSTATE_DISABLING
+}
+
+func mapFields(status *serviceenablement.ServiceStatus, model *Model, region string) error {
+	if status == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	var serviceId string
+	if model.ServiceId.ValueString() != "" {
+		serviceId = model.ServiceId.ValueString()
+	} else if status.ServiceId != nil {
+		serviceId = *status.ServiceId
+	} else {
+		return fmt.Errorf("service id not present")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, serviceId)
+	model.ServiceId = types.StringValue(serviceId)
+	model.Region = types.StringValue(region)
+	model.State = types.StringNull()
+	if status.State != nil {
+		model.State = types.StringValue(string(*status.State))
+	}
+	return nil
+}
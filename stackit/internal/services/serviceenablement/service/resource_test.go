@@ -0,0 +1,134 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/serviceenablement"
+)
+
+func TestMapFields(t *testing.T) {
+	const testRegion = "eu01"
+	tests := []struct {
+		description string
+		state       Model
+		input       *serviceenablement.ServiceStatus
+		expected    Model
+		isValid     bool
+	}{
+		{
+			description: "default_values",
+			state: Model{
+				ProjectId: types.StringValue("pid"),
+				ServiceId: types.StringValue("cloud.stackit.ske"),
+			},
+			input: &serviceenablement.ServiceStatus{},
+			expected: Model{
+				Id:        types.StringValue("pid,eu01,cloud.stackit.ske"),
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue(testRegion),
+				ServiceId: types.StringValue("cloud.stackit.ske"),
+				State:     types.StringNull(),
+			},
+			isValid: true,
+		},
+		{
+			description: "imported",
+			state: Model{
+				ProjectId:        types.StringValue("pid"),
+				DisableOnDestroy: types.BoolValue(false),
+			},
+			input: &serviceenablement.ServiceStatus{
+				ServiceId: utils.Ptr("cloud.stackit.ske"),
+				State:     serviceenablement.SERVICESTATUSSTATE_ENABLED.Ptr(),
+			},
+			expected: Model{
+				Id:               types.StringValue("pid,eu01,cloud.stackit.ske"),
+				ProjectId:        types.StringValue("pid"),
+				Region:           types.StringValue(testRegion),
+				ServiceId:        types.StringValue("cloud.stackit.ske"),
+				State:            types.StringValue("ENABLED"),
+				DisableOnDestroy: types.BoolValue(false),
+			},
+			isValid: true,
+		},
+		{
+			description: "response_nil_fail",
+			state: Model{
+				ProjectId: types.StringValue("pid"),
+				ServiceId: types.StringValue("cloud.stackit.ske"),
+			},
+			input:   nil,
+			isValid: false,
+		},
+		{
+			description: "no_service_id",
+			state: Model{
+				ProjectId: types.StringValue("pid"),
+			},
+			input:   &serviceenablement.ServiceStatus{},
+			isValid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, &tt.state, testRegion)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestIsDisabled(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *serviceenablement.ServiceStatus
+		expected    bool
+	}{
+		{
+			description: "no status",
+			input:       nil,
+			expected:    false,
+		},
+		{
+			description: "enabled",
+			input:       &serviceenablement.ServiceStatus{State: serviceenablement.SERVICESTATUSSTATE_ENABLED.Ptr()},
+			expected:    false,
+		},
+		{
+			description: "enabling",
+			input:       &serviceenablement.ServiceStatus{State: serviceenablement.SERVICESTATUSSTATE_ENABLING.Ptr()},
+			expected:    false,
+		},
+		{
+			description: "disabled",
+			input:       &serviceenablement.ServiceStatus{State: serviceenablement.SERVICESTATUSSTATE_DISABLED.Ptr()},
+			expected:    true,
+		},
+		{
+			description: "disabling",
+			input:       &serviceenablement.ServiceStatus{State: serviceenablement.SERVICESTATUSSTATE_DISABLING.Ptr()},
+			expected:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := isDisabled(tt.input)
+			if output != tt.expected {
+				t.Fatalf("expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
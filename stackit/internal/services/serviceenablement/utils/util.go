@@ -21,7 +21,9 @@ func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags
 	} else {
 		apiClientConfigOptions = append(apiClientConfigOptions, config.WithRegion(providerData.GetRegion()))
 	}
-	apiClient, err := serviceenablement.NewAPIClient(apiClientConfigOptions...)
+	apiClient, err := core.CachedClient(providerData.Clients, "serviceenablement", providerData.GetRegion(), providerData.ServiceEnablementCustomEndpoint, func() (*serviceenablement.APIClient, error) {
+		return serviceenablement.NewAPIClient(apiClientConfigOptions...)
+	})
 	if err != nil {
 		core.LogAndAddError(ctx, diags, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the resource configuration", err))
 		return nil
@@ -29,3 +31,13 @@ func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags
 
 	return apiClient
 }
+
+// ConfigureRequiredService returns the service with the given ID, which a resource depends on.
+// If autoEnable is set, the service is enabled in the project on demand.
+func ConfigureRequiredService(ctx context.Context, providerData *core.ProviderData, serviceId, displayName string, autoEnable bool, diags *diag.Diagnostics) *core.RequiredService {
+	apiClient := ConfigureClient(ctx, providerData, diags)
+	if diags.HasError() {
+		return nil
+	}
+	return core.NewRequiredService(apiClient, serviceId, displayName, autoEnable, providerData.Wait)
+}
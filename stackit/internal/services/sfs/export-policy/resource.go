@@ -38,9 +38,12 @@ var (
 	_ resource.Resource                = &exportPolicyResource{}
 	_ resource.ResourceWithConfigure   = &exportPolicyResource{}
 	_ resource.ResourceWithImportState = &exportPolicyResource{}
+	_ resource.ResourceWithIdentity    = &exportPolicyResource{}
 	_ resource.ResourceWithModifyPlan  = &exportPolicyResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "policy_id"}
+
 type Model struct {
 	Id             types.String `tfsdk:"id"` // needed by TF
 	ProjectId      types.String `tfsdk:"project_id"`
@@ -104,7 +107,7 @@ func (r *exportPolicyResource) ModifyPlan(ctx context.Context, req resource.Modi
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -120,6 +123,11 @@ func (r *exportPolicyResource) Metadata(_ context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_sfs_export_policy"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *exportPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *exportPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	var ok bool
@@ -245,6 +253,8 @@ func (r *exportPolicyResource) Schema(_ context.Context, _ resource.SchemaReques
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *exportPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { //nolint:gocritic // defined by terraform api
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -320,6 +330,8 @@ func (r *exportPolicyResource) Create(ctx context.Context, req resource.CreateRe
 
 // Read refreshes the Terraform state with the latest data.
 func (r *exportPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { //nolint:gocritic // defined by terraform api
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -341,7 +353,7 @@ func (r *exportPolicyResource) Read(ctx context.Context, req resource.ReadReques
 		var openapiError *oapierror.GenericOpenAPIError
 		if errors.As(err, &openapiError) {
 			if openapiError.StatusCode == http.StatusNotFound {
-				resp.State.RemoveResource(ctx)
+				core.RemoveFromStateWithWarning(ctx, resp, "stackit_sfs_export_policy", model.Id.ValueString())
 				return
 			}
 		}
@@ -370,6 +382,8 @@ func (r *exportPolicyResource) Read(ctx context.Context, req resource.ReadReques
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *exportPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { //nolint:gocritic // defined by terraform api
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -462,12 +476,13 @@ func (r *exportPolicyResource) Delete(ctx context.Context, req resource.DeleteRe
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the export policy resource import identifier is: project_id,region,policy_id
 func (r *exportPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing export policy",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[policy_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[policy_id]  Got: %q", importId),
 		)
 		return
 	}
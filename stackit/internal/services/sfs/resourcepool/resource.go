@@ -35,10 +35,13 @@ import (
 var (
 	_ resource.Resource                = &resourcePoolResource{}
 	_ resource.ResourceWithImportState = &resourcePoolResource{}
+	_ resource.ResourceWithIdentity    = &resourcePoolResource{}
 	_ resource.ResourceWithConfigure   = &resourcePoolResource{}
 	_ resource.ResourceWithModifyPlan  = &resourcePoolResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "resource_pool_id"}
+
 type Model struct {
 	Id                  types.String `tfsdk:"id"` // needed by TF
 	ProjectId           types.String `tfsdk:"project_id"`
@@ -81,7 +84,7 @@ func (r *resourcePoolResource) ModifyPlan(ctx context.Context, req resource.Modi
 		return
 	}
 
-	coreutils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	coreutils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -97,6 +100,11 @@ func (r *resourcePoolResource) Metadata(_ context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_sfs_resource_pool"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *resourcePoolResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *resourcePoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	var ok bool
@@ -207,6 +215,8 @@ func (r *resourcePoolResource) Schema(_ context.Context, _ resource.SchemaReques
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *resourcePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -254,7 +264,7 @@ func (r *resourcePoolResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	response, err := wait.CreateResourcePoolWaitHandler(ctx, r.client, projectId, region, *resourcePool.ResourcePool.Id).
+	response, err := core.ConfigureWaitHandler(wait.CreateResourcePoolWaitHandler(ctx, r.client, projectId, region, *resourcePool.ResourcePool.Id), r.providerData.Wait, core.WaitCreate).
 		WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating resource pool", fmt.Sprintf("resource pool creation waiting: %v", err))
@@ -293,6 +303,8 @@ func (r *resourcePoolResource) Create(ctx context.Context, req resource.CreateRe
 
 // Read refreshes the Terraform state with the latest data.
 func (r *resourcePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -313,7 +325,7 @@ func (r *resourcePoolResource) Read(ctx context.Context, req resource.ReadReques
 		var openapiError *oapierror.GenericOpenAPIError
 		if errors.As(err, &openapiError) {
 			if openapiError.StatusCode == http.StatusNotFound {
-				resp.State.RemoveResource(ctx)
+				core.RemoveFromStateWithWarning(ctx, resp, "stackit_sfs_resource_pool", model.Id.ValueString())
 				return
 			}
 		}
@@ -340,6 +352,8 @@ func (r *resourcePoolResource) Read(ctx context.Context, req resource.ReadReques
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *resourcePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -395,7 +409,7 @@ func (r *resourcePoolResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	getResponse, err := wait.UpdateResourcePoolWaitHandler(ctx, r.client, projectId, region, resourcePoolId).WaitWithContext(ctx)
+	getResponse, err := core.ConfigureWaitHandler(wait.UpdateResourcePoolWaitHandler(ctx, r.client, projectId, region, resourcePoolId), r.providerData.Wait, core.WaitUpdate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating resource pool", fmt.Sprintf("resource pool get: %v", err))
 		return
@@ -442,7 +456,7 @@ func (r *resourcePoolResource) Delete(ctx context.Context, req resource.DeleteRe
 	ctx = core.LogResponse(ctx)
 
 	// only delete, if no error occurred
-	_, err = wait.DeleteResourcePoolWaitHandler(ctx, r.client, projectId, region, resourcePoolId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.DeleteResourcePoolWaitHandler(ctx, r.client, projectId, region, resourcePoolId), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting resource pool", fmt.Sprintf("resource pool deletion waiting: %v", err))
 		return
@@ -453,11 +467,12 @@ func (r *resourcePoolResource) Delete(ctx context.Context, req resource.DeleteRe
 
 // ImportState implements resource.ResourceWithImportState.
 func (r *resourcePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing resource pool",
-			fmt.Sprintf("Expected import identifier with format [project_id],[region],[resource_pool_id], got %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format [project_id],[region],[resource_pool_id], got %q", importId),
 		)
 		return
 	}
@@ -18,7 +18,7 @@ import (
 )
 
 var exportPolicyResource = map[string]string{
-	"name":            fmt.Sprintf("acc-sfs-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)),
+	"name":            fmt.Sprintf("acc-sfs-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum)),
 	"project_id":      testutil.ProjectId,
 	"region":          "eu01",
 	"ip_acl_1":        "172.16.0.0/24",
@@ -82,7 +82,7 @@ func resourceConfigUpdateExportPolicy() string {
 var (
 	testCreateResourcePool = map[string]string{
 		"providerConfig":        testutil.SFSProviderConfig(),
-		"name":                  fmt.Sprintf("acc-sfs-resource-pool-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)),
+		"name":                  fmt.Sprintf("acc-sfs-resource-pool-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum)),
 		"project_id":            testutil.ProjectId,
 		"availability_zone":     "eu01-m",
 		"performance_class":     "Standard",
@@ -93,7 +93,7 @@ var (
 
 	testUpdateResourcePool = map[string]string{
 		"providerConfig":        testutil.SFSProviderConfig(),
-		"name":                  fmt.Sprintf("acc-sfs-resource-pool-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)),
+		"name":                  fmt.Sprintf("acc-sfs-resource-pool-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum)),
 		"project_id":            testutil.ProjectId,
 		"availability_zone":     "eu01-m",
 		"performance_class":     "Premium",
@@ -133,8 +133,8 @@ func resourcePoolConfig(configParams map[string]string) string {
 var (
 	testCreateShare = map[string]string{
 		"providerConfig":             testutil.SFSProviderConfig(),
-		"resource_pool_name":         fmt.Sprintf("acc-sfs-resource-pool-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)),
-		"name":                       fmt.Sprintf("acc-sfs-share-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)),
+		"resource_pool_name":         fmt.Sprintf("acc-sfs-resource-pool-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum)),
+		"name":                       fmt.Sprintf("acc-sfs-share-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum)),
 		"project_id":                 testutil.ProjectId,
 		"region":                     "eu01",
 		"space_hard_limit_gigabytes": "42",
@@ -142,8 +142,8 @@ var (
 
 	testUpdateShare = map[string]string{
 		"providerConfig":             testutil.SFSProviderConfig(),
-		"resource_pool_name":         fmt.Sprintf("acc-sfs-resource-pool-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)),
-		"name":                       fmt.Sprintf("acc-sfs-share-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)),
+		"resource_pool_name":         fmt.Sprintf("acc-sfs-resource-pool-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum)),
+		"name":                       fmt.Sprintf("acc-sfs-share-%s", testutil.RandStringFromCharSet(5, acctest.CharSetAlphaNum)),
 		"project_id":                 testutil.ProjectId,
 		"region":                     "eu02",
 		"space_hard_limit_gigabytes": "42",
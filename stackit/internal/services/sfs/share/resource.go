@@ -33,8 +33,11 @@ var (
 	_ resource.Resource               = &shareResource{}
 	_ resource.ResourceWithConfigure  = &shareResource{}
 	_ resource.ResourceWithModifyPlan = &shareResource{}
+	_ resource.ResourceWithIdentity   = &shareResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "resource_pool_id", "share_id"}
+
 type Model struct {
 	Id                      types.String `tfsdk:"id"` // needed by TF
 	ProjectId               types.String `tfsdk:"project_id"`
@@ -76,7 +79,7 @@ func (r *shareResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 		return
 	}
 
-	coreutils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	coreutils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -92,6 +95,11 @@ func (r *shareResource) Metadata(_ context.Context, req resource.MetadataRequest
 	resp.TypeName = req.ProviderTypeName + "_sfs_share"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *shareResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *shareResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	var ok bool
@@ -203,6 +211,8 @@ You can also assign a Share Export Policy after creating the Share`,
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *shareResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -252,7 +262,7 @@ func (r *shareResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	response, err := wait.CreateShareWaitHandler(ctx, r.client, projectId, region, resourcePoolId, *share.Share.Id).
+	response, err := core.ConfigureWaitHandler(wait.CreateShareWaitHandler(ctx, r.client, projectId, region, resourcePoolId, *share.Share.Id), r.providerData.Wait, core.WaitCreate).
 		WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating share", fmt.Sprintf("share creation waiting: %v", err))
@@ -291,6 +301,8 @@ func (r *shareResource) Create(ctx context.Context, req resource.CreateRequest,
 
 // Read refreshes the Terraform state with the latest data.
 func (r *shareResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -313,7 +325,7 @@ func (r *shareResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		var openapiError *oapierror.GenericOpenAPIError
 		if errors.As(err, &openapiError) {
 			if openapiError.StatusCode == http.StatusNotFound {
-				resp.State.RemoveResource(ctx)
+				core.RemoveFromStateWithWarning(ctx, resp, "stackit_sfs_share", model.Id.ValueString())
 				return
 			}
 		}
@@ -340,6 +352,8 @@ func (r *shareResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *shareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	// Retrieve values from plan
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
@@ -397,7 +411,7 @@ func (r *shareResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	getResponse, err := wait.UpdateShareWaitHandler(ctx, r.client, projectId, region, resourcePoolId, shareId).WaitWithContext(ctx)
+	getResponse, err := core.ConfigureWaitHandler(wait.UpdateShareWaitHandler(ctx, r.client, projectId, region, resourcePoolId, shareId), r.providerData.Wait, core.WaitUpdate).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating share", fmt.Sprintf("share get: %v", err))
 		return
@@ -446,7 +460,7 @@ func (r *shareResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	ctx = core.LogResponse(ctx)
 
 	// only delete, if no error occurred
-	_, err = wait.DeleteShareWaitHandler(ctx, r.client, projectId, region, resourcePoolId, shareId).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(wait.DeleteShareWaitHandler(ctx, r.client, projectId, region, resourcePoolId, shareId), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting share", fmt.Sprintf("share deletion waiting: %v", err))
 		return
@@ -457,11 +471,12 @@ func (r *shareResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 // ImportState implements resource.ResourceWithImportState.
 func (r *shareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing share",
-			fmt.Sprintf("Expected import identifier with format [project_id],[region],[resource_pool_id],[share_id], got %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format [project_id],[region],[resource_pool_id],[share_id], got %q", importId),
 		)
 		return
 	}
@@ -93,6 +93,19 @@ func (r *clusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"node_count_min": schema.Int64Attribute{
+				Description: "Total minimum number of nodes of all node pools. Can be used for cost estimation.",
+				Computed:    true,
+			},
+			"node_count_max": schema.Int64Attribute{
+				Description: "Total maximum number of nodes of all node pools. Can be used for cost estimation.",
+				Computed:    true,
+			},
+			"machine_types": schema.ListAttribute{
+				Description: "Machine types used by the node pools, sorted alphabetically and without duplicates. Can be used for cost estimation.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"node_pools": schema.ListNestedAttribute{
 				Description: "One or more `node_pool` block as defined below.",
 				Computed:    true,
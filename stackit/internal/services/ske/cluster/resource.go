@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -12,6 +13,7 @@ import (
 	serviceenablementUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serviceenablement/utils"
 	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -34,8 +36,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 	sdkUtils "github.com/stackitcloud/stackit-sdk-go/core/utils"
-	"github.com/stackitcloud/stackit-sdk-go/services/serviceenablement"
-	enablementWait "github.com/stackitcloud/stackit-sdk-go/services/serviceenablement/wait"
 	"github.com/stackitcloud/stackit-sdk-go/services/ske"
 	skeWait "github.com/stackitcloud/stackit-sdk-go/services/ske/wait"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
@@ -62,9 +62,12 @@ var (
 	_ resource.Resource                = &clusterResource{}
 	_ resource.ResourceWithConfigure   = &clusterResource{}
 	_ resource.ResourceWithImportState = &clusterResource{}
+	_ resource.ResourceWithIdentity    = &clusterResource{}
 	_ resource.ResourceWithModifyPlan  = &clusterResource{}
 )
 
+var resourceIdentity = utils.Identity{"project_id", "region", "name"}
+
 type skeClient interface {
 	GetClusterExecute(ctx context.Context, projectId, region, clusterName string) (*ske.Cluster, error)
 }
@@ -82,6 +85,9 @@ type Model struct {
 	Extensions            types.Object `tfsdk:"extensions"`
 	EgressAddressRanges   types.List   `tfsdk:"egress_address_ranges"`
 	PodAddressRanges      types.List   `tfsdk:"pod_address_ranges"`
+	NodeCountMin          types.Int64  `tfsdk:"node_count_min"`
+	NodeCountMax          types.Int64  `tfsdk:"node_count_max"`
+	MachineTypes          types.List   `tfsdk:"machine_types"`
 	Region                types.String `tfsdk:"region"`
 }
 
@@ -252,9 +258,9 @@ func NewClusterResource() resource.Resource {
 
 // clusterResource is the resource implementation.
 type clusterResource struct {
-	skeClient        *ske.APIClient
-	enablementClient *serviceenablement.APIClient
-	providerData     core.ProviderData
+	skeClient    *ske.APIClient
+	skeService   *core.RequiredService
+	providerData core.ProviderData
 }
 
 // ModifyPlan implements resource.ResourceWithModifyPlan.
@@ -276,11 +282,18 @@ func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Compute the node pool summary already in the plan, so it can be used for cost estimation
+	err := mapNodePoolsSummary(ctx, &planModel)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error modifying plan", fmt.Sprintf("Computing node pool summary: %v", err))
+		return
+	}
+
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -292,6 +305,11 @@ func (r *clusterResource) Metadata(_ context.Context, req resource.MetadataReque
 	resp.TypeName = req.ProviderTypeName + "_ske_cluster"
 }
 
+// IdentitySchema defines the identity of the resource, which can be used to import it.
+func (r *clusterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentity.Schema()
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *clusterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	var ok bool
@@ -304,12 +322,12 @@ func (r *clusterResource) Configure(ctx context.Context, req resource.ConfigureR
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	serviceEnablementClient := serviceenablementUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	skeService := serviceenablementUtils.ConfigureRequiredService(ctx, &r.providerData, utils.SKEServiceId, "SKE", true, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	r.skeClient = skeClient
-	r.enablementClient = serviceEnablementClient
+	r.skeService = skeService
 	tflog.Info(ctx, "SKE cluster clients configured")
 }
 
@@ -388,6 +406,19 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					listplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"node_count_min": schema.Int64Attribute{
+				Description: "Total minimum number of nodes of all node pools. Can be used for cost estimation.",
+				Computed:    true,
+			},
+			"node_count_max": schema.Int64Attribute{
+				Description: "Total maximum number of nodes of all node pools. Can be used for cost estimation.",
+				Computed:    true,
+			},
+			"machine_types": schema.ListAttribute{
+				Description: "Machine types used by the node pools, sorted alphabetically and without duplicates. Can be used for cost estimation.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"node_pools": schema.ListNestedAttribute{
 				Description: "One or more `node_pool` block as defined below.",
 				Required:    true,
@@ -462,7 +493,7 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 							},
 						},
 						"volume_type": schema.StringAttribute{
-							Description: "Specifies the volume type. Defaults to `storage_premium_perf1`.",
+							Description: "Specifies the volume type, which determines the performance class of the node volumes. Defaults to `storage_premium_perf1`. The volume type must be offered by SKE in the region of the cluster.",
 							Optional:    true,
 							Computed:    true,
 							Default:     stringdefault.StaticString(DefaultVolumeType),
@@ -472,6 +503,9 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 							Optional:    true,
 							Computed:    true,
 							Default:     int64default.StaticInt64(DefaultVolumeSizeGB),
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
 						},
 						"labels": schema.MapAttribute{
 							Description: "Labels to add to each node.",
@@ -720,6 +754,8 @@ func validateConfig(ctx context.Context, respDiags *diag.Diagnostics, model *Mod
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State)
+
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -736,26 +772,19 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 	ctx = tflog.SetField(ctx, "name", clusterName)
 	ctx = tflog.SetField(ctx, "region", region)
 
-	// If SKE functionality is not enabled, enable it
-	err := r.enablementClient.EnableServiceRegional(ctx, region, projectId, utils.SKEServiceId).Execute()
-	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating cluster", fmt.Sprintf("Calling API to enable SKE: %v", err))
-		return
-	}
-
-	_, err = enablementWait.EnableServiceWaitHandler(ctx, r.enablementClient, region, projectId, utils.SKEServiceId).WaitWithContext(ctx)
+	availableKubernetesVersions, availableMachines, availableVolumeTypes, err := r.loadAvailableVersions(ctx, region)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating cluster", fmt.Sprintf("Wait for SKE enablement: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating cluster", fmt.Sprintf("Loading available Kubernetes and machine image versions: %v", err))
 		return
 	}
 
-	availableKubernetesVersions, availableMachines, err := r.loadAvailableVersions(ctx, region)
+	err = validateVolumeTypes(ctx, &model, availableVolumeTypes)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating cluster", fmt.Sprintf("Loading available Kubernetes and machine image versions: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating cluster", fmt.Sprintf("Validating node pool volume types: %v", err))
 		return
 	}
 
-	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model, availableKubernetesVersions, availableMachines, nil, nil)
+	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model, availableKubernetesVersions, availableMachines, nil, nil, core.WaitCreate)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -793,25 +822,55 @@ func sortK8sVersions(versions []ske.KubernetesVersion) {
 	})
 }
 
-// loadAvailableVersions loads the available k8s and machine versions from the API.
+// loadAvailableVersions loads the available k8s and machine versions and the available volume types from the API.
 // The k8s versions are sorted  descending order, i.e. the latest versions (including previews)
 // are listed first
-func (r *clusterResource) loadAvailableVersions(ctx context.Context, region string) ([]ske.KubernetesVersion, []ske.MachineImage, error) {
+func (r *clusterResource) loadAvailableVersions(ctx context.Context, region string) ([]ske.KubernetesVersion, []ske.MachineImage, []ske.VolumeType, error) {
 	c := r.skeClient
 	res, err := c.ListProviderOptions(ctx, region).Execute()
 	if err != nil {
-		return nil, nil, fmt.Errorf("calling API: %w", err)
+		return nil, nil, nil, fmt.Errorf("calling API: %w", err)
 	}
 
 	if res.KubernetesVersions == nil {
-		return nil, nil, fmt.Errorf("API response has nil kubernetesVersions")
+		return nil, nil, nil, fmt.Errorf("API response has nil kubernetesVersions")
 	}
 
 	if res.MachineImages == nil {
-		return nil, nil, fmt.Errorf("API response has nil machine images")
+		return nil, nil, nil, fmt.Errorf("API response has nil machine images")
+	}
+
+	return *res.KubernetesVersions, *res.MachineImages, res.GetVolumeTypes(), nil
+}
+
+// validateVolumeTypes checks that the volume types of all node pools are offered by SKE in the region.
+// If the API doesn't return any volume types, the validation is skipped and left to the API.
+func validateVolumeTypes(ctx context.Context, m *Model, availableVolumeTypes []ske.VolumeType) error {
+	if len(availableVolumeTypes) == 0 || utils.IsUndefined(m.NodePools) {
+		return nil
 	}
 
-	return *res.KubernetesVersions, *res.MachineImages, nil
+	volumeTypeNames := []string{}
+	for _, volumeType := range availableVolumeTypes {
+		if volumeType.Name != nil {
+			volumeTypeNames = append(volumeTypeNames, *volumeType.Name)
+		}
+	}
+
+	nodePools := []nodePool{}
+	diags := m.NodePools.ElementsAs(ctx, &nodePools, false)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	for _, nodePool := range nodePools {
+		if utils.IsUndefined(nodePool.VolumeType) {
+			continue
+		}
+		if !slices.Contains(volumeTypeNames, nodePool.VolumeType.ValueString()) {
+			return fmt.Errorf("volume type %q of node pool %q is not available, available volume types are: %s", nodePool.VolumeType.ValueString(), nodePool.Name.ValueString(), strings.Join(volumeTypeNames, ", "))
+		}
+	}
+	return nil
 }
 
 // getCurrentVersions makes a call to get the details of a cluster and returns the current kubernetes version and a
@@ -842,7 +901,7 @@ func getCurrentVersions(ctx context.Context, c skeClient, m *Model) (kubernetesV
 	return kubernetesVersion, nodePoolMachineImages
 }
 
-func (r *clusterResource) createOrUpdateCluster(ctx context.Context, diags *diag.Diagnostics, model *Model, availableKubernetesVersions []ske.KubernetesVersion, availableMachineVersions []ske.MachineImage, currentKubernetesVersion *string, currentMachineImages map[string]*ske.Image) {
+func (r *clusterResource) createOrUpdateCluster(ctx context.Context, diags *diag.Diagnostics, model *Model, availableKubernetesVersions []ske.KubernetesVersion, availableMachineVersions []ske.MachineImage, currentKubernetesVersion *string, currentMachineImages map[string]*ske.Image, operation core.WaitOperation) {
 	// cluster vars
 	projectId := model.ProjectId.ValueString()
 	name := model.Name.ValueString()
@@ -892,7 +951,10 @@ func (r *clusterResource) createOrUpdateCluster(ctx context.Context, diags *diag
 		Network:     network,
 		Nodepools:   &nodePools,
 	}
-	_, err = r.skeClient.CreateOrUpdateCluster(ctx, projectId, region, name).CreateOrUpdateClusterPayload(payload).Execute()
+	// If SKE functionality is not enabled, it is enabled and the call is retried
+	_, err = core.CallWithRequiredService(ctx, r.skeService, projectId, region, func() (*ske.Cluster, error) {
+		return r.skeClient.CreateOrUpdateCluster(ctx, projectId, region, name).CreateOrUpdateClusterPayload(payload).Execute()
+	})
 	if err != nil {
 		core.LogAndAddError(ctx, diags, "Error creating/updating cluster", fmt.Sprintf("Calling API: %v", err))
 		return
@@ -904,7 +966,7 @@ func (r *clusterResource) createOrUpdateCluster(ctx context.Context, diags *diag
 	// Call tflog.Info here, to log the information of the updated context
 	tflog.Info(ctx, "Triggered create/update cluster")
 
-	waitResp, err := skeWait.CreateOrUpdateClusterWaitHandler(ctx, r.skeClient, projectId, region, name).WaitWithContext(ctx)
+	waitResp, err := core.ConfigureWaitHandler(skeWait.CreateOrUpdateClusterWaitHandler(ctx, r.skeClient, projectId, region, name), r.providerData.Wait, operation).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, diags, "Error creating/updating cluster", fmt.Sprintf("Cluster creation waiting: %v", err))
 		return
@@ -1415,6 +1477,10 @@ func mapFields(ctx context.Context, cl *ske.Cluster, m *Model, region string) er
 	if err != nil {
 		return fmt.Errorf("map node_pools: %w", err)
 	}
+	err = mapNodePoolsSummary(ctx, m)
+	if err != nil {
+		return fmt.Errorf("map node pool summary: %w", err)
+	}
 	err = mapMaintenance(ctx, cl, m)
 	if err != nil {
 		return fmt.Errorf("map maintenance: %w", err)
@@ -1535,6 +1601,57 @@ func mapNodePools(ctx context.Context, cl *ske.Cluster, model *Model) error {
 	return nil
 }
 
+// mapNodePoolsSummary aggregates the node counts and machine types of all node pools.
+// The summary is unknown as long as any of the aggregated node pool fields is unknown.
+func mapNodePoolsSummary(ctx context.Context, m *Model) error {
+	if m.NodePools.IsUnknown() {
+		m.NodeCountMin = types.Int64Unknown()
+		m.NodeCountMax = types.Int64Unknown()
+		m.MachineTypes = types.ListUnknown(types.StringType)
+		return nil
+	}
+
+	if m.NodePools.IsNull() {
+		m.NodeCountMin = types.Int64Null()
+		m.NodeCountMax = types.Int64Null()
+		m.MachineTypes = types.ListNull(types.StringType)
+		return nil
+	}
+
+	nodePools := []nodePool{}
+	diags := m.NodePools.ElementsAs(ctx, &nodePools, false)
+	if diags.HasError() {
+		return fmt.Errorf("converting node_pools object: %w", core.DiagsToError(diags))
+	}
+
+	var nodeCountMin, nodeCountMax int64
+	machineTypes := []string{}
+	for i := range nodePools {
+		np := nodePools[i]
+		if np.Minimum.IsUnknown() || np.Maximum.IsUnknown() || np.MachineType.IsUnknown() {
+			m.NodeCountMin = types.Int64Unknown()
+			m.NodeCountMax = types.Int64Unknown()
+			m.MachineTypes = types.ListUnknown(types.StringType)
+			return nil
+		}
+		nodeCountMin += np.Minimum.ValueInt64()
+		nodeCountMax += np.Maximum.ValueInt64()
+		if !np.MachineType.IsNull() && !slices.Contains(machineTypes, np.MachineType.ValueString()) {
+			machineTypes = append(machineTypes, np.MachineType.ValueString())
+		}
+	}
+	slices.Sort(machineTypes)
+
+	machineTypesTF, diags := types.ListValueFrom(ctx, types.StringType, machineTypes)
+	if diags.HasError() {
+		return fmt.Errorf("map machine types: %w", core.DiagsToError(diags))
+	}
+	m.NodeCountMin = types.Int64Value(nodeCountMin)
+	m.NodeCountMax = types.Int64Value(nodeCountMax)
+	m.MachineTypes = machineTypesTF
+	return nil
+}
+
 func mapTaints(t *[]ske.Taint, nodePool map[string]attr.Value, existInModel bool) error {
 	if t == nil || len(*t) == 0 {
 		if existInModel {
@@ -2158,6 +2275,8 @@ func hasOsVersionMinChanged(ctx context.Context, request planmodifier.StringRequ
 }
 
 func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var state Model
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -2178,7 +2297,7 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+			core.RemoveFromStateWithWarning(ctx, resp, "stackit_ske_cluster", state.Id.ValueString())
 			return
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading cluster", fmt.Sprintf("Calling API: %v", err))
@@ -2202,6 +2321,8 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	defer utils.SetIdentity(ctx, resp.Identity, &resp.Diagnostics, &resp.State, &req.State)
+
 	var model Model
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -2218,15 +2339,21 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	ctx = tflog.SetField(ctx, "name", clName)
 	ctx = tflog.SetField(ctx, "region", region)
 
-	availableKubernetesVersions, availableMachines, err := r.loadAvailableVersions(ctx, region)
+	availableKubernetesVersions, availableMachines, availableVolumeTypes, err := r.loadAvailableVersions(ctx, region)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating cluster", fmt.Sprintf("Loading available Kubernetes and machine image versions: %v", err))
 		return
 	}
 
+	err = validateVolumeTypes(ctx, &model, availableVolumeTypes)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating cluster", fmt.Sprintf("Validating node pool volume types: %v", err))
+		return
+	}
+
 	currentKubernetesVersion, currentMachineImages := getCurrentVersions(ctx, r.skeClient, &model)
 
-	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model, availableKubernetesVersions, availableMachines, currentKubernetesVersion, currentMachineImages)
+	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model, availableKubernetesVersions, availableMachines, currentKubernetesVersion, currentMachineImages, core.WaitUpdate)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -2264,7 +2391,7 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	ctx = core.LogResponse(ctx)
 
-	_, err = skeWait.DeleteClusterWaitHandler(ctx, r.skeClient, projectId, region, name).WaitWithContext(ctx)
+	_, err = core.ConfigureWaitHandler(skeWait.DeleteClusterWaitHandler(ctx, r.skeClient, projectId, region, name), r.providerData.Wait, core.WaitDelete).WaitWithContext(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting cluster", fmt.Sprintf("Cluster deletion waiting: %v", err))
 		return
@@ -2275,12 +2402,13 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,name
 func (r *clusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	importId := resourceIdentity.ImportID(ctx, req, &resp.Diagnostics)
+	idParts := strings.Split(importId, core.Separator)
 
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing cluster",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[name]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[name]  Got: %q", importId),
 		)
 		return
 	}
@@ -63,6 +63,7 @@ func TestMapFields(t *testing.T) {
 				Extensions:          types.ObjectNull(extensionsTypes),
 				EgressAddressRanges: types.ListNull(types.StringType),
 				PodAddressRanges:    types.ListNull(types.StringType),
+				MachineTypes:        types.ListNull(types.StringType),
 				Region:              types.StringValue(testRegion),
 			},
 			true,
@@ -173,6 +174,11 @@ func TestMapFields(t *testing.T) {
 						types.StringValue("1.1.1.1/32"),
 					},
 				),
+				NodeCountMin: types.Int64Value(1),
+				NodeCountMax: types.Int64Value(5),
+				MachineTypes: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("B"),
+				}),
 				NodePools: types.ListValueMust(
 					types.ObjectType{AttrTypes: nodePoolTypes},
 					[]attr.Value{
@@ -288,6 +294,7 @@ func TestMapFields(t *testing.T) {
 				Extensions:          types.ObjectNull(extensionsTypes),
 				EgressAddressRanges: types.ListNull(types.StringType),
 				PodAddressRanges:    types.ListNull(types.StringType),
+				MachineTypes:        types.ListNull(types.StringType),
 				Region:              types.StringValue(testRegion),
 			},
 			true,
@@ -323,6 +330,7 @@ func TestMapFields(t *testing.T) {
 				Hibernations:        types.ListNull(types.ObjectType{AttrTypes: hibernationTypes}),
 				EgressAddressRanges: types.ListNull(types.StringType),
 				PodAddressRanges:    types.ListNull(types.StringType),
+				MachineTypes:        types.ListNull(types.StringType),
 				Extensions: types.ObjectValueMust(extensionsTypes, map[string]attr.Value{
 					"acl": types.ObjectValueMust(aclTypes, map[string]attr.Value{
 						"enabled":       types.BoolValue(true),
@@ -374,6 +382,7 @@ func TestMapFields(t *testing.T) {
 				Hibernations:        types.ListNull(types.ObjectType{AttrTypes: hibernationTypes}),
 				EgressAddressRanges: types.ListNull(types.StringType),
 				PodAddressRanges:    types.ListNull(types.StringType),
+				MachineTypes:        types.ListNull(types.StringType),
 				Extensions: types.ObjectValueMust(extensionsTypes, map[string]attr.Value{
 					"acl": types.ObjectValueMust(aclTypes, map[string]attr.Value{
 						"enabled":       types.BoolValue(false),
@@ -436,6 +445,7 @@ func TestMapFields(t *testing.T) {
 				Hibernations:        types.ListNull(types.ObjectType{AttrTypes: hibernationTypes}),
 				EgressAddressRanges: types.ListNull(types.StringType),
 				PodAddressRanges:    types.ListNull(types.StringType),
+				MachineTypes:        types.ListNull(types.StringType),
 				Extensions: types.ObjectValueMust(extensionsTypes, map[string]attr.Value{
 					"acl": types.ObjectValueMust(aclTypes, map[string]attr.Value{
 						"enabled": types.BoolValue(true),
@@ -476,6 +486,7 @@ func TestMapFields(t *testing.T) {
 				Extensions:          types.ObjectNull(extensionsTypes),
 				EgressAddressRanges: types.ListNull(types.StringType),
 				PodAddressRanges:    types.ListNull(types.StringType),
+				MachineTypes:        types.ListNull(types.StringType),
 				Region:              types.StringValue(testRegion),
 			},
 			true,
@@ -602,6 +613,11 @@ func TestMapFields(t *testing.T) {
 				KubernetesVersionUsed: types.StringValue("1.2.3"),
 				EgressAddressRanges:   types.ListNull(types.StringType),
 				PodAddressRanges:      types.ListNull(types.StringType),
+				NodeCountMin:          types.Int64Value(1),
+				NodeCountMax:          types.Int64Value(5),
+				MachineTypes: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("B"),
+				}),
 				NodePools: types.ListValueMust(
 					types.ObjectType{AttrTypes: nodePoolTypes},
 					[]attr.Value{
@@ -2339,6 +2355,77 @@ func TestVerifySystemComponentNodepools(t *testing.T) {
 	}
 }
 
+func TestValidateVolumeTypes(t *testing.T) {
+	nodePoolsFixture := func(volumeTypes ...types.String) types.List {
+		nodePools := []nodePool{}
+		for i, volumeType := range volumeTypes {
+			nodePools = append(nodePools, nodePool{
+				Name:              types.StringValue(fmt.Sprintf("pool-%d", i)),
+				VolumeType:        volumeType,
+				Labels:            types.MapNull(types.StringType),
+				Taints:            types.ListNull(types.ObjectType{AttrTypes: taintTypes}),
+				AvailabilityZones: types.ListNull(types.StringType),
+			})
+		}
+		list, diags := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: nodePoolTypes}, nodePools)
+		if diags.HasError() {
+			t.Fatalf("building node pools: %v", diags.Errors())
+		}
+		return list
+	}
+	availableVolumeTypes := []ske.VolumeType{
+		{Name: utils.Ptr("storage_premium_perf1")},
+		{Name: utils.Ptr("storage_premium_perf6")},
+	}
+
+	tests := []struct {
+		description          string
+		nodePools            types.List
+		availableVolumeTypes []ske.VolumeType
+		isValid              bool
+	}{
+		{
+			description:          "available volume types",
+			nodePools:            nodePoolsFixture(types.StringValue("storage_premium_perf1"), types.StringValue("storage_premium_perf6")),
+			availableVolumeTypes: availableVolumeTypes,
+			isValid:              true,
+		},
+		{
+			description:          "unavailable volume type",
+			nodePools:            nodePoolsFixture(types.StringValue("storage_premium_perf1"), types.StringValue("storage_premium_perf42")),
+			availableVolumeTypes: availableVolumeTypes,
+			isValid:              false,
+		},
+		{
+			description:          "volume type not set",
+			nodePools:            nodePoolsFixture(types.StringNull()),
+			availableVolumeTypes: availableVolumeTypes,
+			isValid:              true,
+		},
+		{
+			description:          "no volume types returned by the API",
+			nodePools:            nodePoolsFixture(types.StringValue("storage_premium_perf42")),
+			availableVolumeTypes: nil,
+			isValid:              true,
+		},
+		{
+			description:          "node pools unknown",
+			nodePools:            types.ListUnknown(types.ObjectType{AttrTypes: nodePoolTypes}),
+			availableVolumeTypes: availableVolumeTypes,
+			isValid:              true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := validateVolumeTypes(context.Background(), &Model{NodePools: tt.nodePools}, tt.availableVolumeTypes)
+			if (err == nil) != tt.isValid {
+				t.Errorf("expected validity to be %v, but got error: %v", tt.isValid, err)
+			}
+		})
+	}
+}
+
 func TestMaintenanceWindow(t *testing.T) {
 	tc := []struct {
 		start     string
@@ -2637,3 +2724,105 @@ func TestValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestMapNodePoolsSummary(t *testing.T) {
+	testNodePool := func(machineType types.String, minimum, maximum types.Int64) attr.Value {
+		return types.ObjectValueMust(
+			nodePoolTypes,
+			map[string]attr.Value{
+				"name":                    types.StringValue("np"),
+				"machine_type":            machineType,
+				"os_name":                 types.StringNull(),
+				"os_version_min":          types.StringNull(),
+				"os_version":              types.StringNull(),
+				"os_version_used":         types.StringNull(),
+				"minimum":                 minimum,
+				"maximum":                 maximum,
+				"max_surge":               types.Int64Null(),
+				"max_unavailable":         types.Int64Null(),
+				"volume_type":             types.StringNull(),
+				"volume_size":             types.Int64Null(),
+				"labels":                  types.MapNull(types.StringType),
+				"taints":                  types.ListNull(types.ObjectType{AttrTypes: taintTypes}),
+				"cri":                     types.StringNull(),
+				"availability_zones":      types.ListNull(types.StringType),
+				"allow_system_components": types.BoolNull(),
+			},
+		)
+	}
+	nodePoolsType := types.ObjectType{AttrTypes: nodePoolTypes}
+
+	tests := []struct {
+		description          string
+		nodePools            basetypes.ListValue
+		expectedNodeCountMin basetypes.Int64Value
+		expectedNodeCountMax basetypes.Int64Value
+		expectedMachineTypes basetypes.ListValue
+	}{
+		{
+			"multiple_node_pools",
+			types.ListValueMust(nodePoolsType, []attr.Value{
+				testNodePool(types.StringValue("g1.3"), types.Int64Value(1), types.Int64Value(3)),
+				testNodePool(types.StringValue("c1.2"), types.Int64Value(2), types.Int64Value(4)),
+				testNodePool(types.StringValue("g1.3"), types.Int64Value(0), types.Int64Value(5)),
+			}),
+			types.Int64Value(3),
+			types.Int64Value(12),
+			types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("c1.2"),
+				types.StringValue("g1.3"),
+			}),
+		},
+		{
+			"empty_node_pools",
+			types.ListValueMust(nodePoolsType, []attr.Value{}),
+			types.Int64Value(0),
+			types.Int64Value(0),
+			types.ListValueMust(types.StringType, []attr.Value{}),
+		},
+		{
+			"unknown_value_in_node_pool",
+			types.ListValueMust(nodePoolsType, []attr.Value{
+				testNodePool(types.StringValue("g1.3"), types.Int64Value(1), types.Int64Value(3)),
+				testNodePool(types.StringValue("c1.2"), types.Int64Unknown(), types.Int64Value(4)),
+			}),
+			types.Int64Unknown(),
+			types.Int64Unknown(),
+			types.ListUnknown(types.StringType),
+		},
+		{
+			"unknown_node_pools",
+			types.ListUnknown(nodePoolsType),
+			types.Int64Unknown(),
+			types.Int64Unknown(),
+			types.ListUnknown(types.StringType),
+		},
+		{
+			"null_node_pools",
+			types.ListNull(nodePoolsType),
+			types.Int64Null(),
+			types.Int64Null(),
+			types.ListNull(types.StringType),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				NodePools: tt.nodePools,
+			}
+			err := mapNodePoolsSummary(context.Background(), model)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if !model.NodeCountMin.Equal(tt.expectedNodeCountMin) {
+				t.Fatalf("Expected node_count_min %v, got %v", tt.expectedNodeCountMin, model.NodeCountMin)
+			}
+			if !model.NodeCountMax.Equal(tt.expectedNodeCountMax) {
+				t.Fatalf("Expected node_count_max %v, got %v", tt.expectedNodeCountMax, model.NodeCountMax)
+			}
+			if !model.MachineTypes.Equal(tt.expectedMachineTypes) {
+				t.Fatalf("Expected machine_types %v, got %v", tt.expectedMachineTypes, model.MachineTypes)
+			}
+		})
+	}
+}
@@ -21,7 +21,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -95,8 +94,8 @@ func (r *kubeconfigResource) Schema(_ context.Context, _ resource.SchemaRequest,
 		"kube_config":    "Raw short-lived admin kubeconfig.",
 		"expiration":     "Expiration time of the kubeconfig, in seconds. Defaults to `3600`",
 		"expires_at":     "Timestamp when the kubeconfig expires",
-		"refresh":        "If set to true, the provider will check if the kubeconfig has expired and will generated a new valid one in-place",
-		"refresh_before": "Number of seconds before expiration to trigger refresh of the kubeconfig at. Only used if refresh is set to true.",
+		"refresh":        "If set to true, the provider will check if the kubeconfig is near expiry and will generate a new valid one in-place, when the state is refreshed",
+		"refresh_before": "Number of seconds before expiration to trigger refresh of the kubeconfig at. Defaults to a tenth of `expiration`. Only used if refresh is set to true.",
 		"creation_time":  "Date-time when the kubeconfig was created",
 		"region":         "The resource region. If not defined, the provider region is used.",
 	}
@@ -154,9 +153,6 @@ func (r *kubeconfigResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"refresh": schema.BoolAttribute{
 				Description: descriptions["refresh"],
 				Optional:    true,
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
 			},
 			"refresh_before": schema.Int64Attribute{
 				Description: descriptions["refresh_before"],
@@ -226,7 +222,7 @@ func (r *kubeconfigResource) ModifyPlan(ctx context.Context, req resource.Modify
 		return
 	}
 
-	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetConfiguredRegion(), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -279,7 +275,7 @@ func (r *kubeconfigResource) Create(ctx context.Context, req resource.CreateRequ
 
 // Read refreshes the Terraform state with the latest data.
 // There is no GET kubeconfig endpoint.
-// If the refresh field is set, Read will check the expiration date and will get a new valid kubeconfig if it has expired
+// If the refresh field is set, Read will check the expiration date and will get a new valid kubeconfig if it is near expiry
 // If kubeconfig creation time is before lastCompletionTime of the credentials rotation or
 // before cluster creation time a new kubeconfig is created.
 func (r *kubeconfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
@@ -384,9 +380,31 @@ func (r *kubeconfigResource) createKubeconfig(ctx context.Context, model *Model)
 	return nil
 }
 
-func (r *kubeconfigResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
-	// Update shouldn't be called
-	core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating kubeconfig", "Kubeconfig can't be updated")
+// Update updates the refresh settings, which are only used by the provider, so the kubeconfig is kept.
+// All other attributes require a replacement.
+func (r *kubeconfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var stateModel Model
+	diags = req.State.Get(ctx, &stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateModel.Refresh = model.Refresh
+	stateModel.RefreshBefore = model.RefreshBefore
+	diags = resp.State.Set(ctx, stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE kubeconfig updated")
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -454,7 +472,7 @@ func toCreatePayload(model *Model) (*ske.CreateKubeconfigPayload, error) {
 	}, nil
 }
 
-// helper function to check if kubecondig has expired
+// helper function to check if kubeconfig has expired or is near expiry
 func checkHasExpired(model *Model, currentTime time.Time) (bool, error) {
 	expiresAt := model.ExpiresAt
 	if model.Refresh.ValueBool() && !expiresAt.IsNull() {
@@ -467,6 +485,9 @@ func checkHasExpired(model *Model, currentTime time.Time) (bool, error) {
 		}
 		if !model.RefreshBefore.IsNull() {
 			expiresAt = expiresAt.Add(-time.Duration(model.RefreshBefore.ValueInt64()) * time.Second)
+		} else if !model.Expiration.IsNull() && !model.Expiration.IsUnknown() {
+			// refresh the kubeconfig in the last tenth of its lifetime, so it's still valid when it's used after the refresh
+			expiresAt = expiresAt.Add(-time.Duration(model.Expiration.ValueInt64()/10) * time.Second)
 		}
 		if expiresAt.Before(currentTime) {
 			return true, nil
@@ -181,6 +181,28 @@ func TestCheckHasExpired(t *testing.T) {
 			expected:      true,
 			expectedError: false,
 		},
+		{
+			description: "not expired but near expiry",
+			inputModel: &Model{
+				Refresh:    types.BoolValue(true),
+				Expiration: types.Int64Value(int64(time.Hour.Seconds())),
+				ExpiresAt:  types.StringValue(time.Now().Add(5 * time.Minute).Format(time.RFC3339)), // in five minutes
+			},
+			currentTime:   time.Now(),
+			expected:      true,
+			expectedError: false,
+		},
+		{
+			description: "not near expiry",
+			inputModel: &Model{
+				Refresh:    types.BoolValue(true),
+				Expiration: types.Int64Value(int64(time.Hour.Seconds())),
+				ExpiresAt:  types.StringValue(time.Now().Add(30 * time.Minute).Format(time.RFC3339)), // in 30 minutes
+			},
+			currentTime:   time.Now(),
+			expected:      false,
+			expectedError: false,
+		},
 		{
 			description: "invalid time",
 			inputModel: &Model{
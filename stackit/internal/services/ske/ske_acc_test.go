@@ -21,8 +21,8 @@ import (
 )
 
 var (
-	minTestName = "acc-min" + acctest.RandStringFromCharSet(3, acctest.CharSetAlpha)
-	maxTestName = "acc-max" + acctest.RandStringFromCharSet(3, acctest.CharSetAlpha)
+	minTestName = "acc-min" + testutil.RandStringFromCharSet(3, acctest.CharSetAlpha)
+	maxTestName = "acc-max" + testutil.RandStringFromCharSet(3, acctest.CharSetAlpha)
 )
 
 var (
@@ -87,8 +87,8 @@ var testConfigVarsMax = config.Variables{
 	"expiration":                                       config.StringVariable("3600"),
 	"refresh":                                          config.StringVariable("true"),
 	"refresh_before":                                   config.StringVariable("600"),
-	"dns_zone_name":                                    config.StringVariable("acc-" + acctest.RandStringFromCharSet(6, acctest.CharSetAlpha)),
-	"dns_name":                                         config.StringVariable("acc-" + acctest.RandStringFromCharSet(6, acctest.CharSetAlpha) + ".runs.onstackit.cloud"),
+	"dns_zone_name":                                    config.StringVariable("acc-" + testutil.RandStringFromCharSet(6, acctest.CharSetAlpha)),
+	"dns_name":                                         config.StringVariable("acc-" + testutil.RandStringFromCharSet(6, acctest.CharSetAlpha) + ".runs.onstackit.cloud"),
 }
 
 func configVarsMinUpdated() config.Variables {
@@ -28,19 +28,19 @@ var (
 )
 var testConfigVarsMin = config.Variables{
 	"project_id":         config.StringVariable(testutil.ProjectId),
-	"name":               config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
+	"name":               config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
 	"flavor_cpu":         config.IntegerVariable(4),
 	"flavor_ram":         config.IntegerVariable(16),
 	"flavor_description": config.StringVariable("SQLServer-Flex-4.16-Standard-EU01"),
 	"replicas":           config.IntegerVariable(1),
 	"flavor_id":          config.StringVariable("4.16-Single"),
-	"username":           config.StringVariable(fmt.Sprintf("tf-acc-user-%s", acctest.RandStringFromCharSet(7, acctest.CharSetAlpha))),
+	"username":           config.StringVariable(fmt.Sprintf("tf-acc-user-%s", testutil.RandStringFromCharSet(7, acctest.CharSetAlpha))),
 	"role":               config.StringVariable("##STACKIT_LoginManager##"),
 }
 
 var testConfigVarsMax = config.Variables{
 	"project_id":             config.StringVariable(testutil.ProjectId),
-	"name":                   config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
+	"name":                   config.StringVariable(fmt.Sprintf("tf-acc-%s", testutil.RandStringFromCharSet(7, acctest.CharSetAlphaNum))),
 	"acl1":                   config.StringVariable("192.168.0.0/16"),
 	"flavor_cpu":             config.IntegerVariable(4),
 	"flavor_ram":             config.IntegerVariable(16),
@@ -52,7 +52,7 @@ var testConfigVarsMax = config.Variables{
 	"options_retention_days": config.IntegerVariable(64),
 	"flavor_id":              config.StringVariable("4.16-Single"),
 	"backup_schedule":        config.StringVariable("00 6 * * *"),
-	"username":               config.StringVariable(fmt.Sprintf("tf-acc-user-%s", acctest.RandStringFromCharSet(7, acctest.CharSetAlpha))),
+	"username":               config.StringVariable(fmt.Sprintf("tf-acc-user-%s", testutil.RandStringFromCharSet(7, acctest.CharSetAlpha))),
 	"role":                   config.StringVariable("##STACKIT_LoginManager##"),
 	"region":                 config.StringVariable(testutil.Region),
 }
@@ -3,22 +3,30 @@ package testutil
 import (
 	"encoding/json"
 	"fmt"
+	"math/rand/v2"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/testutil/vcr"
 )
 
 const (
 	// Default location of credentials JSON
 	credentialsFilePath = ".stackit/credentials.json" //nolint:gosec // linter false positive
+	// Default location of the cassette, relative to the package of the acceptance tests
+	defaultCassettePath = "testdata/cassettes/acceptance.json"
 )
 
 var (
@@ -27,7 +35,7 @@ var (
 	// CLI command executed to create a provider server to which the CLI can
 	// reattach.
 	TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-		"stackit": providerserver.NewProtocol6WithError(stackit.New("test-version")()),
+		"stackit": newProviderServer,
 	}
 
 	// TestEphemeralAccProtoV6ProviderFactories is used to instantiate a provider during
@@ -38,7 +46,7 @@ var (
 	// See the Terraform acceptance test documentation on ephemeral resources for more information:
 	// https://developer.hashicorp.com/terraform/plugin/testing/acceptance-tests/ephemeral-resources
 	TestEphemeralAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-		"stackit": providerserver.NewProtocol6WithError(stackit.New("test-version")()),
+		"stackit": newProviderServer,
 		"echo":    echoprovider.NewProviderServer(),
 	}
 
@@ -93,6 +101,109 @@ var (
 	TokenCustomEndpoint           = os.Getenv("TF_ACC_TOKEN_CUSTOM_ENDPOINT")
 )
 
+// Placeholders of the IDs of the test environment in the cassettes, see setupVCR.
+// The cassettes are replayed with these placeholders as values of the corresponding TF_ACC_* env vars.
+const (
+	vcrProjectIdPlaceholder                    = "00000000-0000-0000-0000-000000000001"
+	vcrOrganizationIdPlaceholder               = "00000000-0000-0000-0000-000000000002"
+	vcrServerIdPlaceholder                     = "00000000-0000-0000-0000-000000000003"
+	vcrTestProjectParentUUIDPlaceholder        = "00000000-0000-0000-0000-000000000004"
+	vcrTestProjectParentContainerIDPlaceholder = "tf-acc-parent-container-id"
+)
+
+// vcrRoundTripperWrapper returns the round tripper wrapper of the recorder. The recorder is shared by all providers of a test run.
+var vcrRoundTripperWrapper = sync.OnceValues(setupVCR)
+
+// newProviderServer creates a provider, which records or replays the API interactions, if enabled.
+func newProviderServer() (tfprotov6.ProviderServer, error) {
+	roundTripperWrapper, err := vcrRoundTripperWrapper()
+	if err != nil {
+		return nil, err
+	}
+	return providerserver.NewProtocol6WithError(stackit.NewWithRoundTripperWrapper("test-version", roundTripperWrapper)())()
+}
+
+// setupVCR records or replays the API interactions of the acceptance tests, if enabled with TF_ACC_VCR_MODE.
+// The cassette is stored in TF_ACC_VCR_CASSETTE, which defaults to testdata/cassettes/acceptance.json in the tested package.
+// The returned wrapper is nil, if neither recording nor replaying is enabled.
+func setupVCR() (func(next http.RoundTripper) http.RoundTripper, error) {
+	mode, err := vcr.ModeFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if mode == vcr.ModeDisabled {
+		return nil, nil
+	}
+	recorder, err := vcr.New(mode, getenv("TF_ACC_VCR_CASSETTE", defaultCassettePath), map[string]string{
+		ProjectId:                    vcrProjectIdPlaceholder,
+		OrganizationId:               vcrOrganizationIdPlaceholder,
+		ServerId:                     vcrServerIdPlaceholder,
+		TestProjectParentUUID:        vcrTestProjectParentUUIDPlaceholder,
+		TestProjectParentContainerID: vcrTestProjectParentContainerIDPlaceholder,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("setting up VCR: %w", err)
+	}
+	return recorder.Wrap, nil
+}
+
+// randomNames generates the random names of the acceptance tests while recording or replaying.
+// It is seeded with a constant, so the names in the request URLs match the cassette.
+var randomNames = newDeterministicRand()
+
+type deterministicRand struct {
+	mu     sync.Mutex
+	source *rand.ChaCha8
+	rand   *rand.Rand
+}
+
+func newDeterministicRand() *deterministicRand {
+	source := rand.NewChaCha8([32]byte{})
+	return &deterministicRand{source: source, rand: rand.New(source)} //nolint:gosec // deterministic on purpose
+}
+
+func (r *deterministicRand) stringFromCharSet(length int, charSet string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	result := make([]byte, length)
+	for i := range result {
+		result[i] = charSet[r.rand.IntN(len(charSet))]
+	}
+	return string(result)
+}
+
+func (r *deterministicRand) uuidString() (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id, err := uuid.NewRandomFromReader(r.source)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+// RandStringFromCharSet is like acctest.RandStringFromCharSet, but the string is deterministic while recording or replaying.
+func RandStringFromCharSet(length int, charSet string) string {
+	mode, err := vcr.ModeFromEnv()
+	if err != nil || mode == vcr.ModeDisabled {
+		return acctest.RandStringFromCharSet(length, charSet)
+	}
+	return randomNames.stringFromCharSet(length, charSet)
+}
+
+// UUIDString returns a random UUID, which is deterministic while recording or replaying.
+func UUIDString() string {
+	mode, err := vcr.ModeFromEnv()
+	if err != nil || mode == vcr.ModeDisabled {
+		return uuid.NewString()
+	}
+	id, err := randomNames.uuidString()
+	if err != nil {
+		return uuid.NewString()
+	}
+	return id
+}
+
 // Provider config helper functions
 
 func ObservabilityProviderConfig() string {
@@ -1,8 +1,11 @@
 package testutil
 
 import (
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 )
 
@@ -46,3 +49,74 @@ func TestConvertConfigVariable(t *testing.T) {
 		})
 	}
 }
+
+func TestSetupVCR(t *testing.T) {
+	tests := []struct {
+		description string
+		mode        string
+		expectedNil bool
+		isValid     bool
+	}{
+		{
+			description: "disabled",
+			mode:        "",
+			expectedNil: true,
+			isValid:     true,
+		},
+		{
+			description: "record",
+			mode:        "record",
+			isValid:     true,
+		},
+		{
+			description: "replay missing cassette",
+			mode:        "replay",
+			isValid:     false,
+		},
+		{
+			description: "invalid mode",
+			mode:        "foo",
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			t.Setenv("TF_ACC_VCR_MODE", tt.mode)
+			t.Setenv("TF_ACC_VCR_CASSETTE", filepath.Join(t.TempDir(), "cassette.json"))
+			wrapper, err := setupVCR()
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid && (wrapper == nil) != tt.expectedNil {
+				t.Fatalf("Wrapper does not match: expected nil %t, got nil %t", tt.expectedNil, wrapper == nil)
+			}
+		})
+	}
+}
+
+func TestDeterministicRand(t *testing.T) {
+	first, second := newDeterministicRand(), newDeterministicRand()
+	for range 3 {
+		output := first.stringFromCharSet(10, "ab")
+		if len(output) != 10 || strings.Trim(output, "ab") != "" {
+			t.Fatalf("Unexpected output %q", output)
+		}
+		if diff := cmp.Diff(output, second.stringFromCharSet(10, "ab")); diff != "" {
+			t.Fatalf("Data does not match: %s", diff)
+		}
+		firstId, err := first.uuidString()
+		if err != nil {
+			t.Fatalf("Should not have failed: %v", err)
+		}
+		secondId, err := second.uuidString()
+		if err != nil {
+			t.Fatalf("Should not have failed: %v", err)
+		}
+		if diff := cmp.Diff(firstId, secondId); diff != "" {
+			t.Fatalf("Data does not match: %s", diff)
+		}
+	}
+}
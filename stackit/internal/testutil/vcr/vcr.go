@@ -0,0 +1,302 @@
+// Package vcr records the API interactions of the acceptance tests into cassettes and replays them,
+// so the acceptance tests can run against the recorded API behavior without STACKIT credentials.
+package vcr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ModeEnvVar is the environment variable which sets the mode of the recorder.
+const ModeEnvVar = "TF_ACC_VCR_MODE"
+
+// Mode defines whether API interactions are recorded, replayed or passed through.
+type Mode string
+
+const (
+	// ModeDisabled passes all requests through to the API.
+	ModeDisabled Mode = ""
+	// ModeRecord passes all requests through to the API and records the interactions into the cassette.
+	ModeRecord Mode = "record"
+	// ModeReplay answers all requests from the cassette, without calling the API.
+	ModeReplay Mode = "replay"
+)
+
+// redacted replaces the values of sanitized fields in the cassette.
+const redacted = "REDACTED"
+
+// secretFieldPatterns are the substrings of JSON field names, whose values are removed from the cassette.
+// "content" holds the secret of model serving tokens, "kubeconfig" the credentials of SKE clusters.
+var secretFieldPatterns = []string{"password", "token", "secret", "privatekey", "private_key", "credential", "content", "kubeconfig"}
+
+// referenceFieldSuffixes are the suffixes of JSON field names, which refer to other objects instead of holding a secret.
+// Their values must be kept, as they are used in the URLs of later requests.
+var referenceFieldSuffixes = []string{"id", "ids", "ref", "name"}
+
+// ModeFromEnv returns the mode set in the environment.
+func ModeFromEnv() (Mode, error) {
+	mode := Mode(strings.ToLower(os.Getenv(ModeEnvVar)))
+	switch mode {
+	case ModeDisabled, ModeRecord, ModeReplay:
+		return mode, nil
+	}
+	return ModeDisabled, fmt.Errorf("invalid value %q for %s, must be one of %q, %q", mode, ModeEnvVar, ModeRecord, ModeReplay)
+}
+
+// Cassette holds the recorded API interactions.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single request to the API and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is the recorded part of a request. Headers are not recorded, as they contain the credentials.
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Response is the recorded part of a response.
+type Response struct {
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body,omitempty"`
+}
+
+// Recorder records or replays the API interactions, depending on its mode.
+// It is shared by all round trippers returned by Wrap, as the provider is configured several times during a test run.
+type Recorder struct {
+	mode Mode
+	path string
+	// placeholders replaces environment specific values in the cassette, see New
+	placeholders *strings.Replacer
+
+	mu       sync.Mutex
+	cassette Cassette
+	// used marks the replayed interactions, so each one is only replayed once
+	used []bool
+}
+
+// New returns a recorder with the cassette stored at path. In replay mode, the cassette must exist.
+// While recording, the keys of placeholders, e.g. the ID of the test project, are replaced by their values in the cassette.
+// This way, the cassette can be replayed in another environment by using the placeholders as values.
+func New(mode Mode, path string, placeholders map[string]string) (*Recorder, error) {
+	oldNew := []string{}
+	for value, placeholder := range placeholders {
+		if value != "" {
+			oldNew = append(oldNew, value, placeholder)
+		}
+	}
+	r := &Recorder{
+		mode:         mode,
+		path:         path,
+		placeholders: strings.NewReplacer(oldNew...),
+	}
+	if mode != ModeReplay {
+		return r, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading cassette: %w", err)
+	}
+	err = json.Unmarshal(content, &r.cassette)
+	if err != nil {
+		return nil, fmt.Errorf("parsing cassette %q: %w", path, err)
+	}
+	r.used = make([]bool, len(r.cassette.Interactions))
+	return r, nil
+}
+
+// Wrap returns a round tripper which records the interactions of next or replays them, depending on the mode.
+func (r *Recorder) Wrap(next http.RoundTripper) http.RoundTripper {
+	return &roundTripper{
+		recorder: r,
+		next:     next,
+	}
+}
+
+type roundTripper struct {
+	recorder *Recorder
+	next     http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rt *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch rt.recorder.mode {
+	case ModeRecord:
+		return rt.recorder.record(req, rt.next)
+	case ModeReplay:
+		return rt.recorder.replay(req)
+	}
+	return rt.next.RoundTrip(req)
+}
+
+func (r *Recorder) record(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+	requestBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading request body: %w", err)
+	}
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+
+	interaction := Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    r.placeholders.Replace(req.URL.String()),
+			Body:   r.placeholders.Replace(sanitize(requestBody)),
+		},
+		Response: Response{
+			StatusCode:  resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        r.placeholders.Replace(sanitize(responseBody)),
+		},
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	// The cassette is saved after every interaction, as the provider has no hook at the end of a test run
+	err = r.save()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// replay answers the request with the first unused interaction with the same method and URL.
+// Request bodies are not compared, as they may contain randomly generated values.
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	url := req.URL.String()
+	for i := range r.cassette.Interactions {
+		interaction := r.cassette.Interactions[i]
+		if r.used[i] || interaction.Request.Method != req.Method || interaction.Request.URL != url {
+			continue
+		}
+		r.used[i] = true
+
+		header := http.Header{}
+		if interaction.Response.ContentType != "" {
+			header.Set("Content-Type", interaction.Response.ContentType)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("no recorded interaction left for %s %s in cassette %q", req.Method, url, r.path)
+}
+
+func (r *Recorder) save() error {
+	content, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding cassette: %w", err)
+	}
+	err = os.MkdirAll(filepath.Dir(r.path), 0o750)
+	if err != nil {
+		return fmt.Errorf("creating cassette directory: %w", err)
+	}
+	err = os.WriteFile(r.path, content, 0o600)
+	if err != nil {
+		return fmt.Errorf("writing cassette: %w", err)
+	}
+	return nil
+}
+
+// readBody reads the body and replaces it with a copy, so it can be read again.
+func readBody(body *io.ReadCloser) (string, error) {
+	if *body == nil || *body == http.NoBody {
+		return "", nil
+	}
+	content, err := io.ReadAll(*body)
+	if err != nil {
+		return "", err
+	}
+	err = (*body).Close()
+	if err != nil {
+		return "", err
+	}
+	*body = io.NopCloser(bytes.NewReader(content))
+	return string(content), nil
+}
+
+// sanitize removes the values of secret fields from a JSON body. Other bodies are returned unchanged.
+func sanitize(body string) string {
+	var content any
+	if json.Unmarshal([]byte(body), &content) != nil {
+		return body
+	}
+	sanitized, err := json.Marshal(sanitizeValue(content))
+	if err != nil {
+		return body
+	}
+	return string(sanitized)
+}
+
+func sanitizeValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, fieldValue := range v {
+			if isSecretField(key) {
+				if _, ok := fieldValue.(string); ok {
+					v[key] = redacted
+					continue
+				}
+			}
+			v[key] = sanitizeValue(fieldValue)
+		}
+		return v
+	case []any:
+		for i := range v {
+			v[i] = sanitizeValue(v[i])
+		}
+		return v
+	}
+	return value
+}
+
+func isSecretField(key string) bool {
+	key = strings.ToLower(key)
+	for _, suffix := range referenceFieldSuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return false
+		}
+	}
+	if strings.HasSuffix(key, "uri") {
+		return true
+	}
+	for _, pattern := range secretFieldPatterns {
+		if strings.Contains(key, pattern) {
+			return true
+		}
+	}
+	return false
+}
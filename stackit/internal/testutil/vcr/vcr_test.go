@@ -0,0 +1,241 @@
+package vcr
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestModeFromEnv(t *testing.T) {
+	tests := []struct {
+		description string
+		value       string
+		expected    Mode
+		isValid     bool
+	}{
+		{
+			description: "disabled",
+			value:       "",
+			expected:    ModeDisabled,
+			isValid:     true,
+		},
+		{
+			description: "record",
+			value:       "record",
+			expected:    ModeRecord,
+			isValid:     true,
+		},
+		{
+			description: "replay upper case",
+			value:       "REPLAY",
+			expected:    ModeReplay,
+			isValid:     true,
+		},
+		{
+			description: "invalid",
+			value:       "foo",
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			t.Setenv(ModeEnvVar, tt.value)
+			mode, err := ModeFromEnv()
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid && mode != tt.expected {
+				t.Fatalf("Mode does not match: expected %q, got %q", tt.expected, mode)
+			}
+		})
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expected    string
+	}{
+		{
+			description: "secret fields",
+			input:       `{"name":"instance","password":"pw","credentials":{"username":"user","privateKey":"key"},"connectionUri":"uri"}`,
+			expected:    `{"connectionUri":"REDACTED","credentials":{"privateKey":"REDACTED","username":"user"},"name":"instance","password":"REDACTED"}`,
+		},
+		{
+			description: "reference fields are kept",
+			input:       `{"tokenId":"tid","credentialsRef":"ref","securityGroupId":"sgid","secretName":"name"}`,
+			expected:    `{"credentialsRef":"ref","secretName":"name","securityGroupId":"sgid","tokenId":"tid"}`,
+		},
+		{
+			description: "model serving token content",
+			input:       `{"token":{"id":"tid","name":"token","content":"secret"}}`,
+			expected:    `{"token":{"content":"REDACTED","id":"tid","name":"token"}}`,
+		},
+		{
+			description: "kubeconfig",
+			input:       `{"expirationTimestamp":"2026-01-01T00:00:00Z","kubeconfig":"apiVersion: v1"}`,
+			expected:    `{"expirationTimestamp":"2026-01-01T00:00:00Z","kubeconfig":"REDACTED"}`,
+		},
+		{
+			description: "lists",
+			input:       `{"items":[{"token":"secret"}]}`,
+			expected:    `{"items":[{"token":"REDACTED"}]}`,
+		},
+		{
+			description: "no json",
+			input:       "plain text",
+			expected:    "plain text",
+		},
+		{
+			description: "empty",
+			input:       "",
+			expected:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := sanitize(tt.input)
+			if output != tt.expected {
+				t.Fatalf("Data does not match: expected %s, got %s", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("Authorization header not passed to the API")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"id-1","password":"pw"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"id-1","state":"ready"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassettes", "test.json")
+	doRequests := func(client *http.Client) []string {
+		bodies := []string{}
+		for _, method := range []string{http.MethodPost, http.MethodGet} {
+			req, err := http.NewRequest(method, server.URL+"/v1/instances", strings.NewReader(`{"name":"instance"}`))
+			if err != nil {
+				t.Fatalf("Creating request: %v", err)
+			}
+			req.Header.Set("Authorization", "Bearer token")
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("Calling API: %v", err)
+			}
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("Reading response: %v", err)
+			}
+			_ = resp.Body.Close()
+			bodies = append(bodies, string(body))
+		}
+		return bodies
+	}
+
+	recorder, err := New(ModeRecord, path, nil)
+	if err != nil {
+		t.Fatalf("Creating recorder: %v", err)
+	}
+	recorded := doRequests(&http.Client{Transport: recorder.Wrap(http.DefaultTransport)})
+	if calls != 2 {
+		t.Fatalf("Expected 2 API calls while recording, got %d", calls)
+	}
+	expectedRecorded := []string{`{"id":"id-1","password":"pw"}`, `{"id":"id-1","state":"ready"}`}
+	if diff := cmp.Diff(recorded, expectedRecorded); diff != "" {
+		t.Fatalf("Recorded responses do not match: %s", diff)
+	}
+
+	replayer, err := New(ModeReplay, path, nil)
+	if err != nil {
+		t.Fatalf("Creating replayer: %v", err)
+	}
+	replayed := doRequests(&http.Client{Transport: replayer.Wrap(http.DefaultTransport)})
+	if calls != 2 {
+		t.Fatalf("Expected no API calls while replaying, got %d", calls-2)
+	}
+	expectedReplayed := []string{`{"id":"id-1","password":"REDACTED"}`, `{"id":"id-1","state":"ready"}`}
+	if diff := cmp.Diff(replayed, expectedReplayed); diff != "" {
+		t.Fatalf("Replayed responses do not match: %s", diff)
+	}
+
+	// All interactions are used up
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/v1/instances", http.NoBody)
+	if err != nil {
+		t.Fatalf("Creating request: %v", err)
+	}
+	_, err = replayer.Wrap(http.DefaultTransport).RoundTrip(req)
+	if err == nil {
+		t.Fatalf("Should have failed")
+	}
+}
+
+func TestRecordPlaceholders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"projectId":"pid-1","name":"instance"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "test.json")
+	doRequest := func(rt http.RoundTripper, projectId string) string {
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/v1/projects/"+projectId+"/instances", http.NoBody)
+		if err != nil {
+			t.Fatalf("Creating request: %v", err)
+		}
+		resp, err := rt.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("Calling API: %v", err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("Reading response: %v", err)
+		}
+		_ = resp.Body.Close()
+		return string(body)
+	}
+
+	recorder, err := New(ModeRecord, path, map[string]string{"pid-1": "placeholder-pid", "": "ignored"})
+	if err != nil {
+		t.Fatalf("Creating recorder: %v", err)
+	}
+	recorded := doRequest(recorder.Wrap(http.DefaultTransport), "pid-1")
+	if diff := cmp.Diff(recorded, `{"projectId":"pid-1","name":"instance"}`); diff != "" {
+		t.Fatalf("Recorded response does not match: %s", diff)
+	}
+
+	// The cassette is replayed with the placeholder as project ID
+	replayer, err := New(ModeReplay, path, nil)
+	if err != nil {
+		t.Fatalf("Creating replayer: %v", err)
+	}
+	replayed := doRequest(replayer.Wrap(http.DefaultTransport), "placeholder-pid")
+	if diff := cmp.Diff(replayed, `{"name":"instance","projectId":"placeholder-pid"}`); diff != "" {
+		t.Fatalf("Replayed response does not match: %s", diff)
+	}
+}
+
+func TestNewReplayMissingCassette(t *testing.T) {
+	_, err := New(ModeReplay, filepath.Join(t.TempDir(), "missing.json"), nil)
+	if err == nil {
+		t.Fatalf("Should have failed")
+	}
+}
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"fmt"
+
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+const authProfileDescription = "Name of the auth profile, configured in `auth_profiles` of the provider, whose credentials are used to %s. " +
+	"If not set, the credentials of the provider are used."
+
+// AuthProfileAttribute returns the schema of the auth_profile attribute.
+// Resources opting in must add it to their schema and model and pass it to core.ContextWithAuthProfile at the start of every CRUD operation.
+func AuthProfileAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf(authProfileDescription, "manage the resource"),
+		Optional:    true,
+	}
+}
+
+// AuthProfileDataSourceAttribute returns the schema of the auth_profile attribute for data sources.
+// Data sources opting in must add it to their schema and model and pass it to core.ContextWithAuthProfile at the start of Read.
+func AuthProfileDataSourceAttribute() datasourceSchema.StringAttribute {
+	return datasourceSchema.StringAttribute{
+		Description: fmt.Sprintf(authProfileDescription, "read the data source"),
+		Optional:    true,
+	}
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DeletionProtectionAttributeName is the name of the attribute which protects a resource from being deleted.
+const DeletionProtectionAttributeName = "deletion_protection"
+
+// DeletionProtectionAttribute returns the schema of the deletion_protection attribute.
+// Resources opting in must add it to their schema and model and call BlockDeletionIfProtected at the start of Delete.
+func DeletionProtectionAttribute(resourceName string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("If set to `true`, the %[1]s can't be deleted, e.g. by `terraform destroy` or a change forcing its replacement. "+
+			"To delete the %[1]s, set it to `false` and apply the change first.", resourceName),
+		Optional: true,
+	}
+}
+
+// BlockDeletionIfProtected returns whether the deletion of the resource must be aborted, because it's protected.
+// If true, an error was added to the diagnostics and Delete must return without deleting the resource, so it's kept in the state.
+func BlockDeletionIfProtected(ctx context.Context, state tfsdk.State, resourceName string, diags *diag.Diagnostics) bool {
+	var protected types.Bool
+	diags.Append(state.GetAttribute(ctx, path.Root(DeletionProtectionAttributeName), &protected)...)
+	if diags.HasError() {
+		return true
+	}
+	if !protected.ValueBool() {
+		return false
+	}
+	diags.AddError(
+		fmt.Sprintf("Error deleting %s", resourceName),
+		fmt.Sprintf("The %[1]s is protected from deletion. Set `%[2]s` to `false` and apply the change before deleting the %[1]s.", resourceName, DeletionProtectionAttributeName),
+	)
+	return true
+}
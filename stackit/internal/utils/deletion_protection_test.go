@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestBlockDeletionIfProtected(t *testing.T) {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                            schema.StringAttribute{Computed: true},
+			DeletionProtectionAttributeName: DeletionProtectionAttribute("instance"),
+		},
+	}
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":                            tftypes.String,
+			DeletionProtectionAttributeName: tftypes.Bool,
+		},
+	}
+
+	tests := []struct {
+		description string
+		protected   tftypes.Value
+		expected    bool
+	}{
+		{
+			description: "protected",
+			protected:   tftypes.NewValue(tftypes.Bool, true),
+			expected:    true,
+		},
+		{
+			description: "not protected",
+			protected:   tftypes.NewValue(tftypes.Bool, false),
+			expected:    false,
+		},
+		{
+			description: "not set",
+			protected:   tftypes.NewValue(tftypes.Bool, nil),
+			expected:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := tfsdk.State{
+				Schema: testSchema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"id":                            tftypes.NewValue(tftypes.String, "id"),
+					DeletionProtectionAttributeName: tt.protected,
+				}),
+			}
+			var diags diag.Diagnostics
+			output := BlockDeletionIfProtected(context.Background(), state, "instance", &diags)
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+			if diags.HasError() != tt.expected {
+				t.Fatalf("Expected error %t, got diagnostics: %v", tt.expected, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+)
+
+// ttlDurationRegex matches durations built from days, hours, minutes and seconds in this order, e.g. "30d" or "1d12h30m"
+var ttlDurationRegex = regexp.MustCompile(`^(?:(\d+)d)?(?:(\d+)h)?(?:(\d+)m)?(?:(\d+)s)?$`)
+
+// ParseTTLDuration parses a duration made up of days ("d"), hours ("h"), minutes ("m") and seconds ("s"), in this order, e.g. "30d" or "5h30m40s".
+func ParseTTLDuration(value string) (time.Duration, error) {
+	matches := ttlDurationRegex.FindStringSubmatch(value)
+	if value == "" || matches == nil {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	units := []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var duration time.Duration
+	for i, match := range matches[1:] {
+		if match == "" {
+			continue
+		}
+		amount, err := strconv.ParseInt(match, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", value, err)
+		}
+		duration += time.Duration(amount) * units[i]
+	}
+	return duration, nil
+}
+
+// ExpirationWarningThresholdAttribute returns the schema of the expiration_warning_threshold attribute, which enables a warning when a secret,
+// e.g. a token, is about to expire. The validators are passed by the caller, since the validate package depends on this package.
+func ExpirationWarningThresholdAttribute(secret string, validators ...validator.String) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("If set, a warning is shown when the %[1]s is read, e.g. during plan, and expires within this duration, "+
+			"so the %[1]s can be rotated before it expires. E.g. 30d,24h,5h30m", secret),
+		Optional:   true,
+		Validators: validators,
+	}
+}
+
+// WarnIfExpiring adds a warning to the diagnostics, if the secret expires within the threshold. The expiration is an RFC3339 timestamp.
+// Nothing is checked if either the threshold or the expiration isn't set.
+func WarnIfExpiring(ctx context.Context, diags *diag.Diagnostics, secret string, threshold, expiration types.String) {
+	if IsUndefined(threshold) || IsUndefined(expiration) {
+		return
+	}
+	thresholdDuration, err := ParseTTLDuration(threshold.ValueString())
+	if err != nil {
+		core.LogAndAddWarning(ctx, diags, fmt.Sprintf("Can't check the expiration of the %s", secret), fmt.Sprintf("Parsing expiration warning threshold: %v", err))
+		return
+	}
+	expiresAt, err := time.Parse(time.RFC3339, expiration.ValueString())
+	if err != nil {
+		core.LogAndAddWarning(ctx, diags, fmt.Sprintf("Can't check the expiration of the %s", secret), fmt.Sprintf("Parsing expiration: %v", err))
+		return
+	}
+
+	remaining := time.Until(expiresAt)
+	if remaining > thresholdDuration {
+		return
+	}
+	if remaining <= 0 {
+		core.LogAndAddWarning(ctx, diags, fmt.Sprintf("The %s has expired", secret), fmt.Sprintf("The %s expired at %s. Rotate it to keep using it.", secret, expiresAt.Format(time.RFC3339)))
+		return
+	}
+	core.LogAndAddWarning(ctx, diags, fmt.Sprintf("The %s expires soon", secret),
+		fmt.Sprintf("The %s expires at %s, in less than %s. Rotate it before it expires.", secret, expiresAt.Format(time.RFC3339), threshold.ValueString()))
+}
@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseTTLDuration(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expected    time.Duration
+		isValid     bool
+	}{
+		{
+			description: "days",
+			input:       "30d",
+			expected:    30 * 24 * time.Hour,
+			isValid:     true,
+		},
+		{
+			description: "all units",
+			input:       "1d12h30m15s",
+			expected:    36*time.Hour + 30*time.Minute + 15*time.Second,
+			isValid:     true,
+		},
+		{
+			description: "zero",
+			input:       "0h",
+			expected:    0,
+			isValid:     true,
+		},
+		{
+			description: "empty",
+			input:       "",
+			isValid:     false,
+		},
+		{
+			description: "wrong order",
+			input:       "30m1h",
+			isValid:     false,
+		},
+		{
+			description: "fraction",
+			input:       "1.5h",
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := ParseTTLDuration(tt.input)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("Should have failed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if output != tt.expected {
+				t.Fatalf("Expected %s, got %s", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestWarnIfExpiring(t *testing.T) {
+	in := func(d time.Duration) types.String {
+		return types.StringValue(time.Now().Add(d).Format(time.RFC3339))
+	}
+	tests := []struct {
+		description     string
+		threshold       types.String
+		expiration      types.String
+		expectedWarning string
+	}{
+		{
+			description: "no threshold",
+			threshold:   types.StringNull(),
+			expiration:  in(time.Hour),
+		},
+		{
+			description: "no expiration",
+			threshold:   types.StringValue("7d"),
+			expiration:  types.StringNull(),
+		},
+		{
+			description: "expires after threshold",
+			threshold:   types.StringValue("7d"),
+			expiration:  in(30 * 24 * time.Hour),
+		},
+		{
+			description:     "expires within threshold",
+			threshold:       types.StringValue("7d"),
+			expiration:      in(24 * time.Hour),
+			expectedWarning: "The token expires soon",
+		},
+		{
+			description:     "expired",
+			threshold:       types.StringValue("7d"),
+			expiration:      in(-time.Hour),
+			expectedWarning: "The token has expired",
+		},
+		{
+			description:     "invalid expiration",
+			threshold:       types.StringValue("7d"),
+			expiration:      types.StringValue("tomorrow"),
+			expectedWarning: "Can't check the expiration of the token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var diags diag.Diagnostics
+			WarnIfExpiring(context.Background(), &diags, "token", tt.threshold, tt.expiration)
+			if diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+			warnings := diags.Warnings()
+			if tt.expectedWarning == "" {
+				if len(warnings) != 0 {
+					t.Fatalf("Expected no warning, got %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || warnings[0].Summary() != tt.expectedWarning {
+				t.Fatalf("Expected warning %q, got %v", tt.expectedWarning, warnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+)
+
+// Identity lists the attributes which identify a resource, in the order of the parts of its import identifier.
+// All identity attributes are strings, like the parts of the import identifier.
+type Identity []string
+
+// Schema returns the identity schema of the resource.
+func (i Identity) Schema() identityschema.Schema {
+	attributes := make(map[string]identityschema.Attribute, len(i))
+	for _, name := range i {
+		attributes[name] = identityschema.StringAttribute{
+			RequiredForImport: true,
+		}
+	}
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// ImportID returns the import identifier of the resource. If the resource is imported by its identity, e.g. with the `identity` argument of an
+// import block, the import identifier is built from the identity, so that both ways of importing are handled by parsing the import identifier.
+func (i Identity) ImportID(ctx context.Context, req resource.ImportStateRequest, diags *diag.Diagnostics) string { // nolint:gocritic // the import request is passed on as is
+	if req.ID != "" || req.Identity == nil {
+		return req.ID
+	}
+
+	parts := make([]string, 0, len(i))
+	for _, name := range i {
+		var part types.String
+		diags.Append(req.Identity.GetAttribute(ctx, path.Root(name), &part)...)
+		parts = append(parts, part.ValueString())
+	}
+	return strings.Join(parts, core.Separator)
+}
+
+// SetIdentity sets the identity of the resource from the identity attributes in the first of the states which isn't null.
+// It's deferred at the start of Create, Read and Update, so the identity is set on all return paths, e.g. also when Read removes the resource.
+func SetIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, diags *diag.Diagnostics, states ...*tfsdk.State) {
+	if identity == nil {
+		return
+	}
+	for _, state := range states {
+		if state == nil || state.Raw.IsNull() {
+			continue
+		}
+
+		values := map[string]tftypes.Value{}
+		for name := range identity.Schema.GetAttributes() {
+			value, err := identityValue(state.Raw, name)
+			if err != nil {
+				core.LogAndAddError(ctx, diags, "Error setting resource identity", fmt.Sprintf("Reading attribute %q from state: %v", name, err))
+				return
+			}
+			values[name] = value
+		}
+		identity.Raw = tftypes.NewValue(identity.Schema.Type().TerraformType(ctx), values)
+		return
+	}
+}
+
+// identityValue returns the value of a state attribute as string value of the identity.
+func identityValue(state tftypes.Value, name string) (tftypes.Value, error) {
+	raw, _, err := tftypes.WalkAttributePath(state, tftypes.NewAttributePath().WithAttributeName(name))
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	value, ok := raw.(tftypes.Value)
+	if !ok {
+		return tftypes.Value{}, fmt.Errorf("unexpected type %T", raw)
+	}
+	if value.IsNull() || !value.IsKnown() {
+		return tftypes.NewValue(tftypes.String, nil), nil
+	}
+
+	switch {
+	case value.Type().Is(tftypes.String):
+		return value, nil
+	case value.Type().Is(tftypes.Number):
+		var number big.Float
+		err = value.As(&number)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		return tftypes.NewValue(tftypes.String, number.Text('f', -1)), nil
+	}
+	return tftypes.Value{}, fmt.Errorf("unsupported type %s", value.Type())
+}
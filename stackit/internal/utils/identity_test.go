@@ -0,0 +1,155 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testIdentity = Identity{"project_id", "schedule_id"}
+
+var testIdentityType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"project_id":  tftypes.String,
+		"schedule_id": tftypes.String,
+	},
+}
+
+func TestIdentityImportID(t *testing.T) {
+	tests := []struct {
+		description string
+		id          string
+		identity    *tfsdk.ResourceIdentity
+		expected    string
+	}{
+		{
+			description: "import identifier",
+			id:          "pid,sid",
+			expected:    "pid,sid",
+		},
+		{
+			description: "identity",
+			identity: &tfsdk.ResourceIdentity{
+				Schema: testIdentity.Schema(),
+				Raw: tftypes.NewValue(testIdentityType, map[string]tftypes.Value{
+					"project_id":  tftypes.NewValue(tftypes.String, "pid"),
+					"schedule_id": tftypes.NewValue(tftypes.String, "sid"),
+				}),
+			},
+			expected: "pid,sid",
+		},
+		{
+			description: "identity with missing attribute",
+			identity: &tfsdk.ResourceIdentity{
+				Schema: testIdentity.Schema(),
+				Raw: tftypes.NewValue(testIdentityType, map[string]tftypes.Value{
+					"project_id":  tftypes.NewValue(tftypes.String, "pid"),
+					"schedule_id": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			expected: "pid,",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var diags diag.Diagnostics
+			req := resource.ImportStateRequest{
+				ID:       tt.id,
+				Identity: tt.identity,
+			}
+			output := testIdentity.ImportID(context.Background(), req, &diags)
+			if diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+			if output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestSetIdentity(t *testing.T) {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id":  schema.StringAttribute{Required: true},
+			"schedule_id": schema.Int64Attribute{Computed: true},
+			"name":        schema.StringAttribute{Required: true},
+		},
+	}
+	stateType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"project_id":  tftypes.String,
+			"schedule_id": tftypes.Number,
+			"name":        tftypes.String,
+		},
+	}
+	state := &tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(stateType, map[string]tftypes.Value{
+			"project_id":  tftypes.NewValue(tftypes.String, "pid"),
+			"schedule_id": tftypes.NewValue(tftypes.Number, 5),
+			"name":        tftypes.NewValue(tftypes.String, "name"),
+		}),
+	}
+	priorState := &tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(stateType, map[string]tftypes.Value{
+			"project_id":  tftypes.NewValue(tftypes.String, "prior-pid"),
+			"schedule_id": tftypes.NewValue(tftypes.Number, nil),
+			"name":        tftypes.NewValue(tftypes.String, "name"),
+		}),
+	}
+	removedState := &tfsdk.State{
+		Schema: testSchema,
+		Raw:    tftypes.NewValue(stateType, nil),
+	}
+
+	tests := []struct {
+		description string
+		states      []*tfsdk.State
+		expected    tftypes.Value
+	}{
+		{
+			description: "state",
+			states:      []*tfsdk.State{state, priorState},
+			expected: tftypes.NewValue(testIdentityType, map[string]tftypes.Value{
+				"project_id":  tftypes.NewValue(tftypes.String, "pid"),
+				"schedule_id": tftypes.NewValue(tftypes.String, "5"),
+			}),
+		},
+		{
+			description: "removed state",
+			states:      []*tfsdk.State{removedState, priorState},
+			expected: tftypes.NewValue(testIdentityType, map[string]tftypes.Value{
+				"project_id":  tftypes.NewValue(tftypes.String, "prior-pid"),
+				"schedule_id": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		{
+			description: "no state",
+			states:      []*tfsdk.State{removedState},
+			expected:    tftypes.NewValue(testIdentityType, nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var diags diag.Diagnostics
+			identity := &tfsdk.ResourceIdentity{
+				Schema: testIdentity.Schema(),
+				Raw:    tftypes.NewValue(testIdentityType, nil),
+			}
+			SetIdentity(context.Background(), identity, &diags, tt.states...)
+			if diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+			if !identity.Raw.Equal(tt.expected) {
+				t.Fatalf("Expected %s, got %s", tt.expected, identity.Raw)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+)
+
+// KubernetesSecretTypes are the types of the render_kubernetes_secret attribute
+var KubernetesSecretTypes = map[string]attr.Type{
+	"manifest":  types.StringType,
+	"name":      types.StringType,
+	"namespace": types.StringType,
+}
+
+// kubernetesSecretManifest is the manifest of a Kubernetes Secret, see https://kubernetes.io/docs/concepts/configuration/secret/
+type kubernetesSecretManifest struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Metadata   map[string]string `json:"metadata"`
+	Type       string            `json:"type"`
+	Data       map[string]string `json:"data"`
+}
+
+// KubernetesSecretAttribute returns the schema of the render_kubernetes_secret attribute, which renders a Kubernetes Secret manifest
+// containing a secret, e.g. a credential, so it can be handed to the kubernetes provider without templating each field.
+func KubernetesSecretAttribute(secret string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: fmt.Sprintf("If set, a Kubernetes Secret manifest containing the %[1]s is rendered, e.g. for the `kubernetes_manifest` resource of the kubernetes provider. "+
+			"Changing it doesn't recreate the %[1]s.", secret),
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"manifest": schema.StringAttribute{
+				Description: "The Kubernetes Secret manifest in JSON, which is also valid YAML. The values in `data` are base64-encoded.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the Kubernetes Secret.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 253),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Description: "Namespace of the Kubernetes Secret. If not set, the namespace is chosen when the manifest is applied.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+		},
+	}
+}
+
+// RenderKubernetesSecret returns the render_kubernetes_secret attribute with the manifest of a Kubernetes Secret with the given data.
+// Data values which aren't set are left out of the Secret. Nothing is rendered if the attribute isn't set.
+func RenderKubernetesSecret(kubernetesSecret types.Object, data map[string]attr.Value) (types.Object, error) {
+	if kubernetesSecret.IsNull() || kubernetesSecret.IsUnknown() {
+		return kubernetesSecret, nil
+	}
+
+	attributes := kubernetesSecret.Attributes()
+	name, _ := attributes["name"].(types.String)
+	namespace, _ := attributes["namespace"].(types.String)
+	if IsUndefined(name) {
+		return types.ObjectNull(KubernetesSecretTypes), fmt.Errorf("name of the Kubernetes Secret isn't set")
+	}
+
+	metadata := map[string]string{
+		"name": name.ValueString(),
+	}
+	if !IsUndefined(namespace) {
+		metadata["namespace"] = namespace.ValueString()
+	}
+
+	encodedData := map[string]string{}
+	for key, value := range data {
+		var decoded string
+		switch v := value.(type) {
+		case types.String:
+			if IsUndefined(v) {
+				continue
+			}
+			decoded = v.ValueString()
+		case types.Int64:
+			if v.IsNull() || v.IsUnknown() {
+				continue
+			}
+			decoded = strconv.FormatInt(v.ValueInt64(), 10)
+		default:
+			return types.ObjectNull(KubernetesSecretTypes), fmt.Errorf("unsupported type %T of %q", value, key)
+		}
+		encodedData[key] = base64.StdEncoding.EncodeToString([]byte(decoded))
+	}
+
+	manifest, err := json.MarshalIndent(kubernetesSecretManifest{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Metadata:   metadata,
+		Type:       "Opaque",
+		Data:       encodedData,
+	}, "", "  ")
+	if err != nil {
+		return types.ObjectNull(KubernetesSecretTypes), fmt.Errorf("encoding manifest: %w", err)
+	}
+
+	kubernetesSecretTF, diags := types.ObjectValue(KubernetesSecretTypes, map[string]attr.Value{
+		"manifest":  types.StringValue(string(manifest)),
+		"name":      name,
+		"namespace": namespace,
+	})
+	if diags.HasError() {
+		return types.ObjectNull(KubernetesSecretTypes), core.DiagsToError(diags)
+	}
+	return kubernetesSecretTF, nil
+}
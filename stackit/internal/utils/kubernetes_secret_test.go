@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRenderKubernetesSecret(t *testing.T) {
+	kubernetesSecret := func(name, namespace types.String, manifest types.String) types.Object {
+		return types.ObjectValueMust(KubernetesSecretTypes, map[string]attr.Value{
+			"manifest":  manifest,
+			"name":      name,
+			"namespace": namespace,
+		})
+	}
+	data := map[string]attr.Value{
+		"host":     types.StringValue("host"),
+		"password": types.StringValue("secret"),
+		"port":     types.Int64Value(6379),
+		"uri":      types.StringNull(),
+	}
+	tests := []struct {
+		description      string
+		kubernetesSecret types.Object
+		data             map[string]attr.Value
+		expected         types.Object
+		isValid          bool
+	}{
+		{
+			description:      "not set",
+			kubernetesSecret: types.ObjectNull(KubernetesSecretTypes),
+			data:             data,
+			expected:         types.ObjectNull(KubernetesSecretTypes),
+			isValid:          true,
+		},
+		{
+			description:      "with namespace",
+			kubernetesSecret: kubernetesSecret(types.StringValue("redis"), types.StringValue("apps"), types.StringUnknown()),
+			data:             data,
+			expected: kubernetesSecret(types.StringValue("redis"), types.StringValue("apps"), types.StringValue(`{
+  "apiVersion": "v1",
+  "kind": "Secret",
+  "metadata": {
+    "name": "redis",
+    "namespace": "apps"
+  },
+  "type": "Opaque",
+  "data": {
+    "host": "aG9zdA==",
+    "password": "c2VjcmV0",
+    "port": "NjM3OQ=="
+  }
+}`)),
+			isValid: true,
+		},
+		{
+			description:      "without namespace",
+			kubernetesSecret: kubernetesSecret(types.StringValue("redis"), types.StringNull(), types.StringUnknown()),
+			data: map[string]attr.Value{
+				"username": types.StringValue("user"),
+			},
+			expected: kubernetesSecret(types.StringValue("redis"), types.StringNull(), types.StringValue(`{
+  "apiVersion": "v1",
+  "kind": "Secret",
+  "metadata": {
+    "name": "redis"
+  },
+  "type": "Opaque",
+  "data": {
+    "username": "dXNlcg=="
+  }
+}`)),
+			isValid: true,
+		},
+		{
+			description:      "unsupported type",
+			kubernetesSecret: kubernetesSecret(types.StringValue("redis"), types.StringNull(), types.StringUnknown()),
+			data: map[string]attr.Value{
+				"enabled": types.BoolValue(true),
+			},
+			isValid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := RenderKubernetesSecret(tt.kubernetesSecret, tt.data)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
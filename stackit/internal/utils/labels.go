@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RemoveSystemLabels removes the labels, which are added by the API itself, from the labels returned by the API,
+// so that they don't show up as a diff to the labels in the Terraform configuration.
+// System labels are identified by the prefix of their key. System labels which are also contained in
+// priorLabels (from plan or state) are kept, as they were set by the user.
+//
+// System labels are suppressed when mapping the API response and not with a plan modifier: the labels attributes
+// are optional, but not computed, so Terraform rejects a planned value which differs from the configuration.
+// All resources with resource labels suppress system labels, the IaaS resources via iaasUtils.MapLabels and the
+// Resource Manager resources via this function. Excluded are maps named labels which aren't labels of the resource,
+// so the API doesn't add system labels to them: the labels of observability alert rules and scrape configs,
+// and the Kubernetes labels of SKE node pools.
+func RemoveSystemLabels[V any](labels map[string]V, priorLabels types.Map, systemLabelPrefixes []string) map[string]V {
+	if len(labels) == 0 || len(systemLabelPrefixes) == 0 {
+		return labels
+	}
+
+	priorElements := priorLabels.Elements()
+	filtered := make(map[string]V, len(labels))
+	for k, v := range labels {
+		if isSystemLabel(k, systemLabelPrefixes) {
+			if _, isPrior := priorElements[k]; !isPrior {
+				continue
+			}
+		}
+		filtered[k] = v
+	}
+	return filtered
+}
+
+func isSystemLabel(key string, systemLabelPrefixes []string) bool {
+	for _, prefix := range systemLabelPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
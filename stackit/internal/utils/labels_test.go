@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRemoveSystemLabels(t *testing.T) {
+	prefixes := []string{"stackit.cloud/"}
+	tests := []struct {
+		description string
+		labels      map[string]interface{}
+		priorLabels types.Map
+		prefixes    []string
+		expected    map[string]interface{}
+	}{
+		{
+			description: "system labels removed",
+			labels: map[string]interface{}{
+				"key":                  "value",
+				"stackit.cloud/system": "value",
+			},
+			priorLabels: types.MapNull(types.StringType),
+			prefixes:    prefixes,
+			expected: map[string]interface{}{
+				"key": "value",
+			},
+		},
+		{
+			description: "system labels from prior labels kept",
+			labels: map[string]interface{}{
+				"key":                  "value",
+				"stackit.cloud/system": "value",
+				"stackit.cloud/user":   "value",
+			},
+			priorLabels: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"stackit.cloud/user": types.StringValue("value"),
+			}),
+			prefixes: prefixes,
+			expected: map[string]interface{}{
+				"key":                "value",
+				"stackit.cloud/user": "value",
+			},
+		},
+		{
+			description: "only system labels",
+			labels: map[string]interface{}{
+				"stackit.cloud/system": "value",
+			},
+			priorLabels: types.MapNull(types.StringType),
+			prefixes:    prefixes,
+			expected:    map[string]interface{}{},
+		},
+		{
+			description: "no prefixes",
+			labels: map[string]interface{}{
+				"stackit.cloud/system": "value",
+			},
+			priorLabels: types.MapNull(types.StringType),
+			prefixes:    nil,
+			expected: map[string]interface{}{
+				"stackit.cloud/system": "value",
+			},
+		},
+		{
+			description: "nil labels",
+			labels:      nil,
+			priorLabels: types.MapNull(types.StringType),
+			prefixes:    prefixes,
+			expected:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := RemoveSystemLabels(tt.labels, tt.priorLabels, tt.prefixes)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LifecyclePausedAttributeName is the name of the attribute which pauses the reconciliation of a resource.
+const LifecyclePausedAttributeName = "lifecycle_paused"
+
+// LifecyclePausedAttribute returns the schema of the lifecycle_paused attribute.
+// Resources opting in must add it to their schema and model and call SkipReadIfLifecyclePaused at the start of Read.
+func LifecyclePausedAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: "If set to `true`, the resource isn't refreshed from the API anymore, so changes done outside of Terraform, e.g. during a planned maintenance, " +
+			"don't show up as drift until the pause is lifted. Changes to the configuration are still applied.",
+		Optional: true,
+	}
+}
+
+// SkipReadIfLifecyclePaused returns whether the refresh of the resource should be skipped, because its reconciliation is paused.
+// If true, Read must return without setting the state, so the prior state is kept.
+func SkipReadIfLifecyclePaused(ctx context.Context, state tfsdk.State, diags *diag.Diagnostics) bool {
+	var paused types.Bool
+	diags.Append(state.GetAttribute(ctx, path.Root(LifecyclePausedAttributeName), &paused)...)
+	if diags.HasError() || !paused.ValueBool() {
+		return false
+	}
+	tflog.Info(ctx, "Reconciliation of the resource is paused, skipping refresh")
+	return true
+}
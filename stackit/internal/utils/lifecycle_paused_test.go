@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSkipReadIfLifecyclePaused(t *testing.T) {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                         schema.StringAttribute{Computed: true},
+			LifecyclePausedAttributeName: LifecyclePausedAttribute(),
+		},
+	}
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":                         tftypes.String,
+			LifecyclePausedAttributeName: tftypes.Bool,
+		},
+	}
+
+	tests := []struct {
+		description string
+		paused      tftypes.Value
+		expected    bool
+	}{
+		{
+			description: "paused",
+			paused:      tftypes.NewValue(tftypes.Bool, true),
+			expected:    true,
+		},
+		{
+			description: "not paused",
+			paused:      tftypes.NewValue(tftypes.Bool, false),
+			expected:    false,
+		},
+		{
+			description: "not set",
+			paused:      tftypes.NewValue(tftypes.Bool, nil),
+			expected:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := tfsdk.State{
+				Schema: testSchema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"id":                         tftypes.NewValue(tftypes.String, "id"),
+					LifecyclePausedAttributeName: tt.paused,
+				}),
+			}
+			var diags diag.Diagnostics
+			output := SkipReadIfLifecyclePaused(context.Background(), state, &diags)
+			if diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
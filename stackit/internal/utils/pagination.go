@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// Page is a single page of a paginated list response.
+type Page[T any] struct {
+	Items []T
+	// NextPageToken identifies the next page. An empty token signals that there are no more pages.
+	NextPageToken string
+}
+
+// ListAllPages calls listPage for every page of a token based paginated list endpoint and returns the items of all pages.
+// The first page is requested with an empty token, afterwards the returned NextPageToken is followed until it is empty.
+func ListAllPages[T any](ctx context.Context, listPage func(ctx context.Context, pageToken string) (Page[T], error)) ([]T, error) {
+	items := []T{}
+	seenTokens := map[string]struct{}{}
+	pageToken := ""
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		page, err := listPage(ctx, pageToken)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+
+		if page.NextPageToken == "" {
+			return items, nil
+		}
+		// Guard against endpoints returning the same token over and over again, which would result in an endless loop
+		if _, ok := seenTokens[page.NextPageToken]; ok {
+			return nil, fmt.Errorf("page token %q was returned more than once", page.NextPageToken)
+		}
+		seenTokens[page.NextPageToken] = struct{}{}
+		pageToken = page.NextPageToken
+	}
+}
+
+// ListAllNumberedPages calls listPage for every page of a page number based paginated list endpoint and returns the items of all pages.
+// Pages are counted from 1 and requested until the total number of pages, as returned by the endpoint, is reached.
+func ListAllNumberedPages[T any](ctx context.Context, listPage func(ctx context.Context, page int32) (items []T, totalPages int64, err error)) ([]T, error) {
+	return ListAllPages(ctx, func(ctx context.Context, pageToken string) (Page[T], error) {
+		page := int32(1)
+		if pageToken != "" {
+			parsedPage, err := strconv.ParseInt(pageToken, 10, 32)
+			if err != nil {
+				return Page[T]{}, fmt.Errorf("parse page number: %w", err)
+			}
+			page = int32(parsedPage)
+		}
+
+		items, totalPages, err := listPage(ctx, page)
+		if err != nil {
+			return Page[T]{}, err
+		}
+
+		nextPageToken := ""
+		if int64(page) < totalPages {
+			nextPageToken = strconv.FormatInt(int64(page)+1, 10)
+		}
+		return Page[T]{
+			Items:         items,
+			NextPageToken: nextPageToken,
+		}, nil
+	})
+}
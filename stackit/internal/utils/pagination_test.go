@@ -0,0 +1,186 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListAllPages(t *testing.T) {
+	tests := []struct {
+		description    string
+		pages          map[string]Page[string]
+		failOnToken    string
+		expected       []string
+		expectedTokens []string
+		isValid        bool
+	}{
+		{
+			description: "single page",
+			pages: map[string]Page[string]{
+				"": {Items: []string{"a", "b"}},
+			},
+			expected:       []string{"a", "b"},
+			expectedTokens: []string{""},
+			isValid:        true,
+		},
+		{
+			description: "multiple pages",
+			pages: map[string]Page[string]{
+				"":   {Items: []string{"a", "b"}, NextPageToken: "p2"},
+				"p2": {Items: []string{"c"}, NextPageToken: "p3"},
+				"p3": {Items: []string{"d"}},
+			},
+			expected:       []string{"a", "b", "c", "d"},
+			expectedTokens: []string{"", "p2", "p3"},
+			isValid:        true,
+		},
+		{
+			description: "empty pages",
+			pages: map[string]Page[string]{
+				"":   {NextPageToken: "p2"},
+				"p2": {},
+			},
+			expected:       []string{},
+			expectedTokens: []string{"", "p2"},
+			isValid:        true,
+		},
+		{
+			description: "error on later page",
+			pages: map[string]Page[string]{
+				"":   {Items: []string{"a"}, NextPageToken: "p2"},
+				"p2": {Items: []string{"b"}},
+			},
+			failOnToken: "p2",
+			isValid:     false,
+		},
+		{
+			description: "repeated page token",
+			pages: map[string]Page[string]{
+				"":   {Items: []string{"a"}, NextPageToken: "p2"},
+				"p2": {Items: []string{"b"}, NextPageToken: "p2"},
+			},
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var tokens []string
+			items, err := ListAllPages(context.Background(), func(_ context.Context, pageToken string) (Page[string], error) {
+				tokens = append(tokens, pageToken)
+				if tt.failOnToken != "" && pageToken == tt.failOnToken {
+					return Page[string]{}, fmt.Errorf("request failed")
+				}
+				page, ok := tt.pages[pageToken]
+				if !ok {
+					t.Fatalf("unexpected page token %q", pageToken)
+				}
+				return page, nil
+			})
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				if diff := cmp.Diff(tt.expected, items); diff != "" {
+					t.Fatalf("Items do not match: %s", diff)
+				}
+				if diff := cmp.Diff(tt.expectedTokens, tokens); diff != "" {
+					t.Fatalf("Requested page tokens do not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestListAllPagesContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ListAllPages(ctx, func(_ context.Context, _ string) (Page[string], error) {
+		t.Fatalf("no page should be requested")
+		return Page[string]{}, nil
+	})
+	if err == nil {
+		t.Fatalf("Should have failed")
+	}
+}
+
+func TestListAllNumberedPages(t *testing.T) {
+	tests := []struct {
+		description   string
+		pages         [][]int
+		totalPages    int64
+		failOnPage    int32
+		expected      []int
+		expectedPages []int32
+		isValid       bool
+	}{
+		{
+			description:   "single page",
+			pages:         [][]int{{1, 2}},
+			totalPages:    1,
+			expected:      []int{1, 2},
+			expectedPages: []int32{1},
+			isValid:       true,
+		},
+		{
+			description:   "multiple pages",
+			pages:         [][]int{{1, 2}, {3, 4}, {5}},
+			totalPages:    3,
+			expected:      []int{1, 2, 3, 4, 5},
+			expectedPages: []int32{1, 2, 3},
+			isValid:       true,
+		},
+		{
+			description:   "no pages",
+			pages:         [][]int{{}},
+			totalPages:    0,
+			expected:      []int{},
+			expectedPages: []int32{1},
+			isValid:       true,
+		},
+		{
+			description: "error on later page",
+			pages:       [][]int{{1}, {2}},
+			totalPages:  2,
+			failOnPage:  2,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var requestedPages []int32
+			items, err := ListAllNumberedPages(context.Background(), func(_ context.Context, page int32) ([]int, int64, error) {
+				requestedPages = append(requestedPages, page)
+				if page == tt.failOnPage {
+					return nil, 0, fmt.Errorf("request failed")
+				}
+				if int(page) > len(tt.pages) {
+					t.Fatalf("unexpected page %d", page)
+				}
+				return tt.pages[page-1], tt.totalPages, nil
+			})
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				if diff := cmp.Diff(tt.expected, items); diff != "" {
+					t.Fatalf("Items do not match: %s", diff)
+				}
+				if diff := cmp.Diff(tt.expectedPages, requestedPages); diff != "" {
+					t.Fatalf("Requested pages do not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -9,14 +9,16 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 )
 
-// AdaptRegion rewrites the region of a terraform plan
+// AdaptRegion rewrites the region of a terraform plan.
+// It fails the plan if neither the resource nor the provider has a region configured,
+// so defaultRegion must be the configured region of the provider (see core.ProviderData.GetConfiguredRegion).
 func AdaptRegion(ctx context.Context, configRegion types.String, planRegion *types.String, defaultRegion string, resp *resource.ModifyPlanResponse) {
 	// Get the intended region. This is either set directly set in the individual
 	// config or the provider region has to be used
 	var intendedRegion types.String
 	if configRegion.IsNull() {
 		if defaultRegion == "" {
-			core.LogAndAddError(ctx, &resp.Diagnostics, "set region", "no region defined in config or provider")
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error setting region", "No region defined in the resource or the provider. Set the \"region\" attribute of the resource or the \"default_region\" attribute of the provider.")
 			return
 		}
 		intendedRegion = types.StringValue(defaultRegion)
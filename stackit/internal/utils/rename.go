@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+)
+
+// renameRejectedKey is the private state key, which marks that the API rejected renaming the resource in place.
+const renameRejectedKey = "rename_rejected"
+
+// renameRejectedStatusCodes are the status codes with which the APIs reject an in-place rename.
+var renameRejectedStatusCodes = []int{http.StatusBadRequest, http.StatusUnprocessableEntity}
+
+// privateState is implemented by the private state of the resource requests and responses.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// RequiresReplaceIfRenameRejected returns a plan modifier for the name attribute of resources which are renamed in place.
+// It falls back to replacing the resource on a name change, once the API rejected renaming it (see LogUpdateErrorWithRenameFallback).
+func RequiresReplaceIfRenameRejected() planmodifier.String {
+	description := "If the API rejected renaming the resource in place before, changing this value requires replacing the resource."
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			if req.Private == nil {
+				return
+			}
+			resp.RequiresReplace = isRenameRejected(ctx, req.Private, &resp.Diagnostics)
+		},
+		description,
+		description,
+	)
+}
+
+// LogUpdateErrorWithRenameFallback logs an error of the update API call. If the name was changed and the API rejected the update,
+// this is stored in the private state, so that RequiresReplaceIfRenameRejected replaces the resource in the next plan.
+func LogUpdateErrorWithRenameFallback(ctx context.Context, diags *diag.Diagnostics, private privateState, err error, nameChanged bool, summary string) {
+	var oapiErr *oapierror.GenericOpenAPIError
+	if !nameChanged || !errors.As(err, &oapiErr) || !slices.Contains(renameRejectedStatusCodes, oapiErr.StatusCode) {
+		core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	diags.Append(private.SetKey(ctx, renameRejectedKey, []byte("true"))...)
+	core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("The API rejected renaming the resource in place: %v. Plan and apply again to replace the resource with the new name instead.", err))
+}
+
+func isRenameRejected(ctx context.Context, private privateState, diags *diag.Diagnostics) bool {
+	value, getDiags := private.GetKey(ctx, renameRejectedKey)
+	diags.Append(getDiags...)
+	return string(value) == "true"
+}
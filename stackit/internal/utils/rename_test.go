@@ -0,0 +1,208 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+type fakePrivateState map[string][]byte
+
+func (p fakePrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p fakePrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func TestLogUpdateErrorWithRenameFallback(t *testing.T) {
+	tests := []struct {
+		description string
+		err         error
+		nameChanged bool
+		expected    bool
+	}{
+		{
+			description: "rename rejected",
+			err:         &oapierror.GenericOpenAPIError{StatusCode: http.StatusBadRequest},
+			nameChanged: true,
+			expected:    true,
+		},
+		{
+			description: "rename rejected unprocessable",
+			err:         &oapierror.GenericOpenAPIError{StatusCode: http.StatusUnprocessableEntity},
+			nameChanged: true,
+			expected:    true,
+		},
+		{
+			description: "name not changed",
+			err:         &oapierror.GenericOpenAPIError{StatusCode: http.StatusBadRequest},
+			nameChanged: false,
+			expected:    false,
+		},
+		{
+			description: "other status code",
+			err:         &oapierror.GenericOpenAPIError{StatusCode: http.StatusInternalServerError},
+			nameChanged: true,
+			expected:    false,
+		},
+		{
+			description: "no api error",
+			err:         fmt.Errorf("some error"),
+			nameChanged: true,
+			expected:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctx := context.Background()
+			private := fakePrivateState{}
+			var diags diag.Diagnostics
+			LogUpdateErrorWithRenameFallback(ctx, &diags, private, tt.err, tt.nameChanged, "Error updating resource")
+			if !diags.HasError() {
+				t.Fatalf("Expected an error diagnostic")
+			}
+			output := isRenameRejected(ctx, private, &diags)
+			if output != tt.expected {
+				t.Fatalf("Expected rename rejected to be %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
+
+// renameTestResource is a minimal resource, whose name attribute uses RequiresReplaceIfRenameRejected.
+type renameTestResource struct{}
+
+func (r *renameTestResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "test_rename"
+}
+
+func (r *renameTestResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					RequiresReplaceIfRenameRejected(),
+				},
+			},
+		},
+	}
+}
+
+func (r *renameTestResource) Create(_ context.Context, _ resource.CreateRequest, _ *resource.CreateResponse) {
+}
+
+func (r *renameTestResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+func (r *renameTestResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *renameTestResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// renameTestProvider is a minimal provider serving renameTestResource.
+type renameTestProvider struct{}
+
+func (p *renameTestProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "test"
+}
+
+func (p *renameTestProvider) Schema(_ context.Context, _ provider.SchemaRequest, _ *provider.SchemaResponse) {
+}
+
+func (p *renameTestProvider) Configure(_ context.Context, _ provider.ConfigureRequest, _ *provider.ConfigureResponse) {
+}
+
+func (p *renameTestProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		func() resource.Resource { return &renameTestResource{} },
+	}
+}
+
+func (p *renameTestProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func TestRequiresReplaceIfRenameRejected(t *testing.T) {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}
+	dynamicValue := func(name string) *tfprotov6.DynamicValue {
+		value, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, name),
+		}))
+		if err != nil {
+			t.Fatalf("creating dynamic value: %v", err)
+		}
+		return &value
+	}
+	renameRejectedPrivate, err := json.Marshal(map[string][]byte{renameRejectedKey: []byte("true")})
+	if err != nil {
+		t.Fatalf("encoding private state: %v", err)
+	}
+
+	tests := []struct {
+		description     string
+		priorPrivate    []byte
+		newName         string
+		requiresReplace bool
+	}{
+		{
+			description:     "rename in place",
+			priorPrivate:    nil,
+			newName:         "new-name",
+			requiresReplace: false,
+		},
+		{
+			description:     "rename rejected before",
+			priorPrivate:    renameRejectedPrivate,
+			newName:         "new-name",
+			requiresReplace: true,
+		},
+		{
+			description:     "rename rejected before, name unchanged",
+			priorPrivate:    renameRejectedPrivate,
+			newName:         "name",
+			requiresReplace: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			server := providerserver.NewProtocol6(&renameTestProvider{})()
+			resp, err := server.PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{
+				TypeName:         "test_rename",
+				PriorState:       dynamicValue("name"),
+				ProposedNewState: dynamicValue(tt.newName),
+				Config:           dynamicValue(tt.newName),
+				PriorPrivate:     tt.priorPrivate,
+			})
+			if err != nil {
+				t.Fatalf("planning: %v", err)
+			}
+			for _, d := range resp.Diagnostics {
+				if d.Severity == tfprotov6.DiagnosticSeverityError {
+					t.Fatalf("planning: %s: %s", d.Summary, d.Detail)
+				}
+			}
+			requiresReplace := len(resp.RequiresReplace) > 0
+			if requiresReplace != tt.requiresReplace {
+				t.Fatalf("Expected requires replace to be %t, got %t", tt.requiresReplace, requiresReplace)
+			}
+		})
+	}
+}
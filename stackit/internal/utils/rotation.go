@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RotateWhenChangedAttribute returns the schema of the rotate_when_changed attribute, which forces the recreation of a secret, e.g. a credential, when it changes.
+func RotateWhenChangedAttribute(secret string) schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: fmt.Sprintf("A map of arbitrary key/value pairs that will force recreation of the %[1]s when they change, "+
+			"enabling %[1]s rotation based on external conditions such as a rotating timestamp. "+
+			"Changing this forces a new resource to be created.", secret),
+		Optional:    true,
+		ElementType: types.StringType,
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.RequiresReplace(),
+		},
+	}
+}
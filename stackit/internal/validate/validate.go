@@ -131,7 +131,7 @@ func RecordSet() *Validator {
 						req.ConfigValue.ValueString(),
 					))
 				}
-			case "CNAME":
+			case "CNAME", "PTR":
 				name := req.ConfigValue.ValueString()
 				if name == "" || name[len(name)-1] != '.' {
 					resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
@@ -339,6 +339,37 @@ func ValidDurationString() *Validator {
 	}
 }
 
+// TTLDurationString returns a Validator that checks if the input is a positive duration made up of
+// days ("d"), hours ("h"), minutes ("m") and seconds ("s"), in this order. Unlike ValidDurationString,
+// days are supported, while negative and fractional values as well as sub-second units are rejected.
+func TTLDurationString() *Validator {
+	description := "value must be a positive duration composed of days (\"d\"), hours (\"h\"), minutes (\"m\") and seconds (\"s\"), in this order. Such as \"30d\", \"24h\" or \"5h30m40s\""
+
+	return &Validator{
+		description: description,
+		validate: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			value := req.ConfigValue.ValueString()
+			duration, err := utils.ParseTTLDuration(value)
+			if err != nil {
+				resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+					req.Path,
+					description,
+					value,
+				))
+				return
+			}
+			if duration > 0 {
+				return
+			}
+			resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+				req.Path,
+				"value must be a duration greater than zero",
+				value,
+			))
+		},
+	}
+}
+
 // ValidNoTrailingNewline returns a Validator that checks if the input string has no trailing newline
 // character ("\n" or "\r\n"). If a trailing newline is present, a diagnostic error will be appended.
 func ValidNoTrailingNewline() *Validator {
@@ -275,6 +275,18 @@ func TestRecordSet(t *testing.T) {
 			"CNAME",
 			true,
 		},
+		{
+			"PTR record Not a Fully Qualified Domain Name",
+			"host.stackit.de",
+			"PTR",
+			false,
+		},
+		{
+			"PTR record ok Fully Qualified Domain Name",
+			"host.stackit.de.",
+			"PTR",
+			true,
+		},
 		{
 			"NS record",
 			"some-record",
@@ -896,6 +908,107 @@ func TestValidTtlDuration(t *testing.T) {
 	}
 }
 
+func TestTTLDurationString(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"valid duration with days",
+			"30d",
+			true,
+		},
+		{
+			"valid duration with hours",
+			"24h",
+			true,
+		},
+		{
+			"valid duration with hours, minutes, and seconds",
+			"5h30m40s",
+			true,
+		},
+		{
+			"valid duration with all units",
+			"1d12h30m15s",
+			true,
+		},
+		{
+			"valid duration with minutes only",
+			"30m",
+			true,
+		},
+		{
+			"valid duration with seconds only",
+			"30s",
+			true,
+		},
+		{
+			"invalid duration with units in wrong order",
+			"30m5h",
+			false,
+		},
+		{
+			"invalid duration with weeks",
+			"2w",
+			false,
+		},
+		{
+			"invalid duration with milliseconds",
+			"300ms",
+			false,
+		},
+		{
+			"invalid negative duration",
+			"-5h",
+			false,
+		},
+		{
+			"invalid fractional duration",
+			"1.5h",
+			false,
+		},
+		{
+			"invalid duration without unit",
+			"30",
+			false,
+		},
+		{
+			"invalid zero duration",
+			"0d0h",
+			false,
+		},
+		{
+			"invalid duration with whitespace",
+			"5h 30m",
+			false,
+		},
+		{
+			"empty string",
+			"",
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			va := TTLDurationString()
+			va.ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Expected validation to fail for input: %v", tt.input)
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Expected validation to succeed for input: %v, but got errors: %v", tt.input, r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
 func TestValidNoTrailingNewline(t *testing.T) {
 	tests := []struct {
 		description string
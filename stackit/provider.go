@@ -2,12 +2,18 @@ package stackit
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"os"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	sdkauth "github.com/stackitcloud/stackit-sdk-go/core/auth"
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
@@ -24,7 +31,9 @@ import (
 	cdnCustomDomain "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/cdn/customdomain"
 	cdn "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/cdn/distribution"
 	dnsRecordSet "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dns/recordset"
+	dnsReverseName "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dns/reversename"
 	dnsZone "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dns/zone"
+	dnsZoneTransfer "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dns/zonetransfer"
 	gitInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/instance"
 	iaasAffinityGroup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/affinitygroup"
 	iaasImage "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/image"
@@ -37,20 +46,25 @@ import (
 	iaasNetworkAreaRoute "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/networkarearoute"
 	iaasNetworkInterface "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/networkinterface"
 	iaasNetworkInterfaceAttach "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/networkinterfaceattach"
+	iaasNetworkRoutingTableAttachment "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/networkroutingtableattachment"
 	iaasProject "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/project"
 	iaasPublicIp "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/publicip"
 	iaasPublicIpAssociate "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/publicipassociate"
 	iaasPublicIpRanges "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/publicipranges"
+	iaasQuotas "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/quotas"
 	iaasSecurityGroup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/securitygroup"
 	iaasSecurityGroupRule "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/securitygrouprule"
 	iaasServer "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/server"
+	iaasServerLog "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/serverlog"
 	iaasServiceAccountAttach "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/serviceaccountattach"
 	iaasVolume "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/volume"
 	iaasVolumeAttach "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/volumeattach"
+	iaasVolumeTypes "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/volumetypes"
 	iaasalphaRoutingTableRoute "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/routingtable/route"
 	iaasalphaRoutingTableRoutes "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/routingtable/routes"
 	iaasalphaRoutingTable "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/routingtable/table"
 	iaasalphaRoutingTables "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/routingtable/tables"
+	inventory "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/inventory"
 	kmsKey "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/kms/key"
 	kmsKeyRing "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/kms/keyring"
 	kmsWrappingKey "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/kms/wrapping-key"
@@ -60,12 +74,14 @@ import (
 	logMeInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/logme/instance"
 	mariaDBCredential "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mariadb/credential"
 	mariaDBInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mariadb/instance"
+	mariaDBPlans "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mariadb/plans"
 	modelServingToken "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/modelserving/token"
 	mongoDBFlexInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mongodbflex/instance"
 	mongoDBFlexUser "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/mongodbflex/user"
 	objectStorageBucket "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/objectstorage/bucket"
 	objecStorageCredential "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/objectstorage/credential"
 	objecStorageCredentialsGroup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/objectstorage/credentialsgroup"
+	objectStorageProject "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/objectstorage/project"
 	alertGroup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/alertgroup"
 	observabilityCredential "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/credential"
 	observabilityInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/instance"
@@ -90,8 +106,10 @@ import (
 	serverBackupSchedule "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverbackup/schedule"
 	serverUpdateSchedule "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serverupdate/schedule"
 	serviceAccount "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serviceaccount/account"
+	serviceAccountEmail "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serviceaccount/email"
 	serviceAccountKey "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serviceaccount/key"
 	serviceAccountToken "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serviceaccount/token"
+	serviceEnablementService "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serviceenablement/service"
 	exportpolicy "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sfs/export-policy"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sfs/resourcepool"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sfs/share"
@@ -100,24 +118,36 @@ import (
 	skeKubeconfig "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/kubeconfig"
 	sqlServerFlexInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/instance"
 	sqlServerFlexUser "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/user"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
 // Ensure the implementation satisfies the expected interfaces
 var (
 	_ provider.Provider                       = &Provider{}
 	_ provider.ProviderWithEphemeralResources = &Provider{}
+	_ provider.ProviderWithFunctions          = &Provider{}
 )
 
 // Provider is the provider implementation.
 type Provider struct {
 	version string
+	// roundTripperWrapper wraps the round tripper of the API clients, if set.
+	// The acceptance tests use it to record and replay the API interactions.
+	roundTripperWrapper func(next http.RoundTripper) http.RoundTripper
 }
 
 // New is a helper function to simplify provider server and testing implementation.
 func New(version string) func() provider.Provider {
+	return NewWithRoundTripperWrapper(version, nil)
+}
+
+// NewWithRoundTripperWrapper is like New, but the round tripper of the API clients is wrapped with the given wrapper.
+func NewWithRoundTripperWrapper(version string, roundTripperWrapper func(next http.RoundTripper) http.RoundTripper) func() provider.Provider {
 	return func() provider.Provider {
 		return &Provider{
-			version: version,
+			version:             version,
+			roundTripperWrapper: roundTripperWrapper,
 		}
 	}
 }
@@ -138,6 +168,14 @@ type providerModel struct {
 	// Deprecated: Use DefaultRegion instead
 	Region        types.String `tfsdk:"region"`
 	DefaultRegion types.String `tfsdk:"default_region"`
+	NamePrefix    types.String `tfsdk:"name_prefix"`
+	DefaultLabels types.Map    `tfsdk:"default_labels"`
+	MaxRetries    types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMax  types.String `tfsdk:"retry_wait_max"`
+	AuthProfiles  types.Map    `tfsdk:"auth_profiles"`
+
+	WaitPollInterval    types.String `tfsdk:"wait_poll_interval"`
+	WaitTimeoutDefaults types.Object `tfsdk:"wait_timeout_defaults"`
 
 	// Custom endpoints
 	AuthorizationCustomEndpoint     types.String `tfsdk:"authorization_custom_endpoint"`
@@ -173,48 +211,79 @@ type providerModel struct {
 	Experiments         types.List `tfsdk:"experiments"`
 }
 
+// Struct corresponding to providerModel.AuthProfiles[name]
+type authProfileModel struct {
+	CredentialsFilePath   types.String `tfsdk:"credentials_path"`
+	ServiceAccountKey     types.String `tfsdk:"service_account_key"`
+	ServiceAccountKeyPath types.String `tfsdk:"service_account_key_path"`
+	PrivateKey            types.String `tfsdk:"private_key"`
+	PrivateKeyPath        types.String `tfsdk:"private_key_path"`
+}
+
+// Struct corresponding to providerModel.WaitTimeoutDefaults
+type waitTimeoutDefaultsModel struct {
+	Create types.String `tfsdk:"create"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
 // Schema defines the provider-level schema for configuration data.
 func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	descriptions := map[string]string{
-		"credentials_path":                   "Path of JSON from where the credentials are read. Takes precedence over the env var `STACKIT_CREDENTIALS_PATH`. Default value is `~/.stackit/credentials.json`.",
-		"service_account_token":              "Token used for authentication. If set, the token flow will be used to authenticate all operations.",
-		"service_account_key_path":           "Path for the service account key used for authentication. If set, the key flow will be used to authenticate all operations.",
-		"service_account_key":                "Service account key used for authentication. If set, the key flow will be used to authenticate all operations.",
-		"private_key_path":                   "Path for the private RSA key used for authentication, relevant for the key flow. It takes precedence over the private key that is included in the service account key.",
-		"private_key":                        "Private RSA key used for authentication, relevant for the key flow. It takes precedence over the private key that is included in the service account key.",
-		"service_account_email":              "Service account email. It can also be set using the environment variable STACKIT_SERVICE_ACCOUNT_EMAIL. It is required if you want to use the resource manager project resource.",
-		"region":                             "Region will be used as the default location for regional services. Not all services require a region, some are global",
-		"default_region":                     "Region will be used as the default location for regional services. Not all services require a region, some are global",
-		"cdn_custom_endpoint":                "Custom endpoint for the CDN service",
-		"dns_custom_endpoint":                "Custom endpoint for the DNS service",
-		"git_custom_endpoint":                "Custom endpoint for the Git service",
-		"iaas_custom_endpoint":               "Custom endpoint for the IaaS service",
-		"kms_custom_endpoint":                "Custom endpoint for the KMS service",
-		"mongodbflex_custom_endpoint":        "Custom endpoint for the MongoDB Flex service",
-		"modelserving_custom_endpoint":       "Custom endpoint for the AI Model Serving service",
-		"loadbalancer_custom_endpoint":       "Custom endpoint for the Load Balancer service",
-		"logme_custom_endpoint":              "Custom endpoint for the LogMe service",
-		"rabbitmq_custom_endpoint":           "Custom endpoint for the RabbitMQ service",
-		"mariadb_custom_endpoint":            "Custom endpoint for the MariaDB service",
-		"authorization_custom_endpoint":      "Custom endpoint for the Membership service",
-		"objectstorage_custom_endpoint":      "Custom endpoint for the Object Storage service",
-		"observability_custom_endpoint":      "Custom endpoint for the Observability service",
-		"opensearch_custom_endpoint":         "Custom endpoint for the OpenSearch service",
-		"postgresflex_custom_endpoint":       "Custom endpoint for the PostgresFlex service",
-		"redis_custom_endpoint":              "Custom endpoint for the Redis service",
-		"server_backup_custom_endpoint":      "Custom endpoint for the Server Backup service",
-		"server_update_custom_endpoint":      "Custom endpoint for the Server Update service",
-		"service_account_custom_endpoint":    "Custom endpoint for the Service Account service",
-		"resourcemanager_custom_endpoint":    "Custom endpoint for the Resource Manager service",
-		"scf_custom_endpoint":                "Custom endpoint for the Cloud Foundry (SCF) service",
-		"secretsmanager_custom_endpoint":     "Custom endpoint for the Secrets Manager service",
-		"sqlserverflex_custom_endpoint":      "Custom endpoint for the SQL Server Flex service",
-		"ske_custom_endpoint":                "Custom endpoint for the Kubernetes Engine (SKE) service",
-		"service_enablement_custom_endpoint": "Custom endpoint for the Service Enablement API",
-		"sfs_custom_endpoint":                "Custom endpoint for the Stackit Filestorage API",
-		"token_custom_endpoint":              "Custom endpoint for the token API, which is used to request access tokens when using the key flow",
-		"enable_beta_resources":              "Enable beta resources. Default is false.",
-		"experiments":                        fmt.Sprintf("Enables experiments. These are unstable features without official support. More information can be found in the README. Available Experiments: %v", strings.Join(features.AvailableExperiments, ", ")),
+		"credentials_path":                       "Path of JSON from where the credentials are read. Takes precedence over the env var `STACKIT_CREDENTIALS_PATH`. Default value is `~/.stackit/credentials.json`.",
+		"service_account_token":                  "Token used for authentication. If set, the token flow will be used to authenticate all operations.",
+		"service_account_key_path":               "Path for the service account key used for authentication. If set, the key flow will be used to authenticate all operations.",
+		"service_account_key":                    "Service account key used for authentication. If set, the key flow will be used to authenticate all operations.",
+		"private_key_path":                       "Path for the private RSA key used for authentication, relevant for the key flow. It takes precedence over the private key that is included in the service account key.",
+		"private_key":                            "Private RSA key used for authentication, relevant for the key flow. It takes precedence over the private key that is included in the service account key.",
+		"service_account_email":                  "Service account email. It can also be set using the environment variable STACKIT_SERVICE_ACCOUNT_EMAIL. It is required if you want to use the resource manager project resource.",
+		"region":                                 "Region will be used as the default location for regional services. Not all services require a region, some are global. Resources with a `region` attribute fail to plan if neither their `region` nor this attribute is set",
+		"default_region":                         "Region will be used as the default location for regional services. Not all services require a region, some are global. Resources with a `region` attribute fail to plan if neither their `region` nor this attribute is set",
+		"name_prefix":                            "Prefix that is prepended to the `name` of supported resources when they are created or updated, e.g. `dev-`. Eases deploying the same configuration to multiple workspaces. The `name` attribute in the Terraform state stays unprefixed. Supported resources: `stackit_network`, `stackit_security_group`.",
+		"default_labels":                         "Labels which are added to all resources supporting labels. Labels set on resource level take precedence. Supported resources: `stackit_image`, `stackit_key_pair`, `stackit_loadbalancer`, `stackit_network`, `stackit_network_area`, `stackit_network_area_route`, `stackit_network_interface`, `stackit_public_ip`, `stackit_resourcemanager_folder`, `stackit_resourcemanager_project`, `stackit_routing_table`, `stackit_routing_table_route`, `stackit_security_group`, `stackit_server`, `stackit_volume`. Not supported by `stackit_routing_table_routes`. The load balancer has no `labels` attribute, so its default labels are only added in the API. The labels of SKE node pools and observability alerts are not resource labels and don't get the default labels.",
+		"auth_profiles":                          "Named credentials, which resources and data sources supporting the `auth_profile` attribute can use instead of the credentials of the provider. Allows managing resources in projects requiring different service accounts with a single provider. Supported resources: `stackit_dns_record_set`, `stackit_dns_zone`, `stackit_network`, `stackit_server`.",
+		"auth_profiles.credentials_path":         "Path of JSON from where the credentials of the auth profile are read. Unlike for the provider credentials, the environment variables are never used.",
+		"auth_profiles.service_account_key":      "Service account key of the auth profile.",
+		"auth_profiles.service_account_key_path": "Path for the service account key of the auth profile.",
+		"auth_profiles.private_key":              "Private RSA key of the auth profile. It takes precedence over the private key that is included in the service account key.",
+		"auth_profiles.private_key_path":         "Path for the private RSA key of the auth profile. It takes precedence over the private key that is included in the service account key.",
+		"cdn_custom_endpoint":                    "Custom endpoint for the CDN service",
+		"dns_custom_endpoint":                    "Custom endpoint for the DNS service",
+		"git_custom_endpoint":                    "Custom endpoint for the Git service",
+		"iaas_custom_endpoint":                   "Custom endpoint for the IaaS service",
+		"kms_custom_endpoint":                    "Custom endpoint for the KMS service",
+		"mongodbflex_custom_endpoint":            "Custom endpoint for the MongoDB Flex service",
+		"modelserving_custom_endpoint":           "Custom endpoint for the AI Model Serving service",
+		"loadbalancer_custom_endpoint":           "Custom endpoint for the Load Balancer service",
+		"logme_custom_endpoint":                  "Custom endpoint for the LogMe service",
+		"rabbitmq_custom_endpoint":               "Custom endpoint for the RabbitMQ service",
+		"mariadb_custom_endpoint":                "Custom endpoint for the MariaDB service",
+		"authorization_custom_endpoint":          "Custom endpoint for the Membership service",
+		"objectstorage_custom_endpoint":          "Custom endpoint for the Object Storage service",
+		"observability_custom_endpoint":          "Custom endpoint for the Observability service",
+		"opensearch_custom_endpoint":             "Custom endpoint for the OpenSearch service",
+		"postgresflex_custom_endpoint":           "Custom endpoint for the PostgresFlex service",
+		"redis_custom_endpoint":                  "Custom endpoint for the Redis service",
+		"server_backup_custom_endpoint":          "Custom endpoint for the Server Backup service",
+		"server_update_custom_endpoint":          "Custom endpoint for the Server Update service",
+		"service_account_custom_endpoint":        "Custom endpoint for the Service Account service",
+		"resourcemanager_custom_endpoint":        "Custom endpoint for the Resource Manager service",
+		"scf_custom_endpoint":                    "Custom endpoint for the Cloud Foundry (SCF) service",
+		"secretsmanager_custom_endpoint":         "Custom endpoint for the Secrets Manager service",
+		"sqlserverflex_custom_endpoint":          "Custom endpoint for the SQL Server Flex service",
+		"ske_custom_endpoint":                    "Custom endpoint for the Kubernetes Engine (SKE) service",
+		"service_enablement_custom_endpoint":     "Custom endpoint for the Service Enablement API",
+		"sfs_custom_endpoint":                    "Custom endpoint for the Stackit Filestorage API",
+		"token_custom_endpoint":                  "Custom endpoint for the token API, which is used to request access tokens when using the key flow",
+		"enable_beta_resources":                  "Enable beta resources. Default is false.",
+		"max_retries":                            fmt.Sprintf("Maximum number of retries for API requests that failed with a transient error. Only idempotent requests, e.g. GET, PUT and DELETE, are retried, if they were rate limited (HTTP 429) or failed with a gateway error (HTTP 502, 503 and 504). Set to `0` to disable retries. Default is `%d`.", core.DefaultMaxRetries),
+		"wait_poll_interval":                     "Interval in which the status of a resource is checked while waiting for it to be created, updated or deleted, e.g. `1s`. Short intervals speed up runs against mocked APIs, e.g. in CI. If not set, the intervals of the resources are used.",
+		"wait_timeout_defaults":                  "Maximum time to wait for resources to be created, updated or deleted. If set, it replaces the default timeouts of the resources, e.g. to give slow operations more time in production. Resources whose operations are known to take long, e.g. image uploads or load balancer creation, keep their timeout if it is longer.",
+		"wait_timeout_defaults.create":           "Maximum time to wait for a resource to be created, e.g. `90m`.",
+		"wait_timeout_defaults.update":           "Maximum time to wait for a resource to be updated, e.g. `90m`.",
+		"wait_timeout_defaults.delete":           "Maximum time to wait for a resource to be deleted, e.g. `90m`.",
+		"retry_wait_max":                         fmt.Sprintf("Maximum time to wait between two retries, e.g. `10s`. The wait time grows exponentially with each retry up to this value. Default is `%s`.", core.DefaultRetryWaitMax),
+		"experiments":                            fmt.Sprintf("Enables experiments. These are unstable features without official support. More information can be found in the README. Available Experiments: %v", strings.Join(features.AvailableExperiments, ", ")),
 	}
 
 	resp.Schema = schema.Schema{
@@ -266,10 +335,97 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 					stringvalidator.ConflictsWith(path.MatchRoot("region")),
 				},
 			},
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["name_prefix"],
+			},
+			"default_labels": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: descriptions["default_labels"],
+			},
+			"auth_profiles": schema.MapNestedAttribute{
+				Optional:    true,
+				Description: descriptions["auth_profiles"],
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"credentials_path": schema.StringAttribute{
+							Optional:    true,
+							Description: descriptions["auth_profiles.credentials_path"],
+						},
+						"service_account_key": schema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: descriptions["auth_profiles.service_account_key"],
+						},
+						"service_account_key_path": schema.StringAttribute{
+							Optional:    true,
+							Description: descriptions["auth_profiles.service_account_key_path"],
+						},
+						"private_key": schema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: descriptions["auth_profiles.private_key"],
+						},
+						"private_key_path": schema.StringAttribute{
+							Optional:    true,
+							Description: descriptions["auth_profiles.private_key_path"],
+						},
+					},
+				},
+			},
 			"enable_beta_resources": schema.BoolAttribute{
 				Optional:    true,
 				Description: descriptions["enable_beta_resources"],
 			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: descriptions["max_retries"],
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["retry_wait_max"],
+				Validators: []validator.String{
+					validate.ValidDurationString(),
+				},
+			},
+			"wait_poll_interval": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["wait_poll_interval"],
+				Validators: []validator.String{
+					validate.ValidDurationString(),
+				},
+			},
+			"wait_timeout_defaults": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: descriptions["wait_timeout_defaults"],
+				Attributes: map[string]schema.Attribute{
+					"create": schema.StringAttribute{
+						Optional:    true,
+						Description: descriptions["wait_timeout_defaults.create"],
+						Validators: []validator.String{
+							validate.ValidDurationString(),
+						},
+					},
+					"update": schema.StringAttribute{
+						Optional:    true,
+						Description: descriptions["wait_timeout_defaults.update"],
+						Validators: []validator.String{
+							validate.ValidDurationString(),
+						},
+					},
+					"delete": schema.StringAttribute{
+						Optional:    true,
+						Description: descriptions["wait_timeout_defaults.delete"],
+						Validators: []validator.String{
+							validate.ValidDurationString(),
+						},
+					},
+				},
+			},
 			"experiments": schema.ListAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -435,6 +591,7 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 
 	setStringField(providerConfig.DefaultRegion, func(v string) { providerData.DefaultRegion = v })
 	setStringField(providerConfig.Region, func(v string) { providerData.Region = v }) // nolint:staticcheck // preliminary handling of deprecated attribute
+	setStringField(providerConfig.NamePrefix, func(v string) { providerData.NamePrefix = v })
 	setBoolField(providerConfig.EnableBetaResources, func(v bool) { providerData.EnableBetaResources = v })
 
 	setStringField(providerConfig.AuthorizationCustomEndpoint, func(v string) { providerData.AuthorizationCustomEndpoint = v })
@@ -474,15 +631,74 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		providerData.Experiments = experimentValues
 	}
 
+	if !(providerConfig.DefaultLabels.IsUnknown() || providerConfig.DefaultLabels.IsNull()) {
+		defaultLabels := map[string]string{}
+		diags := providerConfig.DefaultLabels.ElementsAs(ctx, &defaultLabels, false)
+		if diags.HasError() {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring provider", fmt.Sprintf("Setting up default labels: %v", diags.Errors()))
+			return
+		}
+		providerData.DefaultLabels = defaultLabels
+	}
+
+	maxRetries := core.DefaultMaxRetries
+	if !(providerConfig.MaxRetries.IsUnknown() || providerConfig.MaxRetries.IsNull()) {
+		maxRetries = int(providerConfig.MaxRetries.ValueInt64())
+	}
+	retryWaitMax := core.DefaultRetryWaitMax
+	if !(providerConfig.RetryWaitMax.IsUnknown() || providerConfig.RetryWaitMax.IsNull()) {
+		var err error
+		retryWaitMax, err = time.ParseDuration(providerConfig.RetryWaitMax.ValueString())
+		if err != nil || retryWaitMax <= 0 {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring provider", fmt.Sprintf("Setting up retries: retry_wait_max must be a positive duration, got %q", providerConfig.RetryWaitMax.ValueString()))
+			return
+		}
+	}
+
+	waitSettings, err := toWaitSettings(ctx, providerConfig.WaitPollInterval, providerConfig.WaitTimeoutDefaults)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring provider", fmt.Sprintf("Setting up waiting: %v", err))
+		return
+	}
+	providerData.Wait = waitSettings
+
 	roundTripper, err := sdkauth.SetupAuth(sdkConfig)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring provider", fmt.Sprintf("Setting up authentication: %v", err))
 		return
 	}
 
+	authProfileRoundTrippers := map[string]http.RoundTripper{}
+	if !(providerConfig.AuthProfiles.IsUnknown() || providerConfig.AuthProfiles.IsNull()) {
+		authProfiles := map[string]authProfileModel{}
+		diags := providerConfig.AuthProfiles.ElementsAs(ctx, &authProfiles, false)
+		if diags.HasError() {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring provider", fmt.Sprintf("Setting up auth profiles: %v", diags.Errors()))
+			return
+		}
+		for name, authProfile := range authProfiles {
+			authProfileConfig, err := toAuthProfileConfig(&authProfile, sdkConfig.TokenCustomUrl)
+			if err != nil {
+				core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring provider", fmt.Sprintf("Setting up auth profile %q: %v", name, err))
+				return
+			}
+			authProfileRoundTripper, err := sdkauth.SetupAuth(authProfileConfig)
+			if err != nil {
+				core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring provider", fmt.Sprintf("Setting up authentication of auth profile %q: %v", name, err))
+				return
+			}
+			authProfileRoundTrippers[name] = authProfileRoundTripper
+		}
+	}
+
 	// Make round tripper and custom endpoints available during DataSource and Resource
 	// type Configure methods.
-	providerData.RoundTripper = roundTripper
+	providerData.RoundTripper = core.NewRetryRoundTripper(core.NewAuthProfileRoundTripper(roundTripper, authProfileRoundTrippers), maxRetries, retryWaitMax)
+	if p.roundTripperWrapper != nil {
+		providerData.RoundTripper = p.roundTripperWrapper(providerData.RoundTripper)
+	}
+	// Share the API clients between all resources and data sources
+	providerData.Clients = core.NewClientCache()
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
 
@@ -499,6 +715,125 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	providerData.Version = p.version
 }
 
+// toWaitSettings returns the settings for waiting until resources are created, updated or deleted.
+func toWaitSettings(ctx context.Context, pollInterval types.String, timeoutDefaults types.Object) (core.WaitSettings, error) {
+	var settings core.WaitSettings
+	if err := setPositiveDuration("wait_poll_interval", pollInterval, &settings.PollInterval); err != nil {
+		return settings, err
+	}
+	if utils.IsUndefined(timeoutDefaults) {
+		return settings, nil
+	}
+
+	var timeouts waitTimeoutDefaultsModel
+	diags := timeoutDefaults.As(ctx, &timeouts, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return settings, fmt.Errorf("converting wait_timeout_defaults: %w", core.DiagsToError(diags))
+	}
+	if err := setPositiveDuration("wait_timeout_defaults.create", timeouts.Create, &settings.CreateTimeout); err != nil {
+		return settings, err
+	}
+	if err := setPositiveDuration("wait_timeout_defaults.update", timeouts.Update, &settings.UpdateTimeout); err != nil {
+		return settings, err
+	}
+	if err := setPositiveDuration("wait_timeout_defaults.delete", timeouts.Delete, &settings.DeleteTimeout); err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
+
+// setPositiveDuration parses the value of the attribute into target, if it is set.
+func setPositiveDuration(attribute string, value types.String, target *time.Duration) error {
+	if utils.IsUndefined(value) {
+		return nil
+	}
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil || duration <= 0 {
+		return fmt.Errorf("%s must be a positive duration, got %q", attribute, value.ValueString())
+	}
+	*target = duration
+	return nil
+}
+
+// toAuthProfileConfig returns the SDK configuration for the credentials of an auth profile.
+// The credentials are loaded here and set explicitly, since the SDK would otherwise look up missing keys in the
+// environment first, so that an auth profile would use the credentials of the environment.
+func toAuthProfileConfig(authProfile *authProfileModel, tokenCustomUrl string) (*config.Configuration, error) {
+	if utils.IsUndefined(authProfile.CredentialsFilePath) && utils.IsUndefined(authProfile.ServiceAccountKey) && utils.IsUndefined(authProfile.ServiceAccountKeyPath) {
+		return nil, fmt.Errorf("one of credentials_path, service_account_key or service_account_key_path must be set")
+	}
+
+	credentials := &sdkauth.Credentials{}
+	if !utils.IsUndefined(authProfile.CredentialsFilePath) {
+		credentialsRaw, err := os.ReadFile(authProfile.CredentialsFilePath.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("reading credentials file: %w", err)
+		}
+		if err := json.Unmarshal(credentialsRaw, credentials); err != nil {
+			return nil, fmt.Errorf("parsing credentials file: %w", err)
+		}
+	}
+
+	serviceAccountKey, err := loadKey(authProfile.ServiceAccountKey.ValueString(), authProfile.ServiceAccountKeyPath.ValueString(),
+		credentials.STACKIT_SERVICE_ACCOUNT_KEY, credentials.STACKIT_SERVICE_ACCOUNT_KEY_PATH)
+	if err != nil {
+		return nil, fmt.Errorf("loading service account key: %w", err)
+	}
+	if serviceAccountKey == "" {
+		if credentials.STACKIT_SERVICE_ACCOUNT_TOKEN == "" {
+			return nil, fmt.Errorf("the credentials file contains neither a service account key nor a token")
+		}
+		return &config.Configuration{
+			Token:          credentials.STACKIT_SERVICE_ACCOUNT_TOKEN,
+			TokenCustomUrl: tokenCustomUrl,
+		}, nil
+	}
+
+	privateKey, err := loadKey(authProfile.PrivateKey.ValueString(), authProfile.PrivateKeyPath.ValueString(),
+		credentials.STACKIT_PRIVATE_KEY, credentials.STACKIT_PRIVATE_KEY_PATH)
+	if err != nil {
+		return nil, fmt.Errorf("loading private key: %w", err)
+	}
+	if privateKey == "" {
+		// Use the private key included in the service account key
+		serviceAccountKeyResponse := &clients.ServiceAccountKeyResponse{}
+		if err := json.Unmarshal([]byte(serviceAccountKey), serviceAccountKeyResponse); err != nil {
+			return nil, fmt.Errorf("parsing service account key: %w", err)
+		}
+		if serviceAccountKeyResponse.Credentials == nil || serviceAccountKeyResponse.Credentials.PrivateKey == nil || *serviceAccountKeyResponse.Credentials.PrivateKey == "" {
+			return nil, fmt.Errorf("private key is not set and not part of the service account key")
+		}
+		privateKey = *serviceAccountKeyResponse.Credentials.PrivateKey
+	}
+
+	return &config.Configuration{
+		ServiceAccountKey: serviceAccountKey,
+		PrivateKey:        privateKey,
+		TokenCustomUrl:    tokenCustomUrl,
+	}, nil
+}
+
+// loadKey returns the key set in the auth profile or, if it isn't set, in its credentials file.
+// Keys are taken from the key value before the key path. An empty key is returned if none is set.
+func loadKey(key, keyPath, credentialsKey, credentialsKeyPath string) (string, error) {
+	for _, candidate := range []struct{ key, keyPath string }{{key, keyPath}, {credentialsKey, credentialsKeyPath}} {
+		if candidate.key != "" {
+			return candidate.key, nil
+		}
+		if candidate.keyPath != "" {
+			keyBytes, err := os.ReadFile(candidate.keyPath)
+			if err != nil {
+				return "", fmt.Errorf("reading key file: %w", err)
+			}
+			if len(keyBytes) == 0 {
+				return "", fmt.Errorf("key file %q is empty", candidate.keyPath)
+			}
+			return string(keyBytes), nil
+		}
+	}
+	return "", nil
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
@@ -507,6 +842,7 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		cdnCustomDomain.NewCustomDomainDataSource,
 		dnsZone.NewZoneDataSource,
 		dnsRecordSet.NewRecordSetDataSource,
+		dnsZoneTransfer.NewZoneTransferDataSource,
 		gitInstance.NewGitDataSource,
 		iaasAffinityGroup.NewAffinityGroupDatasource,
 		iaasImage.NewImageDataSource,
@@ -517,17 +853,21 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		iaasNetworkAreaRoute.NewNetworkAreaRouteDataSource,
 		iaasNetworkInterface.NewNetworkInterfaceDataSource,
 		iaasVolume.NewVolumeDataSource,
+		iaasVolumeTypes.NewVolumeTypesDataSource,
 		iaasProject.NewProjectDataSource,
 		iaasPublicIp.NewPublicIpDataSource,
 		iaasPublicIpRanges.NewPublicIpRangesDataSource,
+		iaasQuotas.NewQuotasDataSource,
 		iaasKeyPair.NewKeyPairDataSource,
 		iaasServer.NewServerDataSource,
+		iaasServerLog.NewServerLogDataSource,
 		iaasSecurityGroup.NewSecurityGroupDataSource,
 		iaasalphaRoutingTable.NewRoutingTableDataSource,
 		iaasalphaRoutingTableRoute.NewRoutingTableRouteDataSource,
 		iaasalphaRoutingTables.NewRoutingTablesDataSource,
 		iaasalphaRoutingTableRoutes.NewRoutingTableRoutesDataSource,
 		iaasSecurityGroupRule.NewSecurityGroupRuleDataSource,
+		inventory.NewInventoryDataSource,
 		kmsKey.NewKeyDataSource,
 		kmsKeyRing.NewKeyRingDataSource,
 		kmsWrappingKey.NewWrappingKeyDataSource,
@@ -538,6 +878,7 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		machineType.NewMachineTypeDataSource,
 		mariaDBInstance.NewInstanceDataSource,
 		mariaDBCredential.NewCredentialDataSource,
+		mariaDBPlans.NewPlansDataSource,
 		mongoDBFlexInstance.NewInstanceDataSource,
 		mongoDBFlexUser.NewUserDataSource,
 		objectStorageBucket.NewBucketDataSource,
@@ -568,6 +909,7 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		serverUpdateSchedule.NewScheduleDataSource,
 		serverUpdateSchedule.NewSchedulesDataSource,
 		serviceAccount.NewServiceAccountDataSource,
+		serviceAccountEmail.NewProjectServiceAccountEmailDataSource,
 		skeCluster.NewClusterDataSource,
 		resourcepool.NewResourcePoolDataSource,
 		share.NewShareDataSource,
@@ -597,6 +939,7 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		iaasKeyPair.NewKeyPairResource,
 		iaasVolumeAttach.NewVolumeAttachResource,
 		iaasNetworkInterfaceAttach.NewNetworkInterfaceAttachResource,
+		iaasNetworkRoutingTableAttachment.NewNetworkRoutingTableAttachmentResource,
 		iaasServiceAccountAttach.NewServiceAccountAttachResource,
 		iaasPublicIpAssociate.NewPublicIpAssociateResource,
 		iaasServer.NewServerResource,
@@ -604,6 +947,7 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		iaasSecurityGroupRule.NewSecurityGroupRuleResource,
 		iaasalphaRoutingTable.NewRoutingTableResource,
 		iaasalphaRoutingTableRoute.NewRoutingTableRouteResource,
+		iaasalphaRoutingTableRoutes.NewRoutingTableRoutesResource,
 		kmsKey.NewKeyResource,
 		kmsKeyRing.NewKeyRingResource,
 		kmsWrappingKey.NewWrappingKeyResource,
@@ -619,6 +963,7 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		mongoDBFlexUser.NewUserResource,
 		objectStorageBucket.NewBucketResource,
 		objecStorageCredentialsGroup.NewCredentialsGroupResource,
+		objectStorageProject.NewProjectResource,
 		objecStorageCredential.NewCredentialResource,
 		observabilityCredential.NewCredentialResource,
 		observabilityInstance.NewInstanceResource,
@@ -645,6 +990,7 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		serviceAccount.NewServiceAccountResource,
 		serviceAccountToken.NewServiceAccountTokenResource,
 		serviceAccountKey.NewServiceAccountKeyResource,
+		serviceEnablementService.NewServiceEnablementResource,
 		skeCluster.NewClusterResource,
 		skeKubeconfig.NewKubeconfigResource,
 		resourcepool.NewResourcePoolResource,
@@ -660,5 +1006,14 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 func (p *Provider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
 	return []func() ephemeral.EphemeralResource{
 		access_token.NewAccessTokenEphemeralResource,
+		modelServingToken.NewTokenEphemeralResource,
+	}
+}
+
+// Functions defines the provider functions implemented in the provider.
+func (p *Provider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		dnsReverseName.NewReverseZoneNameFunction,
+		dnsReverseName.NewReverseRecordNameFunction,
 	}
 }
@@ -26,7 +26,7 @@ var providerValidAttributes string
 
 var testConfigProviderCredentials = config.Variables{
 	"project_id": config.StringVariable(testutil.ProjectId),
-	"name":       config.StringVariable(fmt.Sprintf("tf-acc-prov%s", acctest.RandStringFromCharSet(3, acctest.CharSetAlphaNum))),
+	"name":       config.StringVariable(fmt.Sprintf("tf-acc-prov%s", testutil.RandStringFromCharSet(3, acctest.CharSetAlphaNum))),
 }
 
 // Helper function to obtain the home directory on different systems.
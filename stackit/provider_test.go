@@ -0,0 +1,537 @@
+package stackit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+)
+
+// secretAttributePatterns are the substrings of attribute names which indicate that an attribute holds a secret.
+var secretAttributePatterns = []string{"password", "token", "private_key", "content", "secret"}
+
+// sensitiveAttributeExceptions lists attributes which match a secret pattern, but don't hold a secret.
+// Keys are structured as "<type name>.<attribute path>", values describe why the attribute isn't sensitive.
+var sensitiveAttributeExceptions = map[string]string{}
+
+func init() {
+	addSensitiveAttributeException("stackit_modelserving_token", "ID of the token, not the token itself", "token_id")
+	addSensitiveAttributeException("stackit_modelserving_token", "flag whether the token content is known, not the content itself", "content_available")
+	addSensitiveAttributeException("stackit_service_account_access_token", "ID of the access token, not the token itself", "access_token_id")
+	addSensitiveAttributeException("stackit_cdn_custom_domain", "version of the write-only private key, not the key itself", "certificate.private_key_wo_version")
+	addSensitiveAttributeException("stackit_logme_credential", "settings of the rendered Kubernetes Secret, only its manifest holds the credential", "render_kubernetes_secret")
+	addSensitiveAttributeException("stackit_mariadb_credential", "settings of the rendered Kubernetes Secret, only its manifest holds the credential", "render_kubernetes_secret")
+	addSensitiveAttributeException("stackit_opensearch_credential", "settings of the rendered Kubernetes Secret, only its manifest holds the credential", "render_kubernetes_secret")
+	addSensitiveAttributeException("stackit_rabbitmq_credential", "settings of the rendered Kubernetes Secret, only its manifest holds the credential", "render_kubernetes_secret")
+	addSensitiveAttributeException("stackit_redis_credential", "settings of the rendered Kubernetes Secret, only its manifest holds the credential", "render_kubernetes_secret")
+}
+
+// addSensitiveAttributeException registers attributes of a resource or data source, which match a secret pattern, but don't hold a secret.
+func addSensitiveAttributeException(typeName, reason string, attributePaths ...string) {
+	for _, attributePath := range attributePaths {
+		sensitiveAttributeExceptions[fmt.Sprintf("%s.%s", typeName, attributePath)] = reason
+	}
+}
+
+func isSecretAttributeName(name string) bool {
+	for _, pattern := range secretAttributePatterns {
+		if strings.Contains(name, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// sensitiveAttribute is implemented by all resource and data source schema attributes.
+type sensitiveAttribute interface {
+	IsSensitive() bool
+}
+
+// checkSensitive records the path of the attribute, if it holds a secret according to its name, but isn't marked as sensitive.
+func checkSensitive(typeName, attributePath, name string, attribute sensitiveAttribute, findings *[]string) {
+	if !isSecretAttributeName(name) || attribute.IsSensitive() {
+		return
+	}
+	key := fmt.Sprintf("%s.%s", typeName, attributePath)
+	if _, ok := sensitiveAttributeExceptions[key]; ok {
+		return
+	}
+	*findings = append(*findings, key)
+}
+
+func joinAttributePath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+func walkResourceAttributes(typeName, prefix string, attributes map[string]resourceSchema.Attribute, blocks map[string]resourceSchema.Block, findings *[]string) {
+	for name, attribute := range attributes {
+		attributePath := joinAttributePath(prefix, name)
+		checkSensitive(typeName, attributePath, name, attribute, findings)
+
+		switch a := attribute.(type) {
+		case resourceSchema.SingleNestedAttribute:
+			walkResourceAttributes(typeName, attributePath, a.Attributes, nil, findings)
+		case resourceSchema.ListNestedAttribute:
+			walkResourceAttributes(typeName, attributePath, a.NestedObject.Attributes, nil, findings)
+		case resourceSchema.SetNestedAttribute:
+			walkResourceAttributes(typeName, attributePath, a.NestedObject.Attributes, nil, findings)
+		case resourceSchema.MapNestedAttribute:
+			walkResourceAttributes(typeName, attributePath, a.NestedObject.Attributes, nil, findings)
+		}
+	}
+	for name, block := range blocks {
+		blockPath := joinAttributePath(prefix, name)
+		switch b := block.(type) {
+		case resourceSchema.SingleNestedBlock:
+			walkResourceAttributes(typeName, blockPath, b.Attributes, b.Blocks, findings)
+		case resourceSchema.ListNestedBlock:
+			walkResourceAttributes(typeName, blockPath, b.NestedObject.Attributes, b.NestedObject.Blocks, findings)
+		case resourceSchema.SetNestedBlock:
+			walkResourceAttributes(typeName, blockPath, b.NestedObject.Attributes, b.NestedObject.Blocks, findings)
+		}
+	}
+}
+
+func walkDataSourceAttributes(typeName, prefix string, attributes map[string]datasourceSchema.Attribute, blocks map[string]datasourceSchema.Block, findings *[]string) {
+	for name, attribute := range attributes {
+		attributePath := joinAttributePath(prefix, name)
+		checkSensitive(typeName, attributePath, name, attribute, findings)
+
+		switch a := attribute.(type) {
+		case datasourceSchema.SingleNestedAttribute:
+			walkDataSourceAttributes(typeName, attributePath, a.Attributes, nil, findings)
+		case datasourceSchema.ListNestedAttribute:
+			walkDataSourceAttributes(typeName, attributePath, a.NestedObject.Attributes, nil, findings)
+		case datasourceSchema.SetNestedAttribute:
+			walkDataSourceAttributes(typeName, attributePath, a.NestedObject.Attributes, nil, findings)
+		case datasourceSchema.MapNestedAttribute:
+			walkDataSourceAttributes(typeName, attributePath, a.NestedObject.Attributes, nil, findings)
+		}
+	}
+	for name, block := range blocks {
+		blockPath := joinAttributePath(prefix, name)
+		switch b := block.(type) {
+		case datasourceSchema.SingleNestedBlock:
+			walkDataSourceAttributes(typeName, blockPath, b.Attributes, b.Blocks, findings)
+		case datasourceSchema.ListNestedBlock:
+			walkDataSourceAttributes(typeName, blockPath, b.NestedObject.Attributes, b.NestedObject.Blocks, findings)
+		case datasourceSchema.SetNestedBlock:
+			walkDataSourceAttributes(typeName, blockPath, b.NestedObject.Attributes, b.NestedObject.Blocks, findings)
+		}
+	}
+}
+
+// TestSecretAttributesAreSensitive makes sure that attributes holding secrets are never shown in plans or logs.
+func TestSecretAttributesAreSensitive(t *testing.T) {
+	ctx := context.Background()
+	p := &Provider{}
+
+	var findings []string
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		metadataResp := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "stackit"}, &metadataResp)
+		schemaResp := resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		if schemaResp.Diagnostics.HasError() {
+			t.Fatalf("resource %s: getting schema: %v", metadataResp.TypeName, schemaResp.Diagnostics.Errors())
+		}
+		walkResourceAttributes(metadataResp.TypeName, "", schemaResp.Schema.Attributes, schemaResp.Schema.Blocks, &findings)
+	}
+	for _, newDataSource := range p.DataSources(ctx) {
+		d := newDataSource()
+		metadataResp := datasource.MetadataResponse{}
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "stackit"}, &metadataResp)
+		schemaResp := datasource.SchemaResponse{}
+		d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+		if schemaResp.Diagnostics.HasError() {
+			t.Fatalf("data source %s: getting schema: %v", metadataResp.TypeName, schemaResp.Diagnostics.Errors())
+		}
+		walkDataSourceAttributes("data."+metadataResp.TypeName, "", schemaResp.Schema.Attributes, schemaResp.Schema.Blocks, &findings)
+	}
+
+	sort.Strings(findings)
+	for _, finding := range findings {
+		t.Errorf("attribute %s looks like a secret, but isn't marked as sensitive. Mark it as sensitive or register an exception with addSensitiveAttributeException", finding)
+	}
+}
+
+func TestToAuthProfileConfig(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		filePath := filepath.Join(dir, name)
+		if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+			t.Fatalf("writing %s: %v", name, err)
+		}
+		return filePath
+	}
+	keyPath := writeFile("key.json", "key-from-file")
+	privateKeyPath := writeFile("private-key.pem", "private-key-from-file")
+	credentialsPath := writeFile("credentials.json", `{"STACKIT_SERVICE_ACCOUNT_KEY": "key-from-credentials", "STACKIT_PRIVATE_KEY": "private-key-from-credentials"}`)
+	credentialsWithPathsPath := writeFile("credentials-paths.json", fmt.Sprintf(`{"STACKIT_SERVICE_ACCOUNT_KEY_PATH": %q, "STACKIT_PRIVATE_KEY_PATH": %q}`, keyPath, privateKeyPath))
+	credentialsWithKeyOnlyPath := writeFile("credentials-key-only.json", `{"STACKIT_SERVICE_ACCOUNT_KEY": "key-from-credentials"}`)
+	credentialsWithTokenPath := writeFile("credentials-token.json", `{"STACKIT_SERVICE_ACCOUNT_TOKEN": "token-from-credentials"}`)
+	emptyCredentialsPath := writeFile("credentials-empty.json", `{}`)
+	emptyKeyPath := writeFile("empty-key.json", "")
+	keyWithPrivateKey := `{"credentials": {"privateKey": "private-key-from-key"}}`
+
+	// The credentials of the environment must never be used by an auth profile
+	t.Setenv("STACKIT_SERVICE_ACCOUNT_KEY", "key-from-env")
+	t.Setenv("STACKIT_SERVICE_ACCOUNT_KEY_PATH", keyPath)
+	t.Setenv("STACKIT_PRIVATE_KEY", "private-key-from-env")
+	t.Setenv("STACKIT_PRIVATE_KEY_PATH", privateKeyPath)
+	t.Setenv("STACKIT_SERVICE_ACCOUNT_TOKEN", "token-from-env")
+	t.Setenv("STACKIT_CREDENTIALS_PATH", credentialsPath)
+
+	tests := []struct {
+		description string
+		input       *authProfileModel
+		expected    *config.Configuration
+		isValid     bool
+	}{
+		{
+			description: "service account key",
+			input: &authProfileModel{
+				ServiceAccountKey: types.StringValue("key"),
+				PrivateKey:        types.StringValue("private-key"),
+			},
+			expected: &config.Configuration{
+				ServiceAccountKey: "key",
+				PrivateKey:        "private-key",
+				TokenCustomUrl:    "https://token.example.com",
+			},
+			isValid: true,
+		},
+		{
+			description: "private key included in service account key",
+			input: &authProfileModel{
+				ServiceAccountKey: types.StringValue(keyWithPrivateKey),
+			},
+			expected: &config.Configuration{
+				ServiceAccountKey: keyWithPrivateKey,
+				PrivateKey:        "private-key-from-key",
+				TokenCustomUrl:    "https://token.example.com",
+			},
+			isValid: true,
+		},
+		{
+			description: "paths",
+			input: &authProfileModel{
+				ServiceAccountKeyPath: types.StringValue(keyPath),
+				PrivateKeyPath:        types.StringValue(privateKeyPath),
+			},
+			expected: &config.Configuration{
+				ServiceAccountKey: "key-from-file",
+				PrivateKey:        "private-key-from-file",
+				TokenCustomUrl:    "https://token.example.com",
+			},
+			isValid: true,
+		},
+		{
+			description: "credentials file",
+			input: &authProfileModel{
+				CredentialsFilePath: types.StringValue(credentialsPath),
+			},
+			expected: &config.Configuration{
+				ServiceAccountKey: "key-from-credentials",
+				PrivateKey:        "private-key-from-credentials",
+				TokenCustomUrl:    "https://token.example.com",
+			},
+			isValid: true,
+		},
+		{
+			description: "credentials file with paths",
+			input: &authProfileModel{
+				CredentialsFilePath: types.StringValue(credentialsWithPathsPath),
+			},
+			expected: &config.Configuration{
+				ServiceAccountKey: "key-from-file",
+				PrivateKey:        "private-key-from-file",
+				TokenCustomUrl:    "https://token.example.com",
+			},
+			isValid: true,
+		},
+		{
+			description: "profile keys take precedence over credentials file",
+			input: &authProfileModel{
+				CredentialsFilePath: types.StringValue(credentialsPath),
+				ServiceAccountKey:   types.StringValue("key"),
+				PrivateKey:          types.StringValue("private-key"),
+			},
+			expected: &config.Configuration{
+				ServiceAccountKey: "key",
+				PrivateKey:        "private-key",
+				TokenCustomUrl:    "https://token.example.com",
+			},
+			isValid: true,
+		},
+		{
+			description: "credentials file with token",
+			input: &authProfileModel{
+				CredentialsFilePath: types.StringValue(credentialsWithTokenPath),
+			},
+			expected: &config.Configuration{
+				Token:          "token-from-credentials",
+				TokenCustomUrl: "https://token.example.com",
+			},
+			isValid: true,
+		},
+		{
+			description: "credentials file without private key",
+			input: &authProfileModel{
+				CredentialsFilePath: types.StringValue(credentialsWithKeyOnlyPath),
+			},
+			isValid: false,
+		},
+		{
+			description: "empty credentials file",
+			input: &authProfileModel{
+				CredentialsFilePath: types.StringValue(emptyCredentialsPath),
+			},
+			isValid: false,
+		},
+		{
+			description: "missing credentials file",
+			input: &authProfileModel{
+				CredentialsFilePath: types.StringValue(filepath.Join(dir, "missing.json")),
+			},
+			isValid: false,
+		},
+		{
+			description: "empty key file",
+			input: &authProfileModel{
+				ServiceAccountKeyPath: types.StringValue(emptyKeyPath),
+			},
+			isValid: false,
+		},
+		{
+			description: "service account key without private key",
+			input: &authProfileModel{
+				ServiceAccountKey: types.StringValue(`{"credentials": {}}`),
+			},
+			isValid: false,
+		},
+		{
+			description: "no credentials",
+			input: &authProfileModel{
+				PrivateKey: types.StringValue("private-key"),
+			},
+			isValid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toAuthProfileConfig(tt.input, "https://token.example.com")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected, cmpopts.IgnoreUnexported(config.Configuration{}))
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToWaitSettings(t *testing.T) {
+	timeouts := func(create, update, deleteTimeout types.String) types.Object {
+		return types.ObjectValueMust(map[string]attr.Type{
+			"create": types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}, map[string]attr.Value{
+			"create": create,
+			"update": update,
+			"delete": deleteTimeout,
+		})
+	}
+	tests := []struct {
+		description     string
+		pollInterval    types.String
+		timeoutDefaults types.Object
+		expected        core.WaitSettings
+		isValid         bool
+	}{
+		{
+			description:     "not set",
+			pollInterval:    types.StringNull(),
+			timeoutDefaults: types.ObjectNull(map[string]attr.Type{}),
+			expected:        core.WaitSettings{},
+			isValid:         true,
+		},
+		{
+			description:     "all set",
+			pollInterval:    types.StringValue("1s"),
+			timeoutDefaults: timeouts(types.StringValue("90m"), types.StringValue("1h"), types.StringValue("45m")),
+			expected: core.WaitSettings{
+				PollInterval:  time.Second,
+				CreateTimeout: 90 * time.Minute,
+				UpdateTimeout: time.Hour,
+				DeleteTimeout: 45 * time.Minute,
+			},
+			isValid: true,
+		},
+		{
+			description:     "some timeouts set",
+			pollInterval:    types.StringNull(),
+			timeoutDefaults: timeouts(types.StringValue("2h"), types.StringNull(), types.StringNull()),
+			expected: core.WaitSettings{
+				CreateTimeout: 2 * time.Hour,
+			},
+			isValid: true,
+		},
+		{
+			description:     "zero poll interval",
+			pollInterval:    types.StringValue("0s"),
+			timeoutDefaults: types.ObjectNull(map[string]attr.Type{}),
+			isValid:         false,
+		},
+		{
+			description:     "invalid timeout",
+			pollInterval:    types.StringNull(),
+			timeoutDefaults: timeouts(types.StringNull(), types.StringNull(), types.StringValue("1 day")),
+			isValid:         false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toWaitSettings(context.Background(), tt.pollInterval, tt.timeoutDefaults)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+// idComponentPattern matches the components in the description of the id attribute, e.g. "`project_id`,`region`,`instance_id`".
+var idComponentPattern = regexp.MustCompile("`([a-z_]+)`")
+
+// idAttribute is implemented by the id attributes of all resources and data sources.
+type idAttribute interface {
+	GetDescription() string
+}
+
+// missingIdComponents returns the components of the id, which aren't attributes of the schema.
+func missingIdComponents(id idAttribute, hasAttribute func(name string) bool) []string {
+	var missing []string
+	for _, match := range idComponentPattern.FindAllStringSubmatch(id.GetDescription(), -1) {
+		if !hasAttribute(match[1]) {
+			missing = append(missing, match[1])
+		}
+	}
+	return missing
+}
+
+// TestIdComponentsAreAttributes makes sure that all components of composite ids can be referenced as attributes, without splitting the id.
+func TestIdComponentsAreAttributes(t *testing.T) {
+	ctx := context.Background()
+	p := &Provider{}
+
+	var findings []string
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		metadataResp := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "stackit"}, &metadataResp)
+		schemaResp := resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		id, ok := schemaResp.Schema.Attributes["id"]
+		if !ok {
+			continue
+		}
+		for _, component := range missingIdComponents(id, func(name string) bool { _, ok := schemaResp.Schema.Attributes[name]; return ok }) {
+			findings = append(findings, fmt.Sprintf("%s.%s", metadataResp.TypeName, component))
+		}
+	}
+	for _, newDataSource := range p.DataSources(ctx) {
+		d := newDataSource()
+		metadataResp := datasource.MetadataResponse{}
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "stackit"}, &metadataResp)
+		schemaResp := datasource.SchemaResponse{}
+		d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+		id, ok := schemaResp.Schema.Attributes["id"]
+		if !ok {
+			continue
+		}
+		for _, component := range missingIdComponents(id, func(name string) bool { _, ok := schemaResp.Schema.Attributes[name]; return ok }) {
+			findings = append(findings, fmt.Sprintf("data.%s.%s", metadataResp.TypeName, component))
+		}
+	}
+
+	sort.Strings(findings)
+	for _, finding := range findings {
+		t.Errorf("id component %s isn't an attribute. Expose it as computed attribute, so it can be referenced without splitting the id", finding)
+	}
+}
+
+func TestConfigureWrapsRoundTripper(t *testing.T) {
+	t.Setenv("STACKIT_SERVICE_ACCOUNT_TOKEN", "token")
+	ctx := context.Background()
+	wrapped := false
+	p := NewWithRoundTripperWrapper("test-version", func(next http.RoundTripper) http.RoundTripper {
+		wrapped = true
+		return next
+	})()
+
+	schemaResp := provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	configValues := map[string]tftypes.Value{}
+	for name, attributeType := range configType.AttributeTypes {
+		configValues[name] = tftypes.NewValue(attributeType, nil)
+	}
+	req := provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(configType, configValues),
+		},
+	}
+	resp := provider.ConfigureResponse{}
+	p.Configure(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+	}
+	if !wrapped {
+		t.Fatalf("Round tripper was not wrapped")
+	}
+	providerData, ok := resp.ResourceData.(core.ProviderData)
+	if !ok || providerData.RoundTripper == nil {
+		t.Fatalf("Provider data has no round tripper")
+	}
+}